@@ -107,12 +107,14 @@ func (t *Task) FieldPriority() godantic.FieldOptions[Priority] {
 
 func (t *Task) FieldAssignee() godantic.FieldOptions[*string] {
 	return godantic.Field(
+		godantic.Nullable[*string](),
 		godantic.Description[*string]("Person assigned to the task (optional)"),
 	)
 }
 
 func (t *Task) FieldDueDate() godantic.FieldOptions[*string] {
 	return godantic.Field(
+		godantic.Nullable[*string](),
 		godantic.Format[*string]("date-time"),
 		godantic.Description[*string]("Task deadline in ISO 8601 format (optional)"),
 	)
@@ -182,10 +184,7 @@ There's a critical bug in the login flow that's blocking the QA team - needs imm
 	validator := godantic.NewValidator[TaskList]()
 	taskList, errs := validator.Unmarshal([]byte(result.Text()))
 	if len(errs) > 0 {
-		fmt.Println("Validation errors:")
-		for _, e := range errs {
-			fmt.Printf("  %v: %s\n", e.Loc, e.Message)
-		}
+		fmt.Printf("Validation errors:\n%s\n", errs.Pretty())
 		os.Exit(1)
 	}
 
@@ -143,10 +143,7 @@ Action items:
 	validator := godantic.NewValidator[MeetingSummary]()
 	meeting, errs := validator.Unmarshal([]byte(completion.Choices[0].Message.Content))
 	if len(errs) > 0 {
-		fmt.Println("Validation errors:")
-		for _, e := range errs {
-			fmt.Printf("  %v: %s\n", e.Loc, e.Message)
-		}
+		fmt.Printf("Validation errors:\n%s\n", errs.Pretty())
 		os.Exit(1)
 	}
 
@@ -1,9 +1,11 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/deepankarm/godantic/pkg/godantic"
+	"github.com/deepankarm/godantic/pkg/godantic/schema"
 )
 
 type PaymentType string
@@ -56,15 +58,26 @@ func (b *BankTransferPayment) FieldType() godantic.FieldOptions[PaymentType] {
 }
 
 func main() {
+	variants := map[PaymentType]any{
+		PaymentTypeCreditCard:   CreditCardPayment{},
+		PaymentTypePayPal:       PayPalPayment{},
+		PaymentTypeBankTransfer: BankTransferPayment{},
+	}
+
 	// Create validator with discriminator configuration
 	validator := godantic.NewValidator[PaymentMethod](
-		godantic.WithDiscriminatorTyped("type", map[PaymentType]any{
-			PaymentTypeCreditCard:   CreditCardPayment{},
-			PaymentTypePayPal:       PayPalPayment{},
-			PaymentTypeBankTransfer: BankTransferPayment{},
-		}),
+		godantic.WithDiscriminatorTyped("type", variants),
 	)
 
+	// Generate the oneOf schema for the whole union from the same variants
+	unionSchema, err := schema.GenerateDiscriminatedUnionSchemaTyped("type", variants)
+	if err != nil {
+		fmt.Printf("Schema generation failed: %v\n", err)
+		return
+	}
+	schemaJSON, _ := json.MarshalIndent(unionSchema, "", "  ")
+	fmt.Printf("PaymentMethod schema:\n%s\n", schemaJSON)
+
 	// Valid credit card payment
 	creditCardJSON := `{"type": "credit_card", "card_number": "4532015112830366"}`
 	payment, errs := validator.Unmarshal([]byte(creditCardJSON))
@@ -0,0 +1,67 @@
+package gingodantic
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DefaultMaxDecompressedBytes caps decompressed request bodies for endpoints
+// using WithRequestDecompression without a body limit.
+const DefaultMaxDecompressedBytes int64 = 10 << 20
+
+// decompressBody replaces the request body with its decoded form according to
+// Content-Encoding, limited to limit decompressed bytes (DefaultMaxDecompressedBytes
+// if limit <= 0). Returns false if the encoding is unsupported or the stream is
+// corrupt (and has already sent an error response).
+func decompressBody(c *gin.Context, limit int64) bool {
+	encoding := strings.ToLower(strings.TrimSpace(c.GetHeader("Content-Encoding")))
+	if encoding == "" || encoding == "identity" || c.Request.Body == nil || c.Request.Body == http.NoBody {
+		return true
+	}
+
+	var decoder io.ReadCloser
+	var err error
+	switch encoding {
+	case "gzip", "x-gzip":
+		decoder, err = gzip.NewReader(c.Request.Body)
+	case "deflate":
+		decoder, err = zlib.NewReader(c.Request.Body)
+	default:
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{
+			"error":    "unsupported content encoding",
+			"encoding": encoding,
+		})
+		c.Abort()
+		return false
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to decompress request body"})
+		c.Abort()
+		return false
+	}
+
+	if limit <= 0 {
+		limit = DefaultMaxDecompressedBytes
+	}
+	body := &decodedBody{ReadCloser: decoder, raw: c.Request.Body}
+	c.Request.Body = http.MaxBytesReader(c.Writer, body, limit)
+	c.Request.Header.Del("Content-Encoding")
+	c.Request.ContentLength = -1
+	return true
+}
+
+// decodedBody reads a decompressed stream and closes both it and the raw body
+type decodedBody struct {
+	io.ReadCloser
+	raw io.Closer
+}
+
+func (b *decodedBody) Close() error {
+	b.ReadCloser.Close()
+	return b.raw.Close()
+}
@@ -0,0 +1,117 @@
+package gingodantic
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+	"github.com/gin-gonic/gin"
+)
+
+// HandlerOption configures a handler created with Handler
+type HandlerOption func(*handlerConfig)
+
+// handlerConfig holds the settings of a Handler
+type handlerConfig struct {
+	onError func(*gin.Context, error)
+}
+
+// WithErrorHandler replaces how Handler writes the errors returned by the
+// wrapped function, for example to map a not-found error to 404. By default a
+// godantic.ValidationErrors gets the middleware's 400 response and any other
+// error a 500 with {"error": "internal server error"}.
+func WithErrorHandler(fn func(c *gin.Context, err error)) HandlerOption {
+	return func(cfg *handlerConfig) {
+		cfg.onError = fn
+	}
+}
+
+// Handler turns a function of typed request and response into a gin handler.
+// The JSON body is validated as Req, reusing the value validated by an
+// OpenAPISchema middleware with WithRequest[Req] when there is one, or reading
+// it like BindAndValidate otherwise. The returned *Resp is validated and
+// marshaled with godantic (defaults applied) and written with the lowest 2xx
+// status declared by WithResponse for JSON, or 200. A nil *Resp writes the
+// status with no body; an invalid one is a 500 listing the validation errors.
+//
+// Example:
+//
+//	router.POST("/users",
+//	    api.OpenAPISchema("POST", "/users",
+//	        gingodantic.WithRequest[CreateUser](),
+//	        gingodantic.WithResponse[User](201, "Created"),
+//	    ),
+//	    gingodantic.Handler(func(c *gin.Context, req *CreateUser) (*User, error) {
+//	        return users.Create(c, req)
+//	    }),
+//	)
+func Handler[Req, Resp any](fn func(*gin.Context, *Req) (*Resp, error), opts ...HandlerOption) gin.HandlerFunc {
+	cfg := handlerConfig{onError: writeHandlerError}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	respValidator := godantic.NewValidator[Resp]()
+
+	return func(c *gin.Context) {
+		req, ok := GetValidated[Req](c)
+		if !ok {
+			if req, ok = BindAndValidate[Req](c); !ok {
+				return
+			}
+		}
+
+		resp, err := fn(c, req)
+		if err != nil {
+			cfg.onError(c, err)
+			c.Abort()
+			return
+		}
+
+		status := http.StatusOK
+		if declared, ok := c.Get(successStatusKey); ok {
+			status = declared.(int)
+		}
+		if resp == nil {
+			c.Status(status)
+			return
+		}
+		data, errs := respValidator.Marshal(resp)
+		if errs != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "response validation failed",
+				"details": errs,
+			})
+			c.Abort()
+			return
+		}
+		c.Data(status, "application/json; charset=utf-8", data)
+	}
+}
+
+// writeHandlerError is the default error response of Handler
+func writeHandlerError(c *gin.Context, err error) {
+	var validationErrs godantic.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "validation failed",
+			"details": validationErrs,
+		})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+}
+
+// successStatus returns the lowest 2xx status declared for a JSON response,
+// or 200 when there is none
+func (spec *EndpointSpec) successStatus() int {
+	status := 0
+	for code, resp := range spec.Responses {
+		if resp.Type != nil && code >= 200 && code < 300 && (status == 0 || code < status) {
+			status = code
+		}
+	}
+	if status == 0 {
+		return http.StatusOK
+	}
+	return status
+}
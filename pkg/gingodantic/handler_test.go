@@ -0,0 +1,129 @@
+package gingodantic_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/gingodantic"
+	"github.com/deepankarm/godantic/pkg/godantic"
+	"github.com/gin-gonic/gin"
+)
+
+type HandlerNote struct {
+	ID     string `json:"id"`
+	Text   string `json:"text"`
+	Status string `json:"status"`
+}
+
+func (HandlerNote) FieldID() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Required[string]())
+}
+
+func (HandlerNote) FieldStatus() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Default("draft"))
+}
+
+func setupHandlerRouter(fn func(*gin.Context, *TestRequest) (*HandlerNote, error), opts ...gingodantic.HandlerOption) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	api := gingodantic.New("Test API", "1.0.0")
+	router.POST("/notes",
+		api.OpenAPISchema("POST", "/notes",
+			gingodantic.WithRequest[TestRequest](),
+			gingodantic.WithResponse[HandlerNote](201, "Created"),
+			gingodantic.WithResponse[TestErrorResponse](400, "Invalid request"),
+		),
+		gingodantic.Handler(fn, opts...),
+	)
+	// Without the middleware, the handler validates the body itself
+	router.POST("/bare", gingodantic.Handler(fn, opts...))
+	return router
+}
+
+func postNote(router *gin.Engine, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("POST", path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestHandler(t *testing.T) {
+	router := setupHandlerRouter(func(c *gin.Context, req *TestRequest) (*HandlerNote, error) {
+		switch req.Name {
+		case "missing":
+			return nil, errors.New("database unavailable")
+		case "invalid":
+			return &HandlerNote{Text: req.Email}, nil
+		}
+		return &HandlerNote{ID: "n1", Text: req.Name + " <" + req.Email + ">"}, nil
+	})
+	valid := `{"name": "%s", "email": "john@example.com", "age": 30}`
+
+	t.Run("writes the declared status", func(t *testing.T) {
+		w := postNote(router, "/notes", strings.Replace(valid, "%s", "John", 1))
+		if w.Code != http.StatusCreated {
+			t.Fatalf("Expected status 201, got %d: %s", w.Code, w.Body.String())
+		}
+		var note HandlerNote
+		if err := json.Unmarshal(w.Body.Bytes(), &note); err != nil {
+			t.Fatalf("Expected a JSON note: %v", err)
+		}
+		if note.ID != "n1" || note.Text != "John <john@example.com>" {
+			t.Errorf("Unexpected note %+v", note)
+		}
+		if note.Status != "draft" {
+			t.Errorf("Expected the response default to be applied, got %q", note.Status)
+		}
+	})
+
+	t.Run("validates the body without the middleware", func(t *testing.T) {
+		w := postNote(router, "/bare", strings.Replace(valid, "%s", "John", 1))
+		if w.Code != http.StatusOK {
+			t.Errorf("Expected status 200, got %d: %s", w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("rejects an invalid request", func(t *testing.T) {
+		for _, path := range []string{"/notes", "/bare"} {
+			w := postNote(router, path, `{"name": "Jo", "email": "not-an-email", "age": 30}`)
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("%s: Expected status 400, got %d", path, w.Code)
+			}
+			var resp TestErrorResponse
+			json.Unmarshal(w.Body.Bytes(), &resp)
+			if resp.Error != "validation failed" {
+				t.Errorf("%s: Expected a validation error, got %+v", path, resp)
+			}
+		}
+	})
+
+	t.Run("rejects an invalid response", func(t *testing.T) {
+		w := postNote(router, "/notes", strings.Replace(valid, "%s", "invalid", 1))
+		if w.Code != http.StatusInternalServerError || !strings.Contains(w.Body.String(), "response validation failed") {
+			t.Errorf("Expected a response validation error, got %d: %s", w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("maps returned errors", func(t *testing.T) {
+		w := postNote(router, "/notes", strings.Replace(valid, "%s", "missing", 1))
+		if w.Code != http.StatusInternalServerError || strings.Contains(w.Body.String(), "database") {
+			t.Errorf("Expected a generic 500, got %d: %s", w.Code, w.Body.String())
+		}
+
+		custom := setupHandlerRouter(
+			func(*gin.Context, *TestRequest) (*HandlerNote, error) { return nil, errors.New("no such note") },
+			gingodantic.WithErrorHandler(func(c *gin.Context, err error) {
+				c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			}),
+		)
+		w = postNote(custom, "/notes", strings.Replace(valid, "%s", "John", 1))
+		if w.Code != http.StatusNotFound || !strings.Contains(w.Body.String(), "no such note") {
+			t.Errorf("Expected the custom error response, got %d: %s", w.Code, w.Body.String())
+		}
+	})
+}
@@ -2,9 +2,15 @@ package gingodantic_test
 
 import (
 	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/deepankarm/godantic/pkg/gingodantic"
@@ -816,3 +822,527 @@ func TestIntegration_OpenAPISpec(t *testing.T) {
 		t.Errorf("Expected at least 5 parameters for /users, got %d", len(params))
 	}
 }
+
+type ForwardedHeaders struct {
+	APIKey       string   `json:"X-API-Key"`
+	ForwardedFor []string `json:"X-Forwarded-For"`
+}
+
+func (ForwardedHeaders) FieldAPIKey() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Required[string]())
+}
+
+func TestIntegration_RepeatedHeaders(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newRouter := func(opts ...godantic.ValidatorOption) *gin.Engine {
+		router := gin.New()
+		api := gingodantic.New("Test API", "1.0.0")
+		router.GET("/proxy",
+			api.OpenAPISchema("GET", "/proxy",
+				gingodantic.WithHeaderParams[ForwardedHeaders](opts...),
+			),
+			func(c *gin.Context) {
+				headers, _ := gingodantic.GetValidatedHeaders[ForwardedHeaders](c)
+				c.JSON(http.StatusOK, headers)
+			},
+		)
+		return router
+	}
+
+	t.Run("repeated header into slice field", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/proxy", nil)
+		req.Header.Set("x-api-key", "secret")
+		req.Header.Add("X-Forwarded-For", "10.0.0.1")
+		req.Header.Add("X-Forwarded-For", "10.0.0.2")
+		w := httptest.NewRecorder()
+		newRouter().ServeHTTP(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d: %s", w.Code, w.Body.String())
+		}
+		var got ForwardedHeaders
+		if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+			t.Fatalf("Failed to unmarshal response: %v", err)
+		}
+		if got.APIKey != "secret" {
+			t.Errorf("Expected API key 'secret', got %q", got.APIKey)
+		}
+		if len(got.ForwardedFor) != 2 {
+			t.Errorf("Expected 2 forwarded addresses, got %v", got.ForwardedFor)
+		}
+	})
+
+	t.Run("strict single value rejects repeated scalar", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/proxy", nil)
+		req.Header.Add("X-API-Key", "one")
+		req.Header.Add("X-API-Key", "two")
+		w := httptest.NewRecorder()
+		newRouter(godantic.WithStrictSingleValue()).ServeHTTP(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("Expected status 400, got %d", w.Code)
+		}
+	})
+}
+
+func TestIntegration_PathParamCoercion(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	api := gingodantic.New("Test API", "1.0.0")
+	router.GET("/items/:id",
+		api.OpenAPISchema("GET", "/items/:id",
+			gingodantic.WithPathParams[UserPathParams](),
+		),
+		func(c *gin.Context) {
+			params, _ := gingodantic.GetValidatedPath[UserPathParams](c)
+			c.JSON(http.StatusOK, params)
+		},
+	)
+
+	for _, id := range []string{"abc", "+5", "0x10", "99999999999999999999"} {
+		t.Run(id, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/items/"+url.PathEscape(id), nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("Expected status 400, got %d", w.Code)
+			}
+			var body struct {
+				Details []godantic.ValidationError `json:"details"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("Failed to unmarshal response: %v", err)
+			}
+			if len(body.Details) != 1 || body.Details[0].Type != godantic.ErrorTypeCoercion {
+				t.Errorf("Expected a single coercion error, got %+v", body.Details)
+			}
+		})
+	}
+}
+
+// HexID is a 16-byte identifier written as 32 hex characters
+type HexID [16]byte
+
+func (id HexID) MarshalText() ([]byte, error) {
+	return []byte(hex.EncodeToString(id[:])), nil
+}
+
+func (id *HexID) UnmarshalText(text []byte) error {
+	if len(text) != 32 {
+		return fmt.Errorf("expected 32 hex characters, got %d", len(text))
+	}
+	_, err := hex.Decode(id[:], text)
+	return err
+}
+
+type DevicePathParams struct {
+	ID HexID `json:"id"`
+}
+
+type LinkDeviceRequest struct {
+	ParentID HexID `json:"parent_id"`
+}
+
+func (LinkDeviceRequest) FieldParentID() godantic.FieldOptions[HexID] {
+	return godantic.Field(godantic.Required[HexID]())
+}
+
+func TestIntegration_TextUnmarshalerParams(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	api := gingodantic.New("Test API", "1.0.0")
+	router.PUT("/devices/:id",
+		api.OpenAPISchema("PUT", "/devices/:id",
+			gingodantic.WithPathParams[DevicePathParams](),
+			gingodantic.WithRequest[LinkDeviceRequest](),
+		),
+		func(c *gin.Context) {
+			params, _ := gingodantic.GetValidatedPath[DevicePathParams](c)
+			body, _ := gingodantic.GetValidated[LinkDeviceRequest](c)
+			c.JSON(http.StatusOK, gin.H{"id": params.ID, "parent_id": body.ParentID})
+		},
+	)
+	router.GET("/openapi.json", api.OpenAPIHandler())
+
+	const id = "0102030405060708090a0b0c0d0e0f10"
+	const parent = "ffffffffffffffffffffffffffffffff"
+
+	tests := []struct {
+		name string
+		id   string
+		body string
+		code int
+	}{
+		{"valid", id, `{"parent_id":"` + parent + `"}`, http.StatusOK},
+		{"bad path ID", "xyz", `{"parent_id":"` + parent + `"}`, http.StatusBadRequest},
+		{"bad body ID", id, `{"parent_id":"xyz"}`, http.StatusBadRequest},
+		{"missing body ID", id, `{}`, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("PUT", "/devices/"+tt.id, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.code {
+				t.Fatalf("Expected status %d, got %d: %s", tt.code, w.Code, w.Body.String())
+			}
+			if tt.code == http.StatusOK && w.Body.String() != `{"id":"`+id+`","parent_id":"`+parent+`"}` {
+				t.Errorf("Unexpected body: %s", w.Body.String())
+			}
+		})
+	}
+
+	t.Run("path ID error is a coercion error", func(t *testing.T) {
+		req := httptest.NewRequest("PUT", "/devices/xyz", bytes.NewBufferString(`{}`))
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		var body struct {
+			Details []godantic.ValidationError `json:"details"`
+		}
+		if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+			t.Fatalf("Failed to unmarshal response: %v", err)
+		}
+		if len(body.Details) != 1 || body.Details[0].Type != godantic.ErrorTypeCoercion {
+			t.Errorf("Expected a single coercion error, got %+v", body.Details)
+		}
+	})
+
+	t.Run("documented as a string parameter", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", "/openapi.json", nil))
+		var spec struct {
+			Paths map[string]map[string]struct {
+				Parameters []struct {
+					Name   string         `json:"name"`
+					Schema map[string]any `json:"schema"`
+				} `json:"parameters"`
+			} `json:"paths"`
+		}
+		if err := json.Unmarshal(w.Body.Bytes(), &spec); err != nil {
+			t.Fatalf("Failed to unmarshal OpenAPI spec: %v", err)
+		}
+		params := spec.Paths["/devices/{id}"]["put"].Parameters
+		if len(params) != 1 || params[0].Schema["type"] != "string" {
+			t.Errorf("Expected a string id parameter, got %+v", params)
+		}
+	})
+}
+
+// FlagFilterQuery uses pointer fields to tell omitted params from zero values
+type FlagFilterQuery struct {
+	Enabled *bool `json:"enabled"`
+	Limit   *int  `json:"limit"`
+}
+
+// Options on a pointer field are written for its element type
+func (FlagFilterQuery) FieldLimit() godantic.FieldOptions[int] {
+	return godantic.Field(godantic.Min(1))
+}
+
+func TestIntegration_PointerQueryParams(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	api := gingodantic.New("Test API", "1.0.0")
+	router.GET("/flags",
+		api.OpenAPISchema("GET", "/flags",
+			gingodantic.WithQueryParams[FlagFilterQuery](),
+		),
+		func(c *gin.Context) {
+			query, _ := gingodantic.GetValidatedQuery[FlagFilterQuery](c)
+			c.JSON(http.StatusOK, query)
+		},
+	)
+
+	tests := []struct {
+		name  string
+		query string
+		code  int
+		body  string
+	}{
+		{"enabled true", "?enabled=true", http.StatusOK, `{"enabled":true,"limit":null}`},
+		{"enabled false", "?enabled=false", http.StatusOK, `{"enabled":false,"limit":null}`},
+		{"omitted", "", http.StatusOK, `{"enabled":null,"limit":null}`},
+		{"limit in range", "?limit=5", http.StatusOK, `{"enabled":null,"limit":5}`},
+		{"limit zero", "?limit=0", http.StatusBadRequest, ""},
+		{"limit negative", "?limit=-3", http.StatusBadRequest, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest("GET", "/flags"+tt.query, nil))
+
+			if w.Code != tt.code {
+				t.Fatalf("Expected status %d, got %d: %s", tt.code, w.Code, w.Body.String())
+			}
+			if tt.body != "" && w.Body.String() != tt.body {
+				t.Errorf("Expected body %s, got %s", tt.body, w.Body.String())
+			}
+		})
+	}
+
+	t.Run("constraint error on the limit field", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", "/flags?limit=0", nil))
+
+		var body struct {
+			Details []godantic.ValidationError `json:"details"`
+		}
+		if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+			t.Fatalf("Failed to unmarshal response: %v", err)
+		}
+		if len(body.Details) != 1 || body.Details[0].Type != godantic.ErrorTypeConstraint || body.Details[0].Loc[0] != "Limit" {
+			t.Errorf("Expected a single constraint error on Limit, got %+v", body.Details)
+		}
+	})
+}
+
+func TestIntegration_MaxBodyBytes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	newRouter := func(apiOpts []gingodantic.APIOption, routeOpts ...gingodantic.SchemaOption) *gin.Engine {
+		router := gin.New()
+		api := gingodantic.New("Test API", "1.0.0", apiOpts...)
+		opts := append([]gingodantic.SchemaOption{gingodantic.WithRequest[CreateUserRequest]()}, routeOpts...)
+		router.POST("/users",
+			api.OpenAPISchema("POST", "/users", opts...),
+			func(c *gin.Context) {
+				c.Status(http.StatusCreated)
+			},
+		)
+		return router
+	}
+
+	small := []byte(`{"name":"John Doe","email":"john@example.com","role":"user"}`)
+	large := []byte(`{"name":"John Doe","email":"john@example.com","role":"user","pad":"` + string(bytes.Repeat([]byte("x"), 1024)) + `"}`)
+
+	post := func(router *gin.Engine, body []byte) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", "/users", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("oversized body rejected with 413", func(t *testing.T) {
+		w := post(newRouter(nil, gingodantic.WithMaxBodyBytes(256)), large)
+		if w.Code != http.StatusRequestEntityTooLarge {
+			t.Fatalf("Expected status 413, got %d: %s", w.Code, w.Body.String())
+		}
+		var body map[string]any
+		if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+			t.Fatalf("Failed to unmarshal response: %v", err)
+		}
+		if body["error"] != "request body too large" || body["max_bytes"] != 256.0 {
+			t.Errorf("Unexpected error body: %v", body)
+		}
+	})
+
+	t.Run("body within limit accepted", func(t *testing.T) {
+		w := post(newRouter(nil, gingodantic.WithMaxBodyBytes(256)), small)
+		if w.Code != http.StatusCreated {
+			t.Errorf("Expected status 201, got %d: %s", w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("API default applies", func(t *testing.T) {
+		w := post(newRouter([]gingodantic.APIOption{gingodantic.WithDefaultMaxBodyBytes(256)}), large)
+		if w.Code != http.StatusRequestEntityTooLarge {
+			t.Errorf("Expected status 413, got %d", w.Code)
+		}
+	})
+
+	t.Run("route overrides API default", func(t *testing.T) {
+		router := newRouter(
+			[]gingodantic.APIOption{gingodantic.WithDefaultMaxBodyBytes(256)},
+			gingodantic.WithMaxBodyBytes(-1),
+		)
+		if w := post(router, large); w.Code == http.StatusRequestEntityTooLarge {
+			t.Errorf("Expected route override to disable the limit, got %d", w.Code)
+		}
+	})
+}
+
+func TestIntegration_RequestDecompression(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	newRouter := func(routeOpts ...gingodantic.SchemaOption) *gin.Engine {
+		router := gin.New()
+		api := gingodantic.New("Test API", "1.0.0")
+		opts := append([]gingodantic.SchemaOption{gingodantic.WithRequest[CreateUserRequest]()}, routeOpts...)
+		router.POST("/users",
+			api.OpenAPISchema("POST", "/users", opts...),
+			func(c *gin.Context) {
+				body, _ := gingodantic.GetValidated[CreateUserRequest](c)
+				c.JSON(http.StatusCreated, body)
+			},
+		)
+		return router
+	}
+
+	compress := func(encoding string, data []byte) []byte {
+		var buf bytes.Buffer
+		var w io.WriteCloser
+		if encoding == "deflate" {
+			w = zlib.NewWriter(&buf)
+		} else {
+			w = gzip.NewWriter(&buf)
+		}
+		w.Write(data)
+		w.Close()
+		return buf.Bytes()
+	}
+
+	post := func(router *gin.Engine, encoding string, body []byte) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", "/users", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Content-Encoding", encoding)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	valid := []byte(`{"name":"John Doe","email":"john@example.com","role":"user"}`)
+	router := newRouter(gingodantic.WithRequestDecompression())
+
+	for _, encoding := range []string{"gzip", "deflate"} {
+		t.Run(encoding+" body validates", func(t *testing.T) {
+			w := post(router, encoding, compress(encoding, valid))
+			if w.Code != http.StatusCreated {
+				t.Fatalf("Expected status 201, got %d: %s", w.Code, w.Body.String())
+			}
+			if w.Body.String() != string(valid) {
+				t.Errorf("Unexpected body: %s", w.Body.String())
+			}
+		})
+	}
+
+	t.Run("invalid gzipped body reports validation errors", func(t *testing.T) {
+		w := post(router, "gzip", compress("gzip", []byte(`{"name":"John Doe","email":"john@example.com","role":"owner"}`)))
+		if w.Code != http.StatusBadRequest || !bytes.Contains(w.Body.Bytes(), []byte("validation failed")) {
+			t.Errorf("Expected a validation error, got %d: %s", w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("uncompressed body still accepted", func(t *testing.T) {
+		if w := post(router, "", valid); w.Code != http.StatusCreated {
+			t.Errorf("Expected status 201, got %d: %s", w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("decompressed size is limited", func(t *testing.T) {
+		bomb := compress("gzip", []byte(`{"name":"`+string(bytes.Repeat([]byte("x"), 64<<10))+`"}`))
+		w := post(newRouter(gingodantic.WithRequestDecompression(), gingodantic.WithMaxBodyBytes(1024)), "gzip", bomb)
+		if len(bomb) > 1024 {
+			t.Fatalf("test body should compress below the limit, got %d bytes", len(bomb))
+		}
+		if w.Code != http.StatusRequestEntityTooLarge {
+			t.Errorf("Expected status 413, got %d: %s", w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("corrupt stream rejected", func(t *testing.T) {
+		if w := post(router, "gzip", valid); w.Code != http.StatusBadRequest {
+			t.Errorf("Expected status 400, got %d: %s", w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("unsupported encoding rejected", func(t *testing.T) {
+		if w := post(router, "br", valid); w.Code != http.StatusUnsupportedMediaType {
+			t.Errorf("Expected status 415, got %d: %s", w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("off by default", func(t *testing.T) {
+		if w := post(newRouter(), "gzip", compress("gzip", valid)); w.Code != http.StatusBadRequest {
+			t.Errorf("Expected compressed body to fail validation without the option, got %d", w.Code)
+		}
+	})
+}
+
+func TestIntegration_SliceRequestBody(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	api := gingodantic.New("Test API", "1.0.0")
+	router.POST("/users/bulk",
+		api.OpenAPISchema("POST", "/users/bulk",
+			gingodantic.WithRequest[[]CreateUserRequest](),
+			gingodantic.WithResponse[[]UserResponse](201, "Users created"),
+		),
+		func(c *gin.Context) {
+			users, ok := gingodantic.GetValidated[[]CreateUserRequest](c)
+			if !ok {
+				c.Status(http.StatusInternalServerError)
+				return
+			}
+			created := make([]UserResponse, len(*users))
+			for i, u := range *users {
+				created[i] = UserResponse{ID: i + 1, Name: u.Name, Email: u.Email, Role: u.Role}
+			}
+			c.JSON(http.StatusCreated, created)
+		},
+	)
+
+	post := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", "/users/bulk", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("valid elements", func(t *testing.T) {
+		w := post(`[{"name":"John Doe","email":"john@example.com","role":"user"},{"name":"Jane","email":"jane@example.com","role":"admin"}]`)
+		if w.Code != http.StatusCreated {
+			t.Fatalf("Expected status 201, got %d: %s", w.Code, w.Body.String())
+		}
+		var created []UserResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &created); err != nil {
+			t.Fatalf("Failed to unmarshal response: %v", err)
+		}
+		if len(created) != 2 || created[1].Name != "Jane" {
+			t.Errorf("Unexpected response: %+v", created)
+		}
+	})
+
+	t.Run("errors are indexed by element", func(t *testing.T) {
+		w := post(`[{"name":"John Doe","email":"john@example.com","role":"user"},{"name":"Jane","email":"jane@example.com","role":"owner"}]`)
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("Expected status 400, got %d", w.Code)
+		}
+		var body struct {
+			Details []godantic.ValidationError `json:"details"`
+		}
+		if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+			t.Fatalf("Failed to unmarshal response: %v", err)
+		}
+		if len(body.Details) != 1 || fmt.Sprint(body.Details[0].Loc) != "[[1] Role]" {
+			t.Errorf("Expected a single error at [1].Role, got %+v", body.Details)
+		}
+	})
+
+	t.Run("object body rejected", func(t *testing.T) {
+		if w := post(`{"name":"John Doe","email":"john@example.com","role":"user"}`); w.Code != http.StatusBadRequest {
+			t.Errorf("Expected status 400, got %d", w.Code)
+		}
+	})
+
+	t.Run("documented as an array of the element schema", func(t *testing.T) {
+		spec := api.GenerateOpenAPI()
+		operation := spec["paths"].(map[string]any)["/users/bulk"].(map[string]any)["post"].(map[string]any)
+		bodySchema := operation["requestBody"].(map[string]any)["content"].(map[string]any)["application/json"].(map[string]any)["schema"].(map[string]any)
+		if bodySchema["type"] != "array" {
+			t.Errorf("Expected an array request body, got %v", bodySchema)
+		}
+		if items, _ := bodySchema["items"].(map[string]any); items["$ref"] != "#/components/schemas/CreateUserRequest" {
+			t.Errorf("Expected items to reference CreateUserRequest, got %v", bodySchema["items"])
+		}
+
+		element := spec["components"].(map[string]any)["schemas"].(map[string]any)["CreateUserRequest"].(map[string]any)
+		if required, _ := element["required"].([]any); len(required) != 3 {
+			t.Errorf("Expected the element's required fields, got %v", element["required"])
+		}
+	})
+}
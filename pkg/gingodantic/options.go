@@ -2,10 +2,57 @@ package gingodantic
 
 import (
 	"reflect"
+	"strings"
 
 	"github.com/deepankarm/godantic/pkg/godantic"
 )
 
+// APIOption configures an API instance
+type APIOption func(*API)
+
+// WithOpenAPI31 generates an OpenAPI 3.1 specification instead of 3.0.
+// Required for 3.1-only features such as webhooks.
+func WithOpenAPI31() APIOption {
+	return func(api *API) {
+		api.openAPIVersion = OpenAPIVersion31
+	}
+}
+
+// WithDefaultMaxBodyBytes limits request bodies to n bytes for every endpoint
+// that does not set its own limit with WithMaxBodyBytes.
+func WithDefaultMaxBodyBytes(n int64) APIOption {
+	return func(api *API) {
+		api.maxBodyBytes = n
+	}
+}
+
+// WithSplitReadWriteSchemas documents request and response variants of types
+// with ReadOnly or WriteOnly fields. Request bodies leave out read-only fields
+// (such as a server-assigned ID) and responses leave out write-only ones (such
+// as a password). A component whose variant differs is registered with an
+// Input or Output suffix, e.g. UserInput and UserOutput, so a type used both
+// ways is no longer shared. Without this option both keywords are emitted on a
+// single schema and left for clients to interpret.
+func WithSplitReadWriteSchemas() APIOption {
+	return func(api *API) {
+		api.splitReadWrite = true
+	}
+}
+
+// TagOption configures a tag declared with API.AddTag
+type TagOption func(*TagSpec)
+
+// WithTagExternalDocs attaches external documentation to a tag
+func WithTagExternalDocs(url string, description ...string) TagOption {
+	return func(tag *TagSpec) {
+		docs := &ExternalDocs{URL: url}
+		if len(description) > 0 {
+			docs.Description = description[0]
+		}
+		tag.ExternalDocs = docs
+	}
+}
+
 // SchemaOption configures an endpoint schema
 type SchemaOption func(*EndpointSpec)
 
@@ -30,7 +77,10 @@ func WithTags(tags ...string) SchemaOption {
 	}
 }
 
-// WithRequest specifies the request body type and creates a validator for it
+// WithRequest specifies the request body type and creates a validator for it.
+// T may be a slice such as []Item for JSON array bodies: each element is
+// validated, errors are located by index (e.g. ["[2]", "Name"]), and the body
+// is documented as an array of the element schema.
 func WithRequest[T any]() SchemaOption {
 	var zero T
 	validator := godantic.NewValidator[T]()
@@ -58,10 +108,94 @@ func WithResponse[T any](statusCode int, description ...string) SchemaOption {
 		if spec.Responses == nil {
 			spec.Responses = make(map[int]ResponseSpec)
 		}
-		spec.Responses[statusCode] = ResponseSpec{
-			Type:        reflect.TypeOf(zero),
-			Description: desc,
+		resp := spec.Responses[statusCode]
+		resp.Type = reflect.TypeOf(zero)
+		if desc != "" {
+			resp.Description = desc
+		}
+		spec.Responses[statusCode] = resp
+	}
+}
+
+// WithResponseAs documents a response of another media type than JSON for a
+// status code, such as CSV chosen by the Accept header. It may be combined
+// with WithResponse for the same status, which lists both under its content.
+// The description is used when WithResponse gives none.
+//
+// Example:
+//
+//	gingodantic.WithResponse[Report](200, "The report"),
+//	gingodantic.WithResponseAs[string](200, "text/csv", "The report as CSV"),
+func WithResponseAs[T any](statusCode int, mediaType string, description ...string) SchemaOption {
+	var zero T
+	return func(spec *EndpointSpec) {
+		if spec.Responses == nil {
+			spec.Responses = make(map[int]ResponseSpec)
+		}
+		resp := spec.Responses[statusCode]
+		if resp.Content == nil {
+			resp.Content = make(map[string]reflect.Type)
+		}
+		resp.Content[strings.ToLower(mediaType)] = reflect.TypeOf(zero)
+		if len(description) > 0 && resp.Description == "" {
+			resp.Description = description[0]
 		}
+		spec.Responses[statusCode] = resp
+	}
+}
+
+// WithRequestContent accepts WithRequest bodies of another media type, such as
+// application/cbor, alongside JSON. Bodies sent with that Content-Type are
+// decoded to a map by decode and then validated like a JSON body, with the
+// same field options and defaults; other bodies are parsed as JSON. The spec
+// lists the media type in requestBody.content with the request schema.
+//
+// Example:
+//
+//	gingodantic.WithRequest[CreateUser](),
+//	gingodantic.WithRequestContent("application/cbor", func(body []byte) (map[string]any, error) {
+//	    var m map[string]any
+//	    return m, cbor.Unmarshal(body, &m)
+//	}),
+func WithRequestContent(mediaType string, decode func([]byte) (map[string]any, error)) SchemaOption {
+	return func(spec *EndpointSpec) {
+		if spec.validators.decoders == nil {
+			spec.validators.decoders = make(map[string]func([]byte) (map[string]any, error))
+		}
+		spec.validators.decoders[strings.ToLower(mediaType)] = decode
+	}
+}
+
+// WithOptionalRequestBody marks the WithRequest body as optional, for PATCH
+// endpoints or bodies that may be left out. The spec sets requestBody.required
+// to false, and an empty body skips validation instead of reporting missing
+// required fields; GetValidated then returns false. A non-empty body is
+// validated as usual.
+func WithOptionalRequestBody() SchemaOption {
+	return func(spec *EndpointSpec) {
+		spec.OptionalRequestBody = true
+	}
+}
+
+// WithMaxBodyBytes limits the request body to n bytes. The body is wrapped in
+// http.MaxBytesReader before it is read, so oversized requests are rejected with
+// 413 Request Entity Too Large without being buffered or parsed. The limit also
+// applies to handlers reading the body themselves. Overrides the API default set
+// with WithDefaultMaxBodyBytes; a negative n disables the limit for this endpoint.
+func WithMaxBodyBytes(n int64) SchemaOption {
+	return func(spec *EndpointSpec) {
+		spec.MaxBodyBytes = n
+	}
+}
+
+// WithRequestDecompression decodes request bodies sent with Content-Encoding
+// gzip or deflate before validation, so handlers and validators see plain JSON.
+// The decompressed size is capped at the endpoint's body limit (WithMaxBodyBytes
+// or WithDefaultMaxBodyBytes), or DefaultMaxDecompressedBytes when there is
+// none, and larger bodies are rejected with 413. Other encodings get 415.
+func WithRequestDecompression() SchemaOption {
+	return func(spec *EndpointSpec) {
+		spec.DecompressRequest = true
 	}
 }
 
@@ -99,10 +233,31 @@ func WithResponseExamples(statusCode int, examples map[string]any) SchemaOption
 	}
 }
 
+// WithParamExamples adds named examples for a parameter, given its location
+// ("path", "query", "header" or "cookie") and name. They replace the example
+// taken from the field's Example constraint.
+//
+// Example:
+//
+//	gingodantic.WithParamExamples("query", "sort", map[string]any{
+//	    "newest": map[string]any{"value": "-created_at"},
+//	})
+func WithParamExamples(location, name string, examples map[string]any) SchemaOption {
+	return func(spec *EndpointSpec) {
+		if spec.ParamExamples == nil {
+			spec.ParamExamples = make(map[string]map[string]map[string]any)
+		}
+		if spec.ParamExamples[location] == nil {
+			spec.ParamExamples[location] = make(map[string]map[string]any)
+		}
+		spec.ParamExamples[location][name] = examples
+	}
+}
+
 // WithPathParams specifies path parameter types and creates a validator for them
-func WithPathParams[T any]() SchemaOption {
+func WithPathParams[T any](opts ...godantic.ValidatorOption) SchemaOption {
 	var zero T
-	validator := godantic.NewValidator[T]()
+	validator := godantic.NewValidator[T](opts...)
 
 	return func(spec *EndpointSpec) {
 		spec.ParamTypes.Path = reflect.TypeOf(zero)
@@ -112,36 +267,43 @@ func WithPathParams[T any]() SchemaOption {
 	}
 }
 
-// WithHeaderParams specifies header parameter types and creates a validator for them
-func WithHeaderParams[T any]() SchemaOption {
+// WithHeaderParams specifies header parameter types and creates a validator for them.
+// Header names are matched case-insensitively, after canonicalizing both the request's
+// names and the json tags with textproto.CanonicalMIMEHeaderKey, and a missing required
+// header is reported by name. A []string field collects all values
+// of a repeated header; scalar fields take the first value unless the validator is
+// configured with godantic.WithStrictSingleValue().
+func WithHeaderParams[T any](opts ...godantic.ValidatorOption) SchemaOption {
 	var zero T
-	validator := godantic.NewValidator[T]()
+	validator := godantic.NewValidator[T](opts...)
 
 	return func(spec *EndpointSpec) {
 		spec.ParamTypes.Header = reflect.TypeOf(zero)
 		spec.validators.header = func(headerParams map[string][]string) (any, godantic.ValidationErrors) {
-			return validator.ValidateFromMultiValueMap(headerParams)
+			return validator.ValidateFromHeaders(headerParams)
 		}
 	}
 }
 
-// WithCookieParams specifies cookie parameter types and creates a validator for them
-func WithCookieParams[T any]() SchemaOption {
+// WithCookieParams specifies cookie parameter types and creates a validator for them.
+// Cookie names are case-sensitive and must match the json tag exactly; a missing
+// required cookie is reported by name.
+func WithCookieParams[T any](opts ...godantic.ValidatorOption) SchemaOption {
 	var zero T
-	validator := godantic.NewValidator[T]()
+	validator := godantic.NewValidator[T](opts...)
 
 	return func(spec *EndpointSpec) {
 		spec.ParamTypes.Cookie = reflect.TypeOf(zero)
 		spec.validators.cookie = func(cookieParams map[string]string) (any, godantic.ValidationErrors) {
-			return validator.ValidateFromStringMap(cookieParams)
+			return validator.ValidateFromCookies(cookieParams)
 		}
 	}
 }
 
 // WithQueryParams specifies the query parameter type and creates a validator for it
-func WithQueryParams[T any]() SchemaOption {
+func WithQueryParams[T any](opts ...godantic.ValidatorOption) SchemaOption {
 	var zero T
-	validator := godantic.NewValidator[T]()
+	validator := godantic.NewValidator[T](opts...)
 
 	return func(spec *EndpointSpec) {
 		spec.ParamTypes.Query = reflect.TypeOf(zero)
@@ -0,0 +1,68 @@
+package gingodantic
+
+import (
+	"github.com/deepankarm/godantic/pkg/godantic"
+	"github.com/gin-gonic/gin"
+)
+
+// Path coerces and validates a single path parameter without declaring a params
+// struct, reusing godantic's constraint functions. The returned error is nil or
+// a godantic.ValidationErrors, the same type the middleware reports for
+// WithPathParams.
+//
+// Example:
+//
+//	id, err := gingodantic.Path[int](c, "id", godantic.Min(1))
+//	if err != nil {
+//	    c.JSON(http.StatusBadRequest, gin.H{"error": "validation failed", "details": err})
+//	    return
+//	}
+func Path[T any](c *gin.Context, name string, opts ...func(godantic.FieldOptions[T]) godantic.FieldOptions[T]) (T, error) {
+	var values []string
+	if value, ok := c.Params.Get(name); ok {
+		values = []string{value}
+	}
+	return validateParam(name, values, opts)
+}
+
+// Query coerces and validates a single query parameter without declaring a
+// params struct. An absent parameter falls back to its Default, or is reported
+// if Required; otherwise the zero value is returned. The returned error is nil
+// or a godantic.ValidationErrors.
+func Query[T any](c *gin.Context, name string, opts ...func(godantic.FieldOptions[T]) godantic.FieldOptions[T]) (T, error) {
+	return validateParam(name, c.QueryArray(name), opts)
+}
+
+// PathInt is Path for int parameters.
+func PathInt(c *gin.Context, name string, opts ...func(godantic.FieldOptions[int]) godantic.FieldOptions[int]) (int, error) {
+	return Path(c, name, opts...)
+}
+
+// PathString is Path for string parameters.
+func PathString(c *gin.Context, name string, opts ...func(godantic.FieldOptions[string]) godantic.FieldOptions[string]) (string, error) {
+	return Path(c, name, opts...)
+}
+
+// QueryInt is Query for int parameters.
+func QueryInt(c *gin.Context, name string, opts ...func(godantic.FieldOptions[int]) godantic.FieldOptions[int]) (int, error) {
+	return Query(c, name, opts...)
+}
+
+// QueryString is Query for string parameters.
+func QueryString(c *gin.Context, name string, opts ...func(godantic.FieldOptions[string]) godantic.FieldOptions[string]) (string, error) {
+	return Query(c, name, opts...)
+}
+
+// QueryBool is Query for bool parameters.
+func QueryBool(c *gin.Context, name string, opts ...func(godantic.FieldOptions[bool]) godantic.FieldOptions[bool]) (bool, error) {
+	return Query(c, name, opts...)
+}
+
+// validateParam runs godantic.ValidateParam, keeping a nil error interface on success
+func validateParam[T any](name string, values []string, opts []func(godantic.FieldOptions[T]) godantic.FieldOptions[T]) (T, error) {
+	value, errs := godantic.ValidateParam(name, values, opts...)
+	if len(errs) > 0 {
+		return value, errs
+	}
+	return value, nil
+}
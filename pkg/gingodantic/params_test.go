@@ -0,0 +1,102 @@
+package gingodantic_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/gingodantic"
+	"github.com/deepankarm/godantic/pkg/godantic"
+	"github.com/gin-gonic/gin"
+)
+
+func TestStandaloneParams(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.GET("/items/:id", func(c *gin.Context) {
+		id, err := gingodantic.PathInt(c, "id", godantic.Min(1))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "validation failed", "details": err})
+			return
+		}
+		limit, err := gingodantic.QueryInt(c, "limit", godantic.Max(100), godantic.Default(10))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "validation failed", "details": err})
+			return
+		}
+		sort, err := gingodantic.QueryString(c, "sort", godantic.OneOf("asc", "desc"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "validation failed", "details": err})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"id": id, "limit": limit, "sort": sort})
+	})
+
+	tests := []struct {
+		name string
+		url  string
+		code int
+		body string
+	}{
+		{"valid", "/items/7?limit=5&sort=asc", http.StatusOK, `{"id":7,"limit":5,"sort":"asc"}`},
+		{"default when absent", "/items/7", http.StatusOK, `{"id":7,"limit":10,"sort":""}`},
+		{"path constraint", "/items/0", http.StatusBadRequest, ""},
+		{"path coercion", "/items/abc", http.StatusBadRequest, ""},
+		{"query constraint", "/items/7?limit=500", http.StatusBadRequest, ""},
+		{"query enum", "/items/7?sort=up", http.StatusBadRequest, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.url, nil))
+			if w.Code != tt.code {
+				t.Fatalf("expected %d, got %d: %s", tt.code, w.Code, w.Body.String())
+			}
+			if tt.body != "" && w.Body.String() != tt.body {
+				t.Errorf("expected body %s, got %s", tt.body, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestStandaloneParams_Errors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var got error
+	router := gin.New()
+	router.GET("/users/:id", func(c *gin.Context) {
+		_, got = gingodantic.PathInt(c, "id", godantic.Min(1))
+	})
+	router.GET("/search", func(c *gin.Context) {
+		_, got = gingodantic.QueryString(c, "q", godantic.Required[string]())
+	})
+
+	tests := []struct {
+		url     string
+		errType godantic.ErrorType
+	}{
+		{"/users/abc", godantic.ErrorTypeCoercion},
+		{"/users/0", godantic.ErrorTypeConstraint},
+		{"/search", godantic.ErrorTypeRequired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, tt.url, nil))
+
+			var errs godantic.ValidationErrors
+			if !errors.As(got, &errs) || len(errs) != 1 {
+				t.Fatalf("expected ValidationErrors with one error, got %v", got)
+			}
+			if errs[0].Type != tt.errType {
+				t.Errorf("expected %s, got %s: %v", tt.errType, errs[0].Type, errs[0])
+			}
+		})
+	}
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/users/3", nil))
+	if got != nil {
+		t.Errorf("expected nil error for a valid param, got %#v", got)
+	}
+}
@@ -1,8 +1,13 @@
 package gingodantic
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
+	"maps"
+	"mime"
 	"net/http"
 	"reflect"
 	"slices"
@@ -18,9 +23,15 @@ import (
 
 // API holds the OpenAPI specification
 type API struct {
-	mu        sync.RWMutex
-	endpoints map[string]*EndpointSpec // key: "METHOD /path"
-	info      APIInfo
+	mu             sync.RWMutex
+	endpoints      map[string]*EndpointSpec // key: "METHOD /path"
+	webhooks       map[string]*EndpointSpec // key: webhook name, e.g. "order.created"
+	tags           []TagSpec                // Declared tags, in registration order
+	info           APIInfo
+	openAPIVersion string
+	maxBodyBytes   int64   // Default request body limit for endpoints (0 = unlimited)
+	conflicts      []error // Registration mistakes reported by Validate
+	splitReadWrite bool    // Separate request and response variants of schemas with readOnly/writeOnly fields
 }
 
 type APIInfo struct {
@@ -29,6 +40,19 @@ type APIInfo struct {
 	Description string
 }
 
+// TagSpec describes a tag in the top-level OpenAPI "tags" array
+type TagSpec struct {
+	Name         string
+	Description  string
+	ExternalDocs *ExternalDocs
+}
+
+// ExternalDocs references external documentation
+type ExternalDocs struct {
+	URL         string
+	Description string
+}
+
 type EndpointSpec struct {
 	Method         string
 	Path           string
@@ -37,6 +61,13 @@ type EndpointSpec struct {
 	Tags           []string
 	Deprecated     bool
 	SkipValidation bool
+	MaxBodyBytes   int64 // Request body limit in bytes (0 = API default, <0 = unlimited)
+
+	// DecompressRequest decodes gzip and deflate bodies per Content-Encoding
+	DecompressRequest bool
+
+	// OptionalRequestBody documents the body as optional and accepts an empty one
+	OptionalRequestBody bool
 
 	// Type information for schema generation
 	RequestType     reflect.Type
@@ -44,6 +75,10 @@ type EndpointSpec struct {
 	Responses       map[int]ResponseSpec
 	RequestExamples map[string]any
 
+	// ParamExamples holds named examples for parameters, keyed by location
+	// ("path", "query", "header", "cookie") and then parameter name
+	ParamExamples map[string]map[string]map[string]any
+
 	// Internal validation functions
 	validators validators
 }
@@ -63,26 +98,109 @@ type validators struct {
 	path    func(map[string]string) (any, godantic.ValidationErrors)
 	header  func(map[string][]string) (any, godantic.ValidationErrors)
 	cookie  func(map[string]string) (any, godantic.ValidationErrors)
+
+	// decoders turn request bodies of other media types into JSON objects,
+	// keyed by lowercase media type (set by WithRequestContent)
+	decoders map[string]func([]byte) (map[string]any, error)
 }
 
 type ResponseSpec struct {
 	Type        reflect.Type
 	Description string
 	Examples    map[string]any // key: example name
+
+	// Content holds the types of the response in media types other than
+	// application/json, keyed by lowercase media type (set by WithResponseAs)
+	Content map[string]reflect.Type
 }
 
+// jsonMediaType is the media type of request and response bodies unless
+// another one is given
+const jsonMediaType = "application/json"
+
+// OpenAPI specification versions supported by GenerateOpenAPI
+const (
+	OpenAPIVersion30 = "3.0.3"
+	OpenAPIVersion31 = "3.1.0"
+)
+
 // New creates a new API instance
-func New(title, version string) *API {
-	return &API{
+func New(title, version string, opts ...APIOption) *API {
+	api := &API{
 		endpoints: make(map[string]*EndpointSpec),
+		webhooks:  make(map[string]*EndpointSpec),
 		info: APIInfo{
 			Title:   title,
 			Version: version,
 		},
+		openAPIVersion: OpenAPIVersion30,
+	}
+	for _, opt := range opts {
+		opt(api)
+	}
+	return api
+}
+
+// AddTag declares a tag with a description for the top-level "tags" array.
+// Operations keep referencing tags by name via WithTags. Adding a tag that
+// already exists replaces its metadata.
+//
+// Example:
+//
+//	api.AddTag("users", "User management",
+//	    gingodantic.WithTagExternalDocs("https://docs.example.com/users", "User guide"),
+//	)
+func (api *API) AddTag(name, description string, opts ...TagOption) {
+	tag := TagSpec{Name: name, Description: description}
+	for _, opt := range opts {
+		opt(&tag)
+	}
+
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	for i := range api.tags {
+		if api.tags[i].Name == name {
+			api.tags[i] = tag
+			return
+		}
+	}
+	api.tags = append(api.tags, tag)
+}
+
+// Webhook registers a webhook operation keyed by event name (OpenAPI 3.1).
+// Webhooks are documented under the top-level "webhooks" object and are not tied
+// to a Gin route. The request and response schemas are built exactly like regular
+// endpoints. Webhooks are only emitted when the API uses OpenAPI 3.1 (WithOpenAPI31).
+//
+// Example:
+//
+//	api := gingodantic.New("Shop API", "1.0.0", gingodantic.WithOpenAPI31())
+//	api.Webhook("order.created",
+//	    gingodantic.WithSummary("Order created"),
+//	    gingodantic.WithRequest[OrderEvent](),
+//	    gingodantic.WithResponse[Ack](200, "Acknowledged"),
+//	)
+func (api *API) Webhook(name string, opts ...SchemaOption) {
+	spec := &EndpointSpec{
+		Method:    http.MethodPost,
+		Responses: make(map[int]ResponseSpec),
+	}
+
+	for _, opt := range opts {
+		opt(spec)
+	}
+
+	api.mu.Lock()
+	if _, exists := api.webhooks[name]; exists {
+		api.conflicts = append(api.conflicts, fmt.Errorf("webhook %q is registered more than once", name))
 	}
+	api.webhooks[name] = spec
+	api.mu.Unlock()
 }
 
-// OpenAPISchema creates a middleware that registers endpoint schema and optionally validates
+// OpenAPISchema creates a middleware that registers endpoint schema and optionally validates.
+// Registering the same method and path again replaces the earlier schema;
+// Validate reports it, along with paths that repeat a parameter name.
 func (api *API) OpenAPISchema(method, path string, opts ...SchemaOption) gin.HandlerFunc {
 	spec := &EndpointSpec{
 		Method:    method,
@@ -95,13 +213,37 @@ func (api *API) OpenAPISchema(method, path string, opts ...SchemaOption) gin.Han
 	}
 
 	// Register the schema
-	key := method + " " + path
+	key := strings.ToUpper(method) + " " + path
 	api.mu.Lock()
+	if _, exists := api.endpoints[key]; exists {
+		api.conflicts = append(api.conflicts, fmt.Errorf("%s is registered more than once", key))
+	}
+	if name := repeatedPathParameter(path); name != "" {
+		api.conflicts = append(api.conflicts, fmt.Errorf("%s: path parameter %q appears more than once", key, name))
+	}
 	api.endpoints[key] = spec
 	api.mu.Unlock()
 
+	maxBodyBytes := spec.MaxBodyBytes
+	if maxBodyBytes == 0 {
+		maxBodyBytes = api.maxBodyBytes
+	}
+	successStatus := spec.successStatus()
+
 	// Return middleware that validates all parameters
 	return func(c *gin.Context) {
+		// Read by Handler to write the declared success status
+		c.Set(successStatusKey, successStatus)
+
+		// Limit the body before anything reads it, so handlers that skip
+		// validation are protected too
+		if maxBodyBytes > 0 && c.Request.Body != nil {
+			c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxBodyBytes)
+		}
+		if spec.DecompressRequest && !decompressBody(c, maxBodyBytes) {
+			return
+		}
+
 		if spec.SkipValidation {
 			c.Next()
 			return
@@ -114,7 +256,7 @@ func (api *API) OpenAPISchema(method, path string, opts ...SchemaOption) gin.Han
 				pathParams[param.Key] = param.Value
 			}
 			validated, errs := spec.validators.path(pathParams)
-			if !validateAndStore(c, "validated_path", validated, errs) {
+			if !validateAndStore(c, validatedPathKey, validated, errs) {
 				return
 			}
 		}
@@ -122,7 +264,7 @@ func (api *API) OpenAPISchema(method, path string, opts ...SchemaOption) gin.Han
 		// Validate header parameters
 		if spec.validators.header != nil {
 			validated, errs := spec.validators.header(c.Request.Header)
-			if !validateAndStore(c, "validated_headers", validated, errs) {
+			if !validateAndStore(c, validatedHeadersKey, validated, errs) {
 				return
 			}
 		}
@@ -134,7 +276,7 @@ func (api *API) OpenAPISchema(method, path string, opts ...SchemaOption) gin.Han
 				cookieParams[cookie.Name] = cookie.Value
 			}
 			validated, errs := spec.validators.cookie(cookieParams)
-			if !validateAndStore(c, "validated_cookies", validated, errs) {
+			if !validateAndStore(c, validatedCookiesKey, validated, errs) {
 				return
 			}
 		}
@@ -142,21 +284,27 @@ func (api *API) OpenAPISchema(method, path string, opts ...SchemaOption) gin.Han
 		// Validate query parameters
 		if spec.validators.query != nil {
 			validated, errs := spec.validators.query(c.Request.URL.Query())
-			if !validateAndStore(c, "validated_query", validated, errs) {
+			if !validateAndStore(c, validatedQueryKey, validated, errs) {
 				return
 			}
 		}
 
 		// Validate request body
 		if spec.validators.request != nil {
-			body, err := io.ReadAll(c.Request.Body)
-			if err != nil {
-				c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read request body"})
-				c.Abort()
+			body, ok := readBody(c)
+			if !ok {
+				return
+			}
+			// An optional body may be left out entirely
+			if spec.OptionalRequestBody && len(bytes.TrimSpace(body)) == 0 {
+				c.Next()
+				return
+			}
+			if body, ok = decodeBody(c, spec.validators.decoders, body); !ok {
 				return
 			}
 			validated, errs := spec.validators.request(body)
-			if !validateAndStore(c, "validated_request", validated, errs) {
+			if !validateAndStore(c, validatedRequestKey, validated, errs) {
 				return
 			}
 		}
@@ -165,9 +313,99 @@ func (api *API) OpenAPISchema(method, path string, opts ...SchemaOption) gin.Han
 	}
 }
 
+// decodeBody converts a body sent with a media type registered by
+// WithRequestContent to JSON. Other bodies are returned as is. Returns false if
+// decoding fails (and has already sent an error response).
+func decodeBody(c *gin.Context, decoders map[string]func([]byte) (map[string]any, error), body []byte) ([]byte, bool) {
+	if len(decoders) == 0 {
+		return body, true
+	}
+	mediaType, _, err := mime.ParseMediaType(c.GetHeader("Content-Type"))
+	decode, ok := decoders[mediaType]
+	if err != nil || !ok {
+		return body, true
+	}
+
+	decoded, err := decode(body)
+	if err == nil {
+		body, err = json.Marshal(decoded)
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "failed to decode request body",
+			"details": err.Error(),
+		})
+		c.Abort()
+		return nil, false
+	}
+	return body, true
+}
+
+// readBody reads the request body and puts it back for handlers that read it
+// again. Returns false if the body is too large or can't be read (and has
+// already sent an error response).
+func readBody(c *gin.Context) ([]byte, bool) {
+	if c.Request.Body == nil {
+		return nil, true
+	}
+	body, err := io.ReadAll(c.Request.Body)
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{
+			"error":     "request body too large",
+			"max_bytes": maxBytesErr.Limit,
+		})
+		c.Abort()
+		return nil, false
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "failed to read request body"})
+		c.Abort()
+		return nil, false
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+	return body, true
+}
+
+// bindValidators caches the validators used by BindAndValidate, per type
+var bindValidators sync.Map // map[reflect.Type]any (*godantic.Validator[T])
+
+// BindAndValidate reads and validates the JSON request body as T, for handlers
+// that can't use the OpenAPISchema middleware. On failure it writes the same
+// error response as the middleware (400 with the validation errors, or 413
+// for a body over an http.MaxBytesReader limit), aborts, and returns false.
+// The validated value is also available to GetValidated, and the body can be
+// read again.
+//
+// Example:
+//
+//	router.POST("/users", func(c *gin.Context) {
+//	    user, ok := gingodantic.BindAndValidate[User](c)
+//	    if !ok {
+//	        return
+//	    }
+//	    c.JSON(http.StatusCreated, user)
+//	})
+func BindAndValidate[T any](c *gin.Context) (*T, bool) {
+	body, ok := readBody(c)
+	if !ok {
+		return nil, false
+	}
+	typ := reflect.TypeFor[T]()
+	cached, ok := bindValidators.Load(typ)
+	if !ok {
+		cached, _ = bindValidators.LoadOrStore(typ, godantic.NewValidator[T]())
+	}
+	validated, errs := cached.(*godantic.Validator[T]).Unmarshal(body)
+	if !validateAndStore(c, validatedRequestKey, validated, errs) {
+		return nil, false
+	}
+	return validated, true
+}
+
 // validateAndStore is a helper that validates data and stores it in context
 // Returns false if validation failed (and has already sent error response)
-func validateAndStore(c *gin.Context, contextKey string, validated any, validationErrs godantic.ValidationErrors) bool {
+func validateAndStore(c *gin.Context, key contextKey, validated any, validationErrs godantic.ValidationErrors) bool {
 	if validationErrs != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   "validation failed",
@@ -176,14 +414,32 @@ func validateAndStore(c *gin.Context, contextKey string, validated any, validati
 		c.Abort()
 		return false
 	}
-	c.Set(contextKey, validated)
+	c.Set(key, validated)
+	c.Set(string(key), validated) // Deprecated string key, kept for handlers reading it directly
 	return true
 }
 
+// contextKey is the type of the gin context keys validated data is stored
+// under, so values set by other middleware under the same string can't
+// replace it
+type contextKey string
+
+// Context keys read by the GetValidated functions. The data is also stored
+// under the plain string keys ("validated_request", ...) for compatibility,
+// but those can be overwritten by any c.Set.
+const (
+	validatedRequestKey contextKey = "validated_request"
+	validatedQueryKey   contextKey = "validated_query"
+	validatedPathKey    contextKey = "validated_path"
+	validatedHeadersKey contextKey = "validated_headers"
+	validatedCookiesKey contextKey = "validated_cookies"
+	successStatusKey    contextKey = "success_status"
+)
+
 // GetValidated retrieves validated request data from context
 // Use this in your handlers to get the validated and unmarshaled request
 func GetValidated[T any](c *gin.Context) (*T, bool) {
-	val, exists := c.Get("validated_request")
+	val, exists := c.Get(validatedRequestKey)
 	if !exists {
 		return nil, false
 	}
@@ -194,7 +450,7 @@ func GetValidated[T any](c *gin.Context) (*T, bool) {
 // GetValidatedQuery retrieves validated query parameters from context
 // Use this in your handlers to get the validated query params
 func GetValidatedQuery[T any](c *gin.Context) (*T, bool) {
-	val, exists := c.Get("validated_query")
+	val, exists := c.Get(validatedQueryKey)
 	if !exists {
 		return nil, false
 	}
@@ -205,7 +461,7 @@ func GetValidatedQuery[T any](c *gin.Context) (*T, bool) {
 // GetValidatedPath retrieves validated path parameters from context
 // Use this in your handlers to get the validated path params
 func GetValidatedPath[T any](c *gin.Context) (*T, bool) {
-	val, exists := c.Get("validated_path")
+	val, exists := c.Get(validatedPathKey)
 	if !exists {
 		return nil, false
 	}
@@ -216,7 +472,7 @@ func GetValidatedPath[T any](c *gin.Context) (*T, bool) {
 // GetValidatedHeaders retrieves validated header parameters from context
 // Use this in your handlers to get the validated headers
 func GetValidatedHeaders[T any](c *gin.Context) (*T, bool) {
-	val, exists := c.Get("validated_headers")
+	val, exists := c.Get(validatedHeadersKey)
 	if !exists {
 		return nil, false
 	}
@@ -227,7 +483,7 @@ func GetValidatedHeaders[T any](c *gin.Context) (*T, bool) {
 // GetValidatedCookies retrieves validated cookie parameters from context
 // Use this in your handlers to get the validated cookies
 func GetValidatedCookies[T any](c *gin.Context) (*T, bool) {
-	val, exists := c.Get("validated_cookies")
+	val, exists := c.Get(validatedCookiesKey)
 	if !exists {
 		return nil, false
 	}
@@ -235,6 +491,35 @@ func GetValidatedCookies[T any](c *gin.Context) (*T, bool) {
 	return typed, ok
 }
 
+// Validate reports registration mistakes that would otherwise produce a wrong
+// spec: a method and path or a webhook registered more than once (the last
+// registration wins), and paths that repeat a parameter name. Call it after
+// registering routes, before serving.
+//
+// Example:
+//
+//	if err := api.Validate(); err != nil {
+//	    log.Fatal(err)
+//	}
+func (api *API) Validate() error {
+	api.mu.RLock()
+	defer api.mu.RUnlock()
+	return errors.Join(api.conflicts...)
+}
+
+// repeatedPathParameter returns the first parameter name that appears more
+// than once in a Gin path, or "" if there is none.
+func repeatedPathParameter(path string) string {
+	seen := make(map[string]bool)
+	for _, name := range ExtractPathParameters(ConvertGinPathToOpenAPI(path)) {
+		if seen[name] {
+			return name
+		}
+		seen[name] = true
+	}
+	return ""
+}
+
 // OpenAPIHandler returns a handler that serves the OpenAPI spec
 func (api *API) OpenAPIHandler() gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -243,7 +528,7 @@ func (api *API) OpenAPIHandler() gin.HandlerFunc {
 	}
 }
 
-// GenerateOpenAPI generates the OpenAPI 3.0 specification
+// GenerateOpenAPI generates the OpenAPI specification (3.0 by default, 3.1 with WithOpenAPI31)
 func (api *API) GenerateOpenAPI() map[string]any {
 	api.mu.RLock()
 	defer api.mu.RUnlock()
@@ -253,7 +538,10 @@ func (api *API) GenerateOpenAPI() map[string]any {
 		"schemas": make(map[string]any),
 	}
 
-	for _, endpoint := range api.endpoints {
+	// Visit endpoints in a fixed order so conflicting schema names are
+	// suffixed the same way on every call
+	for _, key := range slices.Sorted(maps.Keys(api.endpoints)) {
+		endpoint := api.endpoints[key]
 		openAPIPath := ConvertGinPathToOpenAPI(endpoint.Path)
 
 		pathItem := paths[openAPIPath]
@@ -267,8 +555,19 @@ func (api *API) GenerateOpenAPI() map[string]any {
 		pathItem.(map[string]any)[method] = operation
 	}
 
-	return map[string]any{
-		"openapi": "3.0.3",
+	// OpenAPI 3.0 has no null type, so nullable schemas use "nullable: true"
+	if api.openAPIVersion == OpenAPIVersion30 {
+		schemas := components["schemas"].(map[string]any)
+		for name, def := range schemas {
+			schemas[name] = nullableForOpenAPI30(def)
+		}
+		for path, pathItem := range paths {
+			paths[path] = operationSchemasForOpenAPI30(pathItem)
+		}
+	}
+
+	result := map[string]any{
+		"openapi": api.openAPIVersion,
 		"info": map[string]any{
 			"title":       api.info.Title,
 			"version":     api.info.Version,
@@ -277,6 +576,66 @@ func (api *API) GenerateOpenAPI() map[string]any {
 		"paths":      paths,
 		"components": components,
 	}
+
+	if tags := api.buildTags(); len(tags) > 0 {
+		result["tags"] = tags
+	}
+
+	// Webhooks are an OpenAPI 3.1 feature
+	if api.openAPIVersion == OpenAPIVersion31 && len(api.webhooks) > 0 {
+		webhooks := make(map[string]any, len(api.webhooks))
+		for _, name := range slices.Sorted(maps.Keys(api.webhooks)) {
+			webhook := api.webhooks[name]
+			method := strings.ToLower(webhook.Method)
+			webhooks[name] = map[string]any{
+				method: api.buildOperation(webhook, "", components),
+			}
+		}
+		result["webhooks"] = webhooks
+	}
+
+	return result
+}
+
+// buildTags creates the top-level tags array.
+// Declared tags come first in registration order, followed by any tags
+// used on operations without being declared (sorted by name).
+func (api *API) buildTags() []any {
+	declared := make(map[string]bool, len(api.tags))
+	tags := make([]any, 0, len(api.tags))
+	for _, tag := range api.tags {
+		declared[tag.Name] = true
+		tagObj := map[string]any{"name": tag.Name}
+		if tag.Description != "" {
+			tagObj["description"] = tag.Description
+		}
+		if tag.ExternalDocs != nil {
+			docs := map[string]any{"url": tag.ExternalDocs.URL}
+			if tag.ExternalDocs.Description != "" {
+				docs["description"] = tag.ExternalDocs.Description
+			}
+			tagObj["externalDocs"] = docs
+		}
+		tags = append(tags, tagObj)
+	}
+
+	var undeclared []string
+	for _, specs := range []map[string]*EndpointSpec{api.endpoints, api.webhooks} {
+		for _, endpoint := range specs {
+			for _, name := range endpoint.Tags {
+				if !declared[name] {
+					declared[name] = true
+					undeclared = append(undeclared, name)
+				}
+			}
+		}
+	}
+	slices.Sort(undeclared)
+	for _, name := range undeclared {
+		tags = append(tags, map[string]any{"name": name})
+	}
+
+	return tags
 }
 
 // buildOperation creates an OpenAPI operation object for an endpoint
@@ -345,6 +704,17 @@ func (api *API) collectParameters(endpoint *EndpointSpec, openAPIPath string) []
 		parameters = append(parameters, extractParametersFromType(endpoint.ParamTypes.Query, "query", nil)...)
 	}
 
+	// Per-route examples replace the single example from a field's constraint
+	for _, p := range parameters {
+		param := p.(map[string]any)
+		location, _ := param["in"].(string)
+		name, _ := param["name"].(string)
+		if examples, ok := endpoint.ParamExamples[location][name]; ok {
+			delete(param, "example")
+			param["examples"] = examples
+		}
+	}
+
 	return parameters
 }
 
@@ -358,64 +728,283 @@ func (api *API) buildRequestBody(endpoint *EndpointSpec, components map[string]a
 	if err != nil {
 		return nil
 	}
-
-	// Extract and store schema definitions
-	if defs, ok := flattenedSchema["$defs"].(map[string]any); ok {
-		for name, def := range defs {
-			components["schemas"].(map[string]any)[name] = FixSchemaRefs(def)
-		}
+	if api.splitReadWrite {
+		flattenedSchema = schemaVariant(flattenedSchema, godantic.ConstraintReadOnly, "Input")
 	}
 
 	content := map[string]any{
-		"schema": removeDefsFromSchema(flattenedSchema),
+		"schema": mergeComponentSchemas(components["schemas"].(map[string]any), flattenedSchema),
 	}
 	if len(endpoint.RequestExamples) > 0 {
 		content["examples"] = endpoint.RequestExamples
 	}
 
+	mediaTypes := map[string]any{jsonMediaType: content}
+	for mediaType := range endpoint.validators.decoders {
+		mediaTypes[mediaType] = content
+	}
+
 	return map[string]any{
-		"required": true,
-		"content": map[string]any{
-			"application/json": content,
-		},
+		"required": !endpoint.OptionalRequestBody,
+		"content":  mediaTypes,
 	}
 }
 
-// buildResponses creates the responses object for an endpoint
+// buildResponses creates the responses object for an endpoint. A response
+// lists its JSON type, if any, and its other media types under content.
 func (api *API) buildResponses(endpoint *EndpointSpec, components map[string]any) map[string]any {
 	responses := make(map[string]any)
 
-	for statusCode, resp := range endpoint.Responses {
-		flattenedSchema, err := generateSchemaFromType(resp.Type)
-		if err != nil {
-			continue
+	for _, statusCode := range slices.Sorted(maps.Keys(endpoint.Responses)) {
+		resp := endpoint.Responses[statusCode]
+		types := maps.Clone(resp.Content)
+		if resp.Type != nil {
+			if types == nil {
+				types = make(map[string]reflect.Type)
+			}
+			types[jsonMediaType] = resp.Type
 		}
 
-		// Extract and store schema definitions
-		if defs, ok := flattenedSchema["$defs"].(map[string]any); ok {
-			for name, def := range defs {
-				components["schemas"].(map[string]any)[name] = FixSchemaRefs(def)
+		mediaTypes := make(map[string]any)
+		for mediaType, typ := range types {
+			flattenedSchema, err := generateSchemaFromType(typ)
+			if err != nil {
+				continue
+			}
+			if api.splitReadWrite {
+				flattenedSchema = schemaVariant(flattenedSchema, godantic.ConstraintWriteOnly, "Output")
 			}
-		}
 
-		content := map[string]any{
-			"schema": removeDefsFromSchema(flattenedSchema),
+			content := map[string]any{
+				"schema": mergeComponentSchemas(components["schemas"].(map[string]any), flattenedSchema),
+			}
+			if mediaType == jsonMediaType && len(resp.Examples) > 0 {
+				content["examples"] = resp.Examples
+			}
+			mediaTypes[mediaType] = content
 		}
-		if len(resp.Examples) > 0 {
-			content["examples"] = resp.Examples
+		if len(mediaTypes) == 0 {
+			continue
 		}
 
 		responses[strconv.Itoa(statusCode)] = map[string]any{
 			"description": resp.Description,
-			"content": map[string]any{
-				"application/json": content,
-			},
+			"content":     mediaTypes,
 		}
 	}
 
 	return responses
 }
 
+// mergeComponentSchemas moves the $defs of a generated schema into
+// components.schemas and returns the schema without them.
+//
+// Definitions are shared by name, so two Go types with the same name (say
+// billing.Address and shipping.Address) would otherwise overwrite each other.
+// A definition that differs from the one already registered under its name is
+// stored under the first free name with a numeric suffix (Address2, Address3,
+// ...) and the refs to it are rewritten. Identical definitions are shared.
+func mergeComponentSchemas(schemas map[string]any, flattenedSchema map[string]any) map[string]any {
+	defs, _ := flattenedSchema["$defs"].(map[string]any)
+	names := slices.Sorted(maps.Keys(defs))
+
+	// Renaming a definition changes the refs in the ones that use it, which
+	// can in turn make those differ from what is registered, so repeat until
+	// nothing new conflicts
+	renames := make(map[string]string)
+	for changed := true; changed; {
+		changed = false
+		for _, name := range names {
+			if _, ok := renames[name]; ok {
+				continue
+			}
+			def := renameComponentRefs(FixSchemaRefs(defs[name]), renames)
+			if existing, ok := schemas[name]; ok && !reflect.DeepEqual(existing, def) {
+				renames[name] = freeComponentName(schemas, defs, name, def)
+				changed = true
+			}
+		}
+	}
+
+	for _, name := range names {
+		target := name
+		if renamed, ok := renames[name]; ok {
+			target = renamed
+		}
+		schemas[target] = renameComponentRefs(FixSchemaRefs(defs[name]), renames)
+	}
+	return renameComponentRefs(removeDefsFromSchema(flattenedSchema), renames).(map[string]any)
+}
+
+// schemaVariant returns the request or response variant of a generated
+// schema: properties marked with hidden (readOnly for requests, writeOnly for
+// responses) are dropped, along with their required entries. Definitions that
+// change, directly or through a ref to another changed definition, are renamed
+// with suffix so both variants can live in components.schemas.
+func schemaVariant(flattenedSchema map[string]any, hidden, suffix string) map[string]any {
+	variant := dropMarkedProperties(flattenedSchema, hidden).(map[string]any)
+	defs, _ := variant["$defs"].(map[string]any)
+	if len(defs) == 0 {
+		return variant
+	}
+	original, _ := flattenedSchema["$defs"].(map[string]any)
+
+	renames := make(map[string]string)
+	for changed := true; changed; {
+		changed = false
+		for _, name := range slices.Sorted(maps.Keys(defs)) {
+			if _, ok := renames[name]; ok {
+				continue
+			}
+			if !reflect.DeepEqual(renameComponentRefs(defs[name], renames), original[name]) {
+				renames[name] = name + suffix
+				changed = true
+			}
+		}
+	}
+	if len(renames) == 0 {
+		return variant
+	}
+
+	variant = renameComponentRefs(variant, renames).(map[string]any)
+	renamedDefs := make(map[string]any, len(defs))
+	for name, def := range variant["$defs"].(map[string]any) {
+		if renamed, ok := renames[name]; ok {
+			name = renamed
+		}
+		renamedDefs[name] = def
+	}
+	variant["$defs"] = renamedDefs
+	return variant
+}
+
+// dropMarkedProperties copies a schema without the properties whose schema
+// sets the boolean keyword to true
+func dropMarkedProperties(data any, keyword string) any {
+	switch v := data.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, value := range v {
+			result[key] = dropMarkedProperties(value, keyword)
+		}
+		props, ok := result["properties"].(map[string]any)
+		if !ok {
+			return result
+		}
+		var dropped []string
+		for name, prop := range props {
+			if propSchema, ok := prop.(map[string]any); ok && propSchema[keyword] == true {
+				delete(props, name)
+				dropped = append(dropped, name)
+			}
+		}
+		if len(dropped) > 0 {
+			result["required"] = withoutRequired(result["required"], dropped)
+			if result["required"] == nil {
+				delete(result, "required")
+			}
+		}
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, item := range v {
+			result[i] = dropMarkedProperties(item, keyword)
+		}
+		return result
+	default:
+		return v
+	}
+}
+
+// withoutRequired returns a required list without the dropped names, or nil
+// when none are left
+func withoutRequired(required any, dropped []string) any {
+	var names []string
+	switch v := required.(type) {
+	case []string:
+		names = v
+	case []any:
+		for _, item := range v {
+			if name, ok := item.(string); ok {
+				names = append(names, name)
+			}
+		}
+	}
+	var kept []string
+	for _, name := range names {
+		if !slices.Contains(dropped, name) {
+			kept = append(kept, name)
+		}
+	}
+	if len(kept) == 0 {
+		return nil
+	}
+	return kept
+}
+
+// freeComponentName returns the first suffixed name that is unused or already
+// holds def, skipping names taken by the definitions being merged
+func freeComponentName(schemas, defs map[string]any, name string, def any) string {
+	for i := 2; ; i++ {
+		candidate := name + strconv.Itoa(i)
+		if _, taken := defs[candidate]; taken {
+			continue
+		}
+		existing, ok := schemas[candidate]
+		if !ok || reflect.DeepEqual(existing, def) {
+			return candidate
+		}
+	}
+}
+
+// renameComponentRefs rewrites #/components/schemas/ refs, including those in
+// discriminator mappings, according to renames
+func renameComponentRefs(data any, renames map[string]string) any {
+	if len(renames) == 0 {
+		return data
+	}
+	rename := func(ref string) string {
+		if name, ok := strings.CutPrefix(ref, "#/components/schemas/"); ok {
+			if renamed, ok := renames[name]; ok {
+				return "#/components/schemas/" + renamed
+			}
+		}
+		return ref
+	}
+	switch v := data.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, value := range v {
+			if ref, ok := value.(string); ok && key == "$ref" {
+				result[key] = rename(ref)
+				continue
+			}
+			if disc, ok := value.(map[string]any); ok && key == "discriminator" && isDiscriminatorObject(disc) {
+				disc = renameComponentRefs(disc, renames).(map[string]any)
+				if mapping, ok := disc["mapping"].(map[string]any); ok {
+					for discValue, ref := range mapping {
+						if refStr, ok := ref.(string); ok {
+							mapping[discValue] = rename(refStr)
+						}
+					}
+				}
+				result[key] = disc
+				continue
+			}
+			result[key] = renameComponentRefs(value, renames)
+		}
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, item := range v {
+			result[i] = renameComponentRefs(item, renames)
+		}
+		return result
+	default:
+		return v
+	}
+}
+
 // removeDefsFromSchema removes $defs from a schema since we move them to components
 func removeDefsFromSchema(s map[string]any) map[string]any {
 	result := make(map[string]any)
@@ -491,7 +1080,7 @@ func extractParametersFromType(t reflect.Type, paramLocation string, paramNames
 	for i := 0; i < t.NumField(); i++ {
 		field := t.Field(i)
 		jsonTag := field.Tag.Get("json")
-		if jsonTag == "" || jsonTag == "-" {
+		if jsonTag == "" || jsonTag == "-" || !field.IsExported() {
 			continue
 		}
 		jsonFieldName := strings.Split(jsonTag, ",")[0]
@@ -504,11 +1093,17 @@ func extractParametersFromType(t reflect.Type, paramLocation string, paramNames
 		paramSchema := map[string]any{
 			"type": reflectutil.JSONSchemaType(field.Type),
 		}
+		if reflectutil.IsDuration(reflectutil.UnwrapPointer(field.Type)) {
+			paramSchema["format"] = "duration"
+		}
 
 		required := false
 		if hasOpts {
 			applyConstraintsToParamSchema(paramSchema, fieldOpts.Constraints)
 			required = fieldOpts.Required
+			if override, ok := fieldOpts.Constraints[godantic.ConstraintSchema].(map[string]any); ok {
+				paramSchema = maps.Clone(override)
+			}
 		}
 
 		param := map[string]any{
@@ -525,6 +1120,9 @@ func extractParametersFromType(t reflect.Type, paramLocation string, paramNames
 			if desc, ok := fieldOpts.Constraints["description"].(string); ok {
 				param["description"] = desc
 			}
+			if example, ok := fieldOpts.Constraints["example"]; ok {
+				param["example"] = example
+			}
 		}
 
 		params = append(params, param)
@@ -536,13 +1134,16 @@ func extractParametersFromType(t reflect.Type, paramLocation string, paramNames
 // applyConstraintsToParamSchema applies godantic constraints to an OpenAPI parameter schema
 func applyConstraintsToParamSchema(paramSchema map[string]any, constraints map[string]any) {
 	constraintMap := map[string]string{
-		"default":   "default",
-		"minimum":   "minimum",
-		"maximum":   "maximum",
-		"minLength": "minLength",
-		"maxLength": "maxLength",
-		"pattern":   "pattern",
-		"enum":      "enum",
+		"default":     "default",
+		"minimum":     "minimum",
+		"maximum":     "maximum",
+		"minLength":   "minLength",
+		"maxLength":   "maxLength",
+		"pattern":     "pattern",
+		"enum":        "enum",
+		"minItems":    "minItems",
+		"maxItems":    "maxItems",
+		"uniqueItems": "uniqueItems",
 	}
 
 	for godanticKey, openAPIKey := range constraintMap {
@@ -566,12 +1167,18 @@ func FixSchemaRefs(data any) any {
 				if refStr, ok := value.(string); ok {
 					// Convert #/$defs/TypeName to #/components/schemas/TypeName
 					if strings.HasPrefix(refStr, "#/$defs/") {
-						refStr = "#/components/schemas/" + refStr[len("#/$defs/"):]
-						result[key] = refStr
+						result[key] = componentRef(refStr)
 						continue
 					}
 				}
 			}
+			// Discriminator mappings hold refs as plain values
+			if key == "discriminator" {
+				if disc, ok := value.(map[string]any); ok && isDiscriminatorObject(disc) {
+					result[key] = fixDiscriminatorMapping(disc)
+					continue
+				}
+			}
 			result[key] = FixSchemaRefs(value)
 		}
 		return result
@@ -586,6 +1193,124 @@ func FixSchemaRefs(data any) any {
 	}
 }
 
+// isDiscriminatorObject tells an OpenAPI discriminator object from a property
+// that happens to be named "discriminator"
+func isDiscriminatorObject(m map[string]any) bool {
+	_, ok := m["propertyName"].(string)
+	return ok
+}
+
+// fixDiscriminatorMapping converts the refs in an OpenAPI discriminator mapping
+func fixDiscriminatorMapping(disc map[string]any) map[string]any {
+	result := make(map[string]any, len(disc))
+	for key, value := range disc {
+		result[key] = value
+	}
+	if mapping, ok := disc["mapping"].(map[string]any); ok {
+		fixed := make(map[string]any, len(mapping))
+		for discValue, ref := range mapping {
+			if refStr, ok := ref.(string); ok {
+				ref = componentRef(refStr)
+			}
+			fixed[discValue] = ref
+		}
+		result["mapping"] = fixed
+	}
+	return result
+}
+
+// operationSchemasForOpenAPI30 applies nullableForOpenAPI30 to the schemas
+// of an OpenAPI object, leaving examples untouched
+func operationSchemasForOpenAPI30(data any) any {
+	switch v := data.(type) {
+	case map[string]any:
+		for key, value := range v {
+			switch key {
+			case "schema":
+				v[key] = nullableForOpenAPI30(value)
+			case "example", "examples":
+			default:
+				v[key] = operationSchemasForOpenAPI30(value)
+			}
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = operationSchemasForOpenAPI30(item)
+		}
+	}
+	return data
+}
+
+// nullableForOpenAPI30 rewrites the JSON Schema form of a nullable value,
+// {"anyOf": [<schema>, {"type": "null"}]}, as <schema> with "nullable: true".
+// An enum also lists null, and a $ref is wrapped in allOf since 3.0 ignores
+// keywords next to it.
+func nullableForOpenAPI30(data any) any {
+	switch v := data.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, value := range v {
+			switch key {
+			case "example", "examples", "default", "const", "enum":
+				result[key] = value // Values, not schemas
+			case "properties":
+				props, ok := value.(map[string]any)
+				if !ok {
+					result[key] = value
+					continue
+				}
+				converted := make(map[string]any, len(props))
+				for name, prop := range props {
+					converted[name] = nullableForOpenAPI30(prop)
+				}
+				result[key] = converted
+			default:
+				result[key] = nullableForOpenAPI30(value)
+			}
+		}
+		anyOf, ok := result["anyOf"].([]any)
+		if !ok || len(anyOf) != 2 {
+			return result
+		}
+		inner, innerOK := anyOf[0].(map[string]any)
+		null, nullOK := anyOf[1].(map[string]any)
+		if !innerOK || !nullOK || len(null) != 1 || null["type"] != "null" {
+			return result
+		}
+		delete(result, "anyOf")
+		if _, isRef := inner["$ref"]; isRef {
+			result["allOf"] = []any{inner}
+		} else {
+			for key, value := range inner {
+				if _, taken := result[key]; !taken {
+					result[key] = value
+				}
+			}
+			if enum, ok := result["enum"].([]any); ok && !slices.Contains(enum, nil) {
+				result["enum"] = append(slices.Clip(enum), nil)
+			}
+		}
+		result["nullable"] = true
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, item := range v {
+			result[i] = nullableForOpenAPI30(item)
+		}
+		return result
+	default:
+		return v
+	}
+}
+
+// componentRef converts a #/$defs/TypeName ref to #/components/schemas/TypeName
+func componentRef(ref string) string {
+	if strings.HasPrefix(ref, "#/$defs/") {
+		return "#/components/schemas/" + ref[len("#/$defs/"):]
+	}
+	return ref
+}
+
 // generateSchemaFromType generates a JSON schema from a reflect.Type
 // Uses godantic's schema package which includes validation metadata
 func generateSchemaFromType(t reflect.Type) (map[string]any, error) {
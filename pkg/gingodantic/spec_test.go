@@ -3,8 +3,12 @@ package gingodantic_test
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/deepankarm/godantic/pkg/gingodantic"
@@ -359,6 +363,208 @@ func TestValidationDisabled(t *testing.T) {
 	}
 }
 
+func TestOptionalRequestBody(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	api := gingodantic.New("Test API", "1.0.0")
+
+	var gotBody bool
+	router.PATCH("/users/:id",
+		api.OpenAPISchema("PATCH", "/users/:id",
+			gingodantic.WithRequest[TestRequest](),
+			gingodantic.WithOptionalRequestBody(),
+		),
+		func(c *gin.Context) {
+			_, gotBody = gingodantic.GetValidated[TestRequest](c)
+			c.JSON(200, gin.H{"success": true})
+		},
+	)
+	api.OpenAPISchema("POST", "/users", gingodantic.WithRequest[TestRequest]())
+
+	t.Run("spec marks the body optional", func(t *testing.T) {
+		paths := api.GenerateOpenAPI()["paths"].(map[string]any)
+		patch := paths["/users/{id}"].(map[string]any)["patch"].(map[string]any)
+		if required := patch["requestBody"].(map[string]any)["required"]; required != false {
+			t.Errorf("Expected requestBody.required false, got %v", required)
+		}
+		post := paths["/users"].(map[string]any)["post"].(map[string]any)
+		if required := post["requestBody"].(map[string]any)["required"]; required != true {
+			t.Errorf("Expected requestBody.required true by default, got %v", required)
+		}
+	})
+
+	for name, body := range map[string]string{"empty body passes": "", "whitespace body passes": "  \n"} {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest("PATCH", "/users/1", bytes.NewBufferString(body))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != 200 {
+				t.Errorf("Expected status 200, got %d. Body: %s", w.Code, w.Body.String())
+			}
+			if gotBody {
+				t.Error("Expected no validated request for an empty body")
+			}
+		})
+	}
+
+	t.Run("non-empty body is validated", func(t *testing.T) {
+		req := httptest.NewRequest("PATCH", "/users/1", bytes.NewBufferString(`{"email":"john@example.com"}`))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if w.Code != 400 {
+			t.Errorf("Expected status 400, got %d", w.Code)
+		}
+	})
+}
+
+func TestBindAndValidate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	var reread string
+	router.POST("/users", func(c *gin.Context) {
+		user, ok := gingodantic.BindAndValidate[TestRequest](c)
+		if !ok {
+			return
+		}
+		raw, _ := io.ReadAll(c.Request.Body)
+		reread = string(raw)
+		if stored, _ := gingodantic.GetValidated[TestRequest](c); stored != user {
+			c.JSON(500, gin.H{"error": "validated request not stored"})
+			return
+		}
+		c.JSON(201, gin.H{"name": user.Name})
+	})
+	router.POST("/limited", func(c *gin.Context) {
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, 8)
+		if _, ok := gingodantic.BindAndValidate[TestRequest](c); ok {
+			c.JSON(201, gin.H{})
+		}
+	})
+
+	post := func(path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", path, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("valid body reaches the handler", func(t *testing.T) {
+		body := `{"name":"John Doe","email":"john@example.com","age":25}`
+		w := post("/users", body)
+		if w.Code != 201 {
+			t.Fatalf("Expected status 201, got %d. Body: %s", w.Code, w.Body.String())
+		}
+		if reread != body {
+			t.Errorf("Expected the body to be readable again, got %q", reread)
+		}
+	})
+
+	t.Run("invalid body gets the middleware's error response", func(t *testing.T) {
+		w := post("/users", `{"name":"Jo","email":"john@example.com"}`)
+		if w.Code != 400 {
+			t.Fatalf("Expected status 400, got %d", w.Code)
+		}
+		var resp map[string]any
+		json.Unmarshal(w.Body.Bytes(), &resp)
+		if resp["error"] != "validation failed" || resp["details"] == nil {
+			t.Errorf("Expected a validation failed envelope with details, got %v", resp)
+		}
+	})
+
+	t.Run("oversized body is rejected", func(t *testing.T) {
+		w := post("/limited", `{"name":"John Doe","email":"john@example.com"}`)
+		if w.Code != http.StatusRequestEntityTooLarge {
+			t.Errorf("Expected status 413, got %d", w.Code)
+		}
+	})
+}
+
+// decodeKeyValues is a toy decoder for "key=value; key=value" bodies
+func decodeKeyValues(body []byte) (map[string]any, error) {
+	m := make(map[string]any)
+	for _, pair := range strings.Split(string(body), ";") {
+		key, value, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok {
+			return nil, fmt.Errorf("malformed pair %q", pair)
+		}
+		if n, err := strconv.Atoi(value); err == nil {
+			m[key] = n
+		} else {
+			m[key] = value
+		}
+	}
+	return m, nil
+}
+
+func TestRequestContent(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	api := gingodantic.New("Test API", "1.0.0")
+
+	router.POST("/users",
+		api.OpenAPISchema("POST", "/users",
+			gingodantic.WithRequest[TestRequest](),
+			gingodantic.WithRequestContent("application/x-kv", decodeKeyValues),
+		),
+		func(c *gin.Context) {
+			req, _ := gingodantic.GetValidated[TestRequest](c)
+			c.JSON(200, gin.H{"name": req.Name, "age": req.Age})
+		},
+	)
+
+	post := func(contentType, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", "/users", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", contentType)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("registered media type is decoded and validated", func(t *testing.T) {
+		w := post("application/x-kv; charset=utf-8", "name=John Doe; email=john@example.com; age=25")
+		if w.Code != 200 || w.Body.String() != `{"age":25,"name":"John Doe"}` {
+			t.Errorf("Expected the decoded request, got %d: %s", w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("field options apply to the decoded body", func(t *testing.T) {
+		w := post("application/x-kv", "name=Jo; email=john@example.com; age=25")
+		if w.Code != 400 || !strings.Contains(w.Body.String(), "validation failed") {
+			t.Errorf("Expected a validation error, got %d: %s", w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("decode errors are rejected", func(t *testing.T) {
+		w := post("application/x-kv", "name")
+		if w.Code != 400 || !strings.Contains(w.Body.String(), "failed to decode request body") {
+			t.Errorf("Expected a decode error, got %d: %s", w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("JSON still works", func(t *testing.T) {
+		w := post("application/json", `{"name":"John Doe","email":"john@example.com","age":30}`)
+		if w.Code != 200 {
+			t.Errorf("Expected status 200, got %d: %s", w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("spec lists every media type", func(t *testing.T) {
+		spec := api.GenerateOpenAPI()
+		post := spec["paths"].(map[string]any)["/users"].(map[string]any)["post"].(map[string]any)
+		content := post["requestBody"].(map[string]any)["content"].(map[string]any)
+		for _, mediaType := range []string{"application/json", "application/x-kv"} {
+			if media, ok := content[mediaType].(map[string]any); !ok || media["schema"] == nil {
+				t.Errorf("Expected a schema for %s, got %v", mediaType, content)
+			}
+		}
+	})
+}
+
 func TestGetValidated(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 	c, _ := gin.CreateTestContext(httptest.NewRecorder())
@@ -370,20 +576,55 @@ func TestGetValidated(t *testing.T) {
 		}
 	})
 
-	t.Run("returns value when set", func(t *testing.T) {
-		expected := &TestRequest{Name: "John", Email: "john@example.com", Age: 25}
-		c.Set("validated_request", expected)
+	t.Run("ignores values set under the string key", func(t *testing.T) {
+		c.Set("validated_request", &TestRequest{Name: "John", Email: "john@example.com", Age: 25})
 
-		result, ok := gingodantic.GetValidated[TestRequest](c)
-		if !ok {
-			t.Fatal("Expected GetValidated to return true")
-		}
-		if result != expected {
-			t.Error("Expected to get the same pointer")
+		if _, ok := gingodantic.GetValidated[TestRequest](c); ok {
+			t.Error("Expected GetValidated to ignore the plain string key")
 		}
 	})
 }
 
+func TestValidatedKeysIsolated(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	api := gingodantic.New("Test API", "1.0.0")
+
+	router.POST("/users",
+		api.OpenAPISchema("POST", "/users",
+			gingodantic.WithRequest[TestRequest](),
+		),
+		func(c *gin.Context) {
+			// Handlers reading the string key directly keep working
+			legacy, _ := c.Get("validated_request")
+			if _, ok := legacy.(*TestRequest); !ok {
+				c.JSON(500, gin.H{"error": "string key not populated"})
+				return
+			}
+			// Unrelated middleware reusing the name doesn't replace the data
+			c.Set("validated_request", "something else")
+			c.Next()
+		},
+		func(c *gin.Context) {
+			req, ok := gingodantic.GetValidated[TestRequest](c)
+			if !ok {
+				c.JSON(500, gin.H{"error": "validated request lost"})
+				return
+			}
+			c.JSON(200, gin.H{"name": req.Name})
+		},
+	)
+
+	req := httptest.NewRequest("POST", "/users", bytes.NewBufferString(`{"name":"John Doe","email":"john@example.com","age":25}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if w.Code != 200 || !strings.Contains(w.Body.String(), `"name":"John Doe"`) {
+		t.Errorf("Expected the validated request, got %d: %s", w.Code, w.Body.String())
+	}
+}
+
 func TestSchemaRefFixes(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -426,6 +667,36 @@ func TestSchemaRefFixes(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "fixes discriminator mapping refs",
+			input: map[string]any{
+				"oneOf": []any{map[string]any{"$ref": "#/$defs/Cat"}},
+				"discriminator": map[string]any{
+					"propertyName": "type",
+					"mapping":      map[string]any{"cat": "#/$defs/Cat"},
+				},
+			},
+			expected: map[string]any{
+				"oneOf": []any{map[string]any{"$ref": "#/components/schemas/Cat"}},
+				"discriminator": map[string]any{
+					"propertyName": "type",
+					"mapping":      map[string]any{"cat": "#/components/schemas/Cat"},
+				},
+			},
+		},
+		{
+			name: "leaves a property named discriminator alone",
+			input: map[string]any{
+				"properties": map[string]any{
+					"discriminator": map[string]any{"$ref": "#/$defs/Kind"},
+				},
+			},
+			expected: map[string]any{
+				"properties": map[string]any{
+					"discriminator": map[string]any{"$ref": "#/components/schemas/Kind"},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -605,6 +876,92 @@ func TestQueryParameters(t *testing.T) {
 	}
 }
 
+type TestTagFilterQuery struct {
+	Tags []string `json:"tags"`
+}
+
+func (q *TestTagFilterQuery) FieldTags() godantic.FieldOptions[[]string] {
+	return godantic.Field(
+		godantic.MinItems[string](1),
+		godantic.MaxItems[string](5),
+		godantic.UniqueItems[string](),
+	)
+}
+
+func TestQueryArrayParameterConstraints(t *testing.T) {
+	api := gingodantic.New("Test API", "1.0.0")
+
+	api.OpenAPISchema("GET", "/posts",
+		gingodantic.WithQueryParams[TestTagFilterQuery](),
+		gingodantic.WithResponse[TestResponse](200, "OK"),
+	)
+
+	spec := api.GenerateOpenAPI()
+	getOp := spec["paths"].(map[string]any)["/posts"].(map[string]any)["get"].(map[string]any)
+	params := getOp["parameters"].([]any)
+	if len(params) != 1 {
+		t.Fatalf("Expected 1 query parameter, got %d", len(params))
+	}
+
+	schema := params[0].(map[string]any)["schema"].(map[string]any)
+	if schema["type"] != "array" {
+		t.Errorf("Expected 'tags' type 'array', got %v", schema["type"])
+	}
+	if schema["minItems"] != 1 || schema["maxItems"] != 5 || schema["uniqueItems"] != true {
+		t.Errorf("Expected minItems 1, maxItems 5 and uniqueItems, got %v", schema)
+	}
+}
+
+type TestSortQuery struct {
+	Sort  string `json:"sort"`
+	Order string `json:"order"`
+}
+
+func (q *TestSortQuery) FieldSort() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Example("name"))
+}
+
+func (q *TestSortQuery) FieldOrder() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Example("asc"))
+}
+
+func TestParameterExamples(t *testing.T) {
+	api := gingodantic.New("Test API", "1.0.0")
+
+	orderExamples := map[string]any{
+		"ascending":  map[string]any{"value": "asc"},
+		"descending": map[string]any{"value": "desc"},
+	}
+	api.OpenAPISchema("GET", "/users/:id",
+		gingodantic.WithQueryParams[TestSortQuery](),
+		gingodantic.WithParamExamples("query", "order", orderExamples),
+		gingodantic.WithParamExamples("path", "id", map[string]any{"first": map[string]any{"value": "1"}}),
+		gingodantic.WithResponse[TestResponse](200, "OK"),
+	)
+
+	spec := api.GenerateOpenAPI()
+	getOp := spec["paths"].(map[string]any)["/users/{id}"].(map[string]any)["get"].(map[string]any)
+	paramMap := make(map[string]map[string]any)
+	for _, p := range getOp["parameters"].([]any) {
+		param := p.(map[string]any)
+		paramMap[param["name"].(string)] = param
+	}
+
+	if got := paramMap["sort"]["example"]; got != "name" {
+		t.Errorf("Expected 'sort' example from its Example constraint, got %v", got)
+	}
+	order := paramMap["order"]
+	if _, ok := order["example"]; ok {
+		t.Errorf("Expected WithParamExamples to replace the 'order' example, got %v", order["example"])
+	}
+	if examples, ok := order["examples"].(map[string]any); !ok || len(examples) != 2 {
+		t.Errorf("Expected 2 examples for 'order', got %v", order["examples"])
+	}
+	if _, ok := paramMap["id"]["examples"].(map[string]any); !ok {
+		t.Errorf("Expected examples for the untyped 'id' path parameter, got %v", paramMap["id"])
+	}
+}
+
 func TestPathAndQueryParameters(t *testing.T) {
 	api := gingodantic.New("Test API", "1.0.0")
 
@@ -867,6 +1224,30 @@ func TestHeaderParameterValidation(t *testing.T) {
 		if w.Code != 400 {
 			t.Errorf("Expected status 400 for missing header, got %d", w.Code)
 		}
+		if !strings.Contains(w.Body.String(), `missing required header \"Authorization\"`) {
+			t.Errorf("Expected the missing header to be named, got %s", w.Body.String())
+		}
+	})
+
+	t.Run("lowercase header names match mixed-case tags", func(t *testing.T) {
+		receivedHeaders = nil
+		req := httptest.NewRequest("GET", "/protected", nil)
+		// Set bypasses canonicalization, as a raw HTTP/2 or proxied request may
+		req.Header["authorization"] = []string{"Bearer token456"}
+		req.Header["user-agent"] = []string{"godantic-test/1.0"}
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		if w.Code != 200 {
+			t.Fatalf("Expected status 200, got %d. Body: %s", w.Code, w.Body.String())
+		}
+		if receivedHeaders.Authorization != "Bearer token456" {
+			t.Errorf("Expected Authorization 'Bearer token456', got %s", receivedHeaders.Authorization)
+		}
+		if receivedHeaders.UserAgent != "godantic-test/1.0" {
+			t.Errorf("Expected User-Agent 'godantic-test/1.0', got %s", receivedHeaders.UserAgent)
+		}
 	})
 }
 
@@ -935,6 +1316,103 @@ func TestCookieParameterValidation(t *testing.T) {
 			t.Errorf("Expected status 400 for constraint violation, got %d", w.Code)
 		}
 	})
+
+	t.Run("cookie names are case-sensitive", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/dashboard", nil)
+		req.AddCookie(&http.Cookie{Name: "Session_ID", Value: "abc1234567"})
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		if w.Code != 400 {
+			t.Errorf("Expected status 400 for mismatched cookie name, got %d", w.Code)
+		}
+		if !strings.Contains(w.Body.String(), `missing required cookie \"session_id\"`) {
+			t.Errorf("Expected the missing cookie to be named, got %s", w.Body.String())
+		}
+	})
+}
+
+// Test types for parameter defaults
+type TestLocaleHeaders struct {
+	Locale string `json:"Accept-Language"`
+}
+
+func (h *TestLocaleHeaders) FieldLocale() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Default("en"))
+}
+
+type TestPrefCookies struct {
+	PageSize int `json:"page_size"`
+}
+
+func (c *TestPrefCookies) FieldPageSize() godantic.FieldOptions[int] {
+	return godantic.Field(godantic.Default(25), godantic.Min(10), godantic.Max(100))
+}
+
+func TestParameterDefaults(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	api := gingodantic.New("Test API", "1.0.0")
+
+	var receivedHeaders *TestLocaleHeaders
+	var receivedCookies *TestPrefCookies
+
+	router.GET("/feed",
+		api.OpenAPISchema("GET", "/feed",
+			gingodantic.WithHeaderParams[TestLocaleHeaders](),
+			gingodantic.WithCookieParams[TestPrefCookies](),
+		),
+		func(c *gin.Context) {
+			receivedHeaders, _ = gingodantic.GetValidatedHeaders[TestLocaleHeaders](c)
+			receivedCookies, _ = gingodantic.GetValidatedCookies[TestPrefCookies](c)
+			c.JSON(200, gin.H{"success": true})
+		},
+	)
+
+	serve := func(setup func(req *http.Request)) int {
+		receivedHeaders, receivedCookies = nil, nil
+		req := httptest.NewRequest("GET", "/feed", nil)
+		setup(req)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	t.Run("defaults applied when absent", func(t *testing.T) {
+		if code := serve(func(*http.Request) {}); code != 200 {
+			t.Fatalf("Expected status 200, got %d", code)
+		}
+		if receivedHeaders.Locale != "en" {
+			t.Errorf("Expected header default 'en', got %q", receivedHeaders.Locale)
+		}
+		if receivedCookies.PageSize != 25 {
+			t.Errorf("Expected cookie default 25, got %d", receivedCookies.PageSize)
+		}
+	})
+
+	t.Run("present but empty header is kept", func(t *testing.T) {
+		code := serve(func(req *http.Request) { req.Header["Accept-Language"] = []string{""} })
+		if code != 200 {
+			t.Fatalf("Expected status 200, got %d", code)
+		}
+		if receivedHeaders.Locale != "" {
+			t.Errorf("Expected empty header to be kept, got %q", receivedHeaders.Locale)
+		}
+	})
+
+	t.Run("present cookie is validated", func(t *testing.T) {
+		code := serve(func(req *http.Request) { req.AddCookie(&http.Cookie{Name: "page_size", Value: "50"}) })
+		if code != 200 || receivedCookies.PageSize != 50 {
+			t.Fatalf("Expected page size 50, got status %d", code)
+		}
+		for _, value := range []string{"5", "0"} {
+			code := serve(func(req *http.Request) { req.AddCookie(&http.Cookie{Name: "page_size", Value: value}) })
+			if code != 400 {
+				t.Errorf("Expected status 400 for page_size=%s, got %d", value, code)
+			}
+		}
+	})
 }
 
 func TestDeprecatedFlag(t *testing.T) {
@@ -1020,6 +1498,84 @@ func TestResponseExamples(t *testing.T) {
 	}
 }
 
+func TestResponseContentTypes(t *testing.T) {
+	api := gingodantic.New("Test API", "1.0.0")
+
+	api.OpenAPISchema("GET", "/users/export",
+		gingodantic.WithResponseAs[string](200, "text/csv", "Users as CSV"),
+		gingodantic.WithResponse[TestResponse](200, "Users"),
+		gingodantic.WithResponse[TestErrorResponse](400),
+		gingodantic.WithResponseAs[string](406, "text/plain", "Not acceptable"),
+		gingodantic.WithResponseAs[string](202, "text/csv", "Export queued"),
+		gingodantic.WithResponse[TestResponse](202),
+	)
+
+	spec := api.GenerateOpenAPI()
+	responses := spec["paths"].(map[string]any)["/users/export"].(map[string]any)["get"].(map[string]any)["responses"].(map[string]any)
+	response200 := responses["200"].(map[string]any)
+	if response200["description"] != "Users" {
+		t.Errorf("description = %v, want the JSON response's", response200["description"])
+	}
+
+	content := response200["content"].(map[string]any)
+	if len(content) != 2 {
+		t.Fatalf("expected application/json and text/csv content, got %v", content)
+	}
+	jsonSchema := content["application/json"].(map[string]any)["schema"].(map[string]any)
+	if jsonSchema["$ref"] != "#/components/schemas/TestResponse" {
+		t.Errorf("application/json schema = %v", jsonSchema)
+	}
+	csvSchema := content["text/csv"].(map[string]any)["schema"].(map[string]any)
+	if csvSchema["type"] != "string" {
+		t.Errorf("text/csv schema = %v, want a string", csvSchema)
+	}
+
+	content400 := responses["400"].(map[string]any)["content"].(map[string]any)
+	if _, ok := content400["application/json"]; !ok || len(content400) != 1 {
+		t.Errorf("400 content = %v, want only application/json", content400)
+	}
+
+	response406 := responses["406"].(map[string]any)
+	content406 := response406["content"].(map[string]any)
+	if _, ok := content406["text/plain"]; !ok || len(content406) != 1 || response406["description"] != "Not acceptable" {
+		t.Errorf("406 = %v, want only text/plain", response406)
+	}
+
+	if desc := responses["202"].(map[string]any)["description"]; desc != "Export queued" {
+		t.Errorf("202 description = %v, want the one from WithResponseAs", desc)
+	}
+}
+
+type TestWidgetSettings struct {
+	Theme string `json:"theme"`
+}
+
+func (s *TestWidgetSettings) FieldTheme() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Extension[string]("x-ui-widget", "color-picker"))
+}
+
+func (TestWidgetSettings) SchemaExtensions() map[string]any {
+	return map[string]any{"x-go-type": "settings.Widget"}
+}
+
+func TestSchemaExtensionsInComponents(t *testing.T) {
+	api := gingodantic.New("Test API", "1.0.0")
+	api.OpenAPISchema("PUT", "/settings",
+		gingodantic.WithRequest[TestWidgetSettings](),
+		gingodantic.WithResponse[TestWidgetSettings](200, "OK"),
+	)
+
+	spec := api.GenerateOpenAPI()
+	settings := spec["components"].(map[string]any)["schemas"].(map[string]any)["TestWidgetSettings"].(map[string]any)
+	if settings["x-go-type"] != "settings.Widget" {
+		t.Errorf("expected the struct-level extension, got %v", settings)
+	}
+	theme := settings["properties"].(map[string]any)["theme"].(map[string]any)
+	if theme["x-ui-widget"] != "color-picker" {
+		t.Errorf("expected x-ui-widget on theme, got %v", theme)
+	}
+}
+
 func TestPathParamsInOpenAPISpec(t *testing.T) {
 	api := gingodantic.New("Test API", "1.0.0")
 
@@ -1058,3 +1614,439 @@ func TestPathParamsInOpenAPISpec(t *testing.T) {
 		t.Errorf("Expected param minimum 1, got %v", schema["minimum"])
 	}
 }
+
+type OrderEvent struct {
+	OrderID string  `json:"order_id"`
+	Total   float64 `json:"total"`
+}
+
+func (OrderEvent) FieldOrderID() godantic.FieldOptions[string] {
+	return godantic.Field(
+		godantic.Required[string](),
+		godantic.Description[string]("Order identifier"),
+	)
+}
+
+func TestWebhooks(t *testing.T) {
+	api := gingodantic.New("Test API", "1.0.0", gingodantic.WithOpenAPI31())
+
+	api.Webhook("order.created",
+		gingodantic.WithSummary("Order created"),
+		gingodantic.WithRequest[OrderEvent](),
+		gingodantic.WithResponse[TestResponse](200, "Acknowledged"),
+	)
+
+	spec := api.GenerateOpenAPI()
+	if spec["openapi"] != "3.1.0" {
+		t.Errorf("Expected OpenAPI 3.1.0, got %v", spec["openapi"])
+	}
+
+	webhooks, ok := spec["webhooks"].(map[string]any)
+	if !ok {
+		t.Fatal("Expected webhooks in spec")
+	}
+	webhook, ok := webhooks["order.created"].(map[string]any)
+	if !ok {
+		t.Fatal("Expected order.created webhook")
+	}
+	postOp, ok := webhook["post"].(map[string]any)
+	if !ok {
+		t.Fatal("Expected post operation on webhook")
+	}
+	if postOp["summary"] != "Order created" {
+		t.Errorf("Expected summary 'Order created', got %v", postOp["summary"])
+	}
+
+	requestBody := postOp["requestBody"].(map[string]any)
+	content := requestBody["content"].(map[string]any)
+	jsonContent := content["application/json"].(map[string]any)
+	schema := jsonContent["schema"].(map[string]any)
+	if schema["$ref"] != "#/components/schemas/OrderEvent" {
+		t.Errorf("Expected $ref to OrderEvent, got %v", schema["$ref"])
+	}
+
+	schemas := spec["components"].(map[string]any)["schemas"].(map[string]any)
+	orderEvent, ok := schemas["OrderEvent"].(map[string]any)
+	if !ok {
+		t.Fatal("Expected OrderEvent in components/schemas")
+	}
+	props := orderEvent["properties"].(map[string]any)
+	orderID, ok := props["order_id"].(map[string]any)
+	if !ok {
+		t.Fatal("Expected order_id property in webhook request schema")
+	}
+	if orderID["description"] != "Order identifier" {
+		t.Errorf("Expected order_id description, got %v", orderID["description"])
+	}
+
+	responses := postOp["responses"].(map[string]any)
+	if _, ok := responses["200"]; !ok {
+		t.Error("Expected 200 response on webhook")
+	}
+
+	// Webhooks must not leak into paths
+	if paths := spec["paths"].(map[string]any); len(paths) != 0 {
+		t.Errorf("Expected no paths, got %v", paths)
+	}
+}
+
+func TestWebhooksOmittedForOpenAPI30(t *testing.T) {
+	api := gingodantic.New("Test API", "1.0.0")
+	api.Webhook("order.created", gingodantic.WithRequest[OrderEvent]())
+
+	spec := api.GenerateOpenAPI()
+	if spec["openapi"] != "3.0.3" {
+		t.Errorf("Expected OpenAPI 3.0.3, got %v", spec["openapi"])
+	}
+	if _, ok := spec["webhooks"]; ok {
+		t.Error("Expected no webhooks in OpenAPI 3.0 spec")
+	}
+}
+
+func TestTagMetadata(t *testing.T) {
+	api := gingodantic.New("Test API", "1.0.0")
+	api.AddTag("users", "User management",
+		gingodantic.WithTagExternalDocs("https://docs.example.com/users", "User guide"),
+	)
+	api.AddTag("admin", "Administrative operations")
+
+	api.OpenAPISchema("GET", "/users",
+		gingodantic.WithTags("users"),
+		gingodantic.WithResponse[TestResponse](200, "OK"),
+	)
+	api.OpenAPISchema("GET", "/health",
+		gingodantic.WithTags("system"),
+		gingodantic.WithResponse[TestResponse](200, "OK"),
+	)
+
+	spec := api.GenerateOpenAPI()
+	tags, ok := spec["tags"].([]any)
+	if !ok {
+		t.Fatal("Expected tags array in spec")
+	}
+	if len(tags) != 3 {
+		t.Fatalf("Expected 3 tags, got %d: %v", len(tags), tags)
+	}
+
+	users := tags[0].(map[string]any)
+	if users["name"] != "users" || users["description"] != "User management" {
+		t.Errorf("Unexpected users tag: %v", users)
+	}
+	docs, ok := users["externalDocs"].(map[string]any)
+	if !ok {
+		t.Fatal("Expected externalDocs on users tag")
+	}
+	if docs["url"] != "https://docs.example.com/users" || docs["description"] != "User guide" {
+		t.Errorf("Unexpected externalDocs: %v", docs)
+	}
+
+	admin := tags[1].(map[string]any)
+	if admin["name"] != "admin" {
+		t.Errorf("Expected declared tag 'admin' second, got %v", admin)
+	}
+	if _, ok := admin["externalDocs"]; ok {
+		t.Error("Expected no externalDocs on admin tag")
+	}
+
+	// Tags used on operations but not declared are still listed
+	system := tags[2].(map[string]any)
+	if system["name"] != "system" {
+		t.Errorf("Expected undeclared tag 'system', got %v", system)
+	}
+	if _, ok := system["description"]; ok {
+		t.Error("Expected no description on undeclared tag")
+	}
+}
+
+func TestAddTagReplacesExisting(t *testing.T) {
+	api := gingodantic.New("Test API", "1.0.0")
+	api.AddTag("users", "Old")
+	api.AddTag("users", "New")
+
+	tags := api.GenerateOpenAPI()["tags"].([]any)
+	if len(tags) != 1 {
+		t.Fatalf("Expected 1 tag, got %d", len(tags))
+	}
+	if tags[0].(map[string]any)["description"] != "New" {
+		t.Errorf("Expected replaced description, got %v", tags[0])
+	}
+}
+
+func TestNoTagsOmitted(t *testing.T) {
+	api := gingodantic.New("Test API", "1.0.0")
+	api.OpenAPISchema("GET", "/users", gingodantic.WithResponse[TestResponse](200, "OK"))
+
+	if _, ok := api.GenerateOpenAPI()["tags"]; ok {
+		t.Error("Expected no tags key when no tags are used")
+	}
+}
+
+func TestConflictingSchemaNames(t *testing.T) {
+	api := gingodantic.New("Test API", "1.0.0")
+
+	// Two nested types that share a name but not a shape, as with
+	// shipping.Address and billing.Address
+	{
+		type Address struct {
+			Street string `json:"street"`
+			City   string `json:"city"`
+		}
+		type ShipmentRequest struct {
+			Address Address `json:"address"`
+		}
+		{
+			type Address struct {
+				Line    string `json:"line"`
+				Country string `json:"country"`
+			}
+			type ShipmentReceipt struct {
+				Address Address `json:"address"`
+			}
+			api.OpenAPISchema("POST", "/shipments",
+				gingodantic.WithRequest[ShipmentRequest](),
+				gingodantic.WithResponse[ShipmentReceipt](201, "Created"),
+			)
+		}
+		api.OpenAPISchema("PUT", "/shipments",
+			gingodantic.WithRequest[ShipmentRequest](),
+		)
+	}
+
+	spec := api.GenerateOpenAPI()
+	schemas := spec["components"].(map[string]any)["schemas"].(map[string]any)
+
+	properties := func(name string) map[string]any {
+		def, ok := schemas[name].(map[string]any)
+		if !ok {
+			t.Fatalf("Expected %s in components/schemas, got %v", name, schemas)
+		}
+		return def["properties"].(map[string]any)
+	}
+
+	if ref := properties("ShipmentRequest")["address"].(map[string]any)["$ref"]; ref != "#/components/schemas/Address" {
+		t.Errorf("Expected the request to keep Address, got %v", ref)
+	}
+	if ref := properties("ShipmentReceipt")["address"].(map[string]any)["$ref"]; ref != "#/components/schemas/Address2" {
+		t.Errorf("Expected the conflicting Address to be renamed, got %v", ref)
+	}
+	if _, ok := properties("Address")["street"]; !ok {
+		t.Error("Expected Address to be the request's type")
+	}
+	if _, ok := properties("Address2")["line"]; !ok {
+		t.Error("Expected Address2 to be the receipt's type")
+	}
+
+	// Reusing the same type shares its definition
+	if _, ok := schemas["Address3"]; ok {
+		t.Error("Expected identical definitions to be shared")
+	}
+}
+
+type TicketState string
+
+type TicketUpdate struct {
+	Title string        `json:"title"`
+	State *TicketState  `json:"state"`
+	Owner *TestResponse `json:"owner"`
+}
+
+func (TicketUpdate) FieldState() godantic.FieldOptions[TicketState] {
+	return godantic.Field(
+		godantic.OneOf[TicketState]("open", "closed"),
+		godantic.Nullable[TicketState](),
+	)
+}
+
+func (TicketUpdate) FieldOwner() godantic.FieldOptions[*TestResponse] {
+	return godantic.Field(godantic.Nullable[*TestResponse]())
+}
+
+func TestNullableEnumByOpenAPIVersion(t *testing.T) {
+	properties := func(api *gingodantic.API) map[string]any {
+		api.OpenAPISchema("PATCH", "/tickets/:id", gingodantic.WithRequest[TicketUpdate]())
+		schemas := api.GenerateOpenAPI()["components"].(map[string]any)["schemas"].(map[string]any)
+		return schemas["TicketUpdate"].(map[string]any)["properties"].(map[string]any)
+	}
+
+	t.Run("3.0 uses nullable", func(t *testing.T) {
+		props := properties(gingodantic.New("Test API", "1.0.0"))
+		raw, _ := json.Marshal(props["state"])
+		want := `{"enum":["open","closed",null],"nullable":true,"title":"State","type":"string"}`
+		if string(raw) != want {
+			t.Errorf("Expected %s, got %s", want, raw)
+		}
+		raw, _ = json.Marshal(props["owner"])
+		want = `{"allOf":[{"$ref":"#/components/schemas/TestResponse"}],"nullable":true,"title":"Owner"}`
+		if string(raw) != want {
+			t.Errorf("Expected %s, got %s", want, raw)
+		}
+	})
+
+	t.Run("3.1 uses a null type", func(t *testing.T) {
+		props := properties(gingodantic.New("Test API", "1.0.0", gingodantic.WithOpenAPI31()))
+		raw, _ := json.Marshal(props["state"])
+		want := `{"anyOf":[{"enum":["open","closed"],"type":"string"},{"type":"null"}],"title":"State"}`
+		if string(raw) != want {
+			t.Errorf("Expected %s, got %s", want, raw)
+		}
+	})
+}
+
+type TestDescribedOrder struct {
+	ID string `json:"id"`
+}
+
+func (o *TestDescribedOrder) Describe() string { return "A customer order." }
+
+func TestObjectDescriptions(t *testing.T) {
+	api := gingodantic.New("Test API", "1.0.0")
+	api.OpenAPISchema("POST", "/orders",
+		gingodantic.WithRequest[TestDescribedOrder](),
+		gingodantic.WithResponse[TestDescribedOrder](201, "Created"),
+	)
+
+	spec := api.GenerateOpenAPI()
+	schemas := spec["components"].(map[string]any)["schemas"].(map[string]any)
+	order, ok := schemas["TestDescribedOrder"].(map[string]any)
+	if !ok {
+		t.Fatalf("Expected TestDescribedOrder in components/schemas, got %v", schemas)
+	}
+	if order["description"] != "A customer order." {
+		t.Errorf("Expected the object description, got %v", order["description"])
+	}
+}
+
+func TestValidateRegistrations(t *testing.T) {
+	t.Run("no conflicts", func(t *testing.T) {
+		api := gingodantic.New("Test API", "1.0.0")
+		api.OpenAPISchema("GET", "/users/:id")
+		api.OpenAPISchema("PUT", "/users/:id")
+		api.OpenAPISchema("GET", "/users")
+		if err := api.Validate(); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("duplicate method and path", func(t *testing.T) {
+		api := gingodantic.New("Test API", "1.0.0")
+		api.OpenAPISchema("GET", "/users", gingodantic.WithSummary("List users"))
+		api.OpenAPISchema("get", "/users", gingodantic.WithSummary("Copy-pasted"))
+
+		err := api.Validate()
+		if err == nil || err.Error() != "GET /users is registered more than once" {
+			t.Fatalf("expected a duplicate registration error, got %v", err)
+		}
+
+		// The last registration still wins
+		paths := api.GenerateOpenAPI()["paths"].(map[string]any)
+		op := paths["/users"].(map[string]any)["get"].(map[string]any)
+		if op["summary"] != "Copy-pasted" {
+			t.Errorf("expected the last registration in the spec, got %v", op["summary"])
+		}
+	})
+
+	t.Run("repeated path parameter", func(t *testing.T) {
+		api := gingodantic.New("Test API", "1.0.0")
+		api.OpenAPISchema("GET", "/orgs/:id/users/:id")
+		err := api.Validate()
+		if err == nil || !strings.Contains(err.Error(), `path parameter "id" appears more than once`) {
+			t.Errorf("expected a repeated parameter error, got %v", err)
+		}
+	})
+
+	t.Run("duplicate webhook", func(t *testing.T) {
+		api := gingodantic.New("Test API", "1.0.0", gingodantic.WithOpenAPI31())
+		api.Webhook("order.created")
+		api.Webhook("order.created")
+		if err := api.Validate(); err == nil || !strings.Contains(err.Error(), `webhook "order.created"`) {
+			t.Errorf("expected a duplicate webhook error, got %v", err)
+		}
+	})
+}
+
+type TestAccount struct {
+	ID       string `json:"id"`
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+func (TestAccount) FieldID() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Required[string](), godantic.ReadOnly[string]())
+}
+
+func (TestAccount) FieldPassword() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Required[string](), godantic.WriteOnly[string]())
+}
+
+type TestAccountList struct {
+	Accounts []TestAccount `json:"accounts"`
+}
+
+func TestReadWriteOnlySchemas(t *testing.T) {
+	register := func(api *gingodantic.API) map[string]any {
+		api.OpenAPISchema("POST", "/accounts",
+			gingodantic.WithRequest[TestAccount](),
+			gingodantic.WithResponse[TestAccount](201, "Created"),
+		)
+		api.OpenAPISchema("GET", "/accounts",
+			gingodantic.WithResponse[TestAccountList](200, "Accounts"),
+		)
+		return api.GenerateOpenAPI()["components"].(map[string]any)["schemas"].(map[string]any)
+	}
+	properties := func(t *testing.T, schemas map[string]any, name string) map[string]any {
+		t.Helper()
+		def, ok := schemas[name].(map[string]any)
+		if !ok {
+			t.Fatalf("Expected %s in components/schemas, got %v", name, schemas)
+		}
+		return def["properties"].(map[string]any)
+	}
+
+	t.Run("keywords on a shared schema", func(t *testing.T) {
+		schemas := register(gingodantic.New("Test API", "1.0.0"))
+		props := properties(t, schemas, "TestAccount")
+		if props["password"].(map[string]any)["writeOnly"] != true {
+			t.Errorf("Expected password to be writeOnly, got %v", props["password"])
+		}
+		if props["id"].(map[string]any)["readOnly"] != true {
+			t.Errorf("Expected id to be readOnly, got %v", props["id"])
+		}
+		if _, ok := schemas["TestAccountInput"]; ok {
+			t.Error("Expected no request variant without WithSplitReadWriteSchemas")
+		}
+	})
+
+	t.Run("split variants", func(t *testing.T) {
+		schemas := register(gingodantic.New("Test API", "1.0.0", gingodantic.WithSplitReadWriteSchemas()))
+
+		input := properties(t, schemas, "TestAccountInput")
+		if _, ok := input["id"]; ok {
+			t.Error("Expected the request schema to leave out the read-only id")
+		}
+		if input["password"].(map[string]any)["writeOnly"] != true {
+			t.Errorf("Expected password to be writeOnly in the request schema, got %v", input["password"])
+		}
+		raw, _ := json.Marshal(schemas["TestAccountInput"].(map[string]any)["required"])
+		if string(raw) != `["email","password"]` {
+			t.Errorf("Expected id to be dropped from required, got %s", raw)
+		}
+
+		output := properties(t, schemas, "TestAccountOutput")
+		if _, ok := output["password"]; ok {
+			t.Error("Expected the response schema to leave out the write-only password")
+		}
+		if _, ok := output["id"]; !ok {
+			t.Error("Expected the response schema to keep id")
+		}
+
+		// Containers of a split type reference its variant
+		list := properties(t, schemas, "TestAccountListOutput")
+		ref := list["accounts"].(map[string]any)["items"].(map[string]any)["$ref"]
+		if ref != "#/components/schemas/TestAccountOutput" {
+			t.Errorf("Expected the list to reference TestAccountOutput, got %v", ref)
+		}
+		if _, ok := schemas["TestAccount"]; ok {
+			t.Error("Expected no unsplit TestAccount component")
+		}
+	})
+}
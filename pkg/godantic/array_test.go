@@ -0,0 +1,84 @@
+package godantic_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════
+// Fixed-Size Array Tests
+// ═══════════════════════════════════════════════════════════════════════════
+
+type TPixel struct {
+	RGB [3]int `json:"rgb"`
+}
+
+func (p *TPixel) FieldRGB() godantic.FieldOptions[[3]int] {
+	return godantic.Field(
+		godantic.Required[[3]int](),
+		godantic.Each[[3]int](godantic.Min(0), godantic.Max(255)),
+	)
+}
+
+func TestFixedSizeArray(t *testing.T) {
+	validator := godantic.NewValidator[TPixel]()
+	compiled := validator.Compile()
+
+	tests := []struct {
+		name    string
+		data    string
+		wantMsg string // empty means valid
+	}{
+		{"valid", `{"rgb": [0, 128, 255]}`, ""},
+		{"element below min", `{"rgb": [-1, 0, 0]}`, "item [0]: value must be >= 0"},
+		{"element above max", `{"rgb": [0, 256, 0]}`, "item [1]: value must be <= 255"},
+		{"too few items", `{"rgb": [1, 2]}`, "must have exactly 3 items, got 2"},
+		{"too many items", `{"rgb": [1, 2, 3, 4]}`, "must have exactly 3 items, got 4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for mode, unmarshal := range map[string]func([]byte) (*TPixel, godantic.ValidationErrors){
+				"walker":   validator.Unmarshal,
+				"compiled": compiled.Unmarshal,
+			} {
+				_, errs := unmarshal([]byte(tt.data))
+				if tt.wantMsg == "" {
+					if len(errs) != 0 {
+						t.Errorf("%s: expected no errors, got %v", mode, errs)
+					}
+					continue
+				}
+				if len(errs) != 1 || errs[0].Loc[0] != "RGB" || !strings.Contains(errs[0].Message, tt.wantMsg) {
+					t.Errorf("%s: expected one RGB error %q, got %v", mode, tt.wantMsg, errs)
+				}
+			}
+		})
+	}
+
+	t.Run("validate checks elements", func(t *testing.T) {
+		errs := validator.Validate(&TPixel{RGB: [3]int{10, 20, 300}})
+		if len(errs) != 1 || !strings.Contains(errs[0].Message, "item [2]: value must be <= 255") {
+			t.Errorf("expected an item [2] error, got %v", errs)
+		}
+	})
+}
+
+func TestEach_Slice(t *testing.T) {
+	fo := godantic.Field(godantic.Each[[]float64](godantic.ExclusiveMin(0.0)))
+	for _, validate := range fo.Validators_ {
+		if err := validate([]float64{1.5, 0}); err == nil || !strings.Contains(err.Error(), "item [1]") {
+			t.Errorf("expected an item [1] error, got %v", err)
+		}
+	}
+	if _, ok := fo.Constraints_[godantic.ConstraintItems].(map[string]any); !ok {
+		t.Errorf("expected element constraints under %q, got %v", godantic.ConstraintItems, fo.Constraints_)
+	}
+
+	mismatched := godantic.Field(godantic.Each[[]int64](godantic.Min(0)))
+	if len(mismatched.Errors_) != 1 {
+		t.Errorf("expected a construction error for mismatched element type, got %v", mismatched.Errors_)
+	}
+}
@@ -0,0 +1,221 @@
+package godantic
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/deepankarm/godantic/pkg/internal/reflectutil"
+	"github.com/deepankarm/godantic/pkg/internal/walk"
+)
+
+var jsonNumberType = reflect.TypeOf(json.Number(""))
+
+// errUnsupportedCoercion is wrapped by CoerceString for types a string can't become
+var errUnsupportedCoercion = errors.New("cannot coerce a string to")
+
+// CoerceString converts s to a value of type t with the rules used for path,
+// query, header and cookie parameters, for reuse in CLI flags, message headers
+// or custom binders. It handles every integer, unsigned and float type, bool
+// (strconv.ParseBool forms), strings and named string types, json.Number,
+// time.Duration ("1h30m"), types implementing encoding.TextUnmarshaler, and
+// pointers to and Optional wrappers of any of these. Numbers must be plain
+// decimal literals that fit t. Other types, such as slices, report an error;
+// use CoerceStrings for multi-value input.
+//
+// Example:
+//
+//	v, err := godantic.CoerceString("8080", reflect.TypeFor[uint16]())
+//	port := v.Interface().(uint16)
+func CoerceString(s string, t reflect.Type) (reflect.Value, error) {
+	return coerceValue(s, t, coerceOptions{})
+}
+
+// CoerceStrings converts each of values to the element type of the slice
+// type t with CoerceString, for repeated parameters such as ?tag=a&tag=b.
+// Errors name the index of the failing value.
+func CoerceStrings(values []string, t reflect.Type) (reflect.Value, error) {
+	if t == nil || t.Kind() != reflect.Slice {
+		return reflect.Value{}, fmt.Errorf("%w %v: not a slice type", errUnsupportedCoercion, t)
+	}
+	slice := reflect.MakeSlice(t, len(values), len(values))
+	for i, value := range values {
+		elem, err := CoerceString(value, t.Elem())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("value %d: %w", i, err)
+		}
+		slice.Index(i).Set(elem)
+	}
+	return slice, nil
+}
+
+// coerceValue is CoerceString with configurable coercion options
+func coerceValue(s string, t reflect.Type, opts coerceOptions) (reflect.Value, error) {
+	if t == nil {
+		return reflect.Value{}, fmt.Errorf("%w %v", errUnsupportedCoercion, t)
+	}
+	switch {
+	case t.Kind() == reflect.Pointer:
+		elem, err := coerceValue(s, t.Elem(), opts)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		ptr := reflect.New(t.Elem())
+		ptr.Elem().Set(elem)
+		return ptr, nil
+	case reflectutil.IsOptional(t):
+		inner, err := coerceValue(s, reflectutil.OptionalElem(t), opts)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		optional := reflect.New(t).Elem()
+		optional.FieldByName("Value").Set(inner)
+		optional.FieldByName("Set").SetBool(true)
+		return optional, nil
+	}
+
+	converted, err := coerceString(s, t, opts)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	// Non-scalar kinds come back as the raw string
+	rv := reflect.ValueOf(converted)
+	if rv.Kind() == reflect.String && t.Kind() != reflect.String {
+		return reflect.Value{}, fmt.Errorf("%w %v", errUnsupportedCoercion, t)
+	}
+	return rv.Convert(t), nil
+}
+
+// coerceOptions customizes string coercion
+type coerceOptions struct {
+	truthy map[string]bool // Lowercased spellings accepted as true (nil = strconv.ParseBool)
+	falsy  map[string]bool // Lowercased spellings accepted as false
+
+	durationSeconds bool // Unitless durations are seconds (WithDurationSeconds)
+}
+
+// coerceString converts a string from a path, query, header or cookie value to
+// the Go value for fieldType. Numbers must be plain decimal literals that fit the
+// target type: surrounding spaces, a leading '+', hex forms and overflow are
+// rejected rather than trimmed or truncated. Bools accept strconv.ParseBool forms
+// unless custom spellings are configured with WithBoolValues. Types implementing
+// encoding.TextUnmarshaler are parsed with UnmarshalText, ahead of their kind.
+// Other non-scalar kinds are returned unchanged as strings.
+func coerceString(value string, fieldType reflect.Type, opts coerceOptions) (any, error) {
+	if fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+	// Optional fields take their wrapped type's text form
+	fieldType = reflectutil.OptionalElem(fieldType)
+
+	if reflectutil.IsDuration(fieldType) {
+		return walk.ParseDuration(value, opts.durationSeconds)
+	}
+
+	if reflectutil.IsTextUnmarshaler(fieldType) {
+		ptr := reflect.New(fieldType)
+		if err := ptr.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(value)); err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %v", fieldType, value, err)
+		}
+		return ptr.Elem().Interface(), nil
+	}
+
+	if fieldType == jsonNumberType {
+		if _, err := parseDecimalFloat(value, 64); err != nil {
+			return nil, fmt.Errorf("invalid number %q", value)
+		}
+		return json.Number(value), nil
+	}
+
+	switch fieldType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if strings.HasPrefix(value, "+") {
+			return nil, fmt.Errorf("invalid integer %q", value)
+		}
+		n, err := strconv.ParseInt(value, 10, fieldType.Bits())
+		if err != nil {
+			return nil, numericCoercionError(value, "integer", fieldType, err)
+		}
+		return n, nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, fieldType.Bits())
+		if err != nil {
+			return nil, numericCoercionError(value, "unsigned integer", fieldType, err)
+		}
+		return n, nil
+
+	case reflect.Float32, reflect.Float64:
+		f, err := parseDecimalFloat(value, fieldType.Bits())
+		if err != nil {
+			return nil, numericCoercionError(value, "number", fieldType, err)
+		}
+		return f, nil
+
+	case reflect.Bool:
+		return opts.parseBool(value)
+
+	default:
+		return value, nil
+	}
+}
+
+// parseDecimalFloat parses a decimal float, rejecting forms strconv accepts
+// but JSON doesn't: a leading '+', hex floats, Inf and NaN.
+func parseDecimalFloat(value string, bitSize int) (float64, error) {
+	if strings.HasPrefix(value, "+") || strings.ContainsAny(value, "xX") {
+		return 0, strconv.ErrSyntax
+	}
+	f, err := strconv.ParseFloat(value, bitSize)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return 0, strconv.ErrSyntax
+	}
+	return f, nil
+}
+
+// numericCoercionError describes a failed numeric parse, distinguishing overflow
+func numericCoercionError(value, kind string, fieldType reflect.Type, err error) error {
+	if errors.Is(err, strconv.ErrRange) {
+		return fmt.Errorf("value %q out of range for %s", value, fieldType.Kind())
+	}
+	return fmt.Errorf("invalid %s %q", kind, value)
+}
+
+// parseBool parses a bool using the configured spellings, or strconv.ParseBool
+func (opts coerceOptions) parseBool(value string) (bool, error) {
+	if opts.truthy == nil && opts.falsy == nil {
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return false, fmt.Errorf("invalid boolean %q", value)
+		}
+		return b, nil
+	}
+
+	lower := strings.ToLower(value)
+	switch {
+	case opts.truthy[lower]:
+		return true, nil
+	case opts.falsy[lower]:
+		return false, nil
+	}
+	return false, fmt.Errorf("invalid boolean %q, expected one of %v or %v", value, sortedSet(opts.truthy), sortedSet(opts.falsy))
+}
+
+// sortedSet returns the members of a set in sorted order
+func sortedSet(set map[string]bool) []string {
+	members := make([]string, 0, len(set))
+	for m := range set {
+		members = append(members, m)
+	}
+	sort.Strings(members)
+	return members
+}
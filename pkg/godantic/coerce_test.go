@@ -0,0 +1,388 @@
+package godantic_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════
+// String → scalar coercion tests (path/query/header/cookie values)
+// ═══════════════════════════════════════════════════════════════════════════
+
+type TCoercionParams struct {
+	Int    int         `json:"int"`
+	Int8   int8        `json:"int8"`
+	Int32  int32       `json:"int32"`
+	Int64  int64       `json:"int64"`
+	Uint   uint        `json:"uint"`
+	Uint8  uint8       `json:"uint8"`
+	F32    float32     `json:"f32"`
+	F64    float64     `json:"f64"`
+	Bool   bool        `json:"bool"`
+	Ptr    *int        `json:"ptr"`
+	Number json.Number `json:"number"`
+}
+
+func TestStringCoercion(t *testing.T) {
+	validator := godantic.NewValidator[TCoercionParams]()
+
+	tests := []struct {
+		field string
+		value string
+		ok    bool
+	}{
+		{"int", "42", true},
+		{"int", "-42", true},
+		{"int", "007", true},
+		{"int", "abc", false},
+		{"int", "", false},
+		{"int", " 5", false},
+		{"int", "5 ", false},
+		{"int", "+5", false},
+		{"int", "0x10", false},
+		{"int", "1e3", false},
+		{"int", "1.0", false},
+		{"int", "1_000", false},
+		{"int8", "127", true},
+		{"int8", "-128", true},
+		{"int8", "128", false},
+		{"int8", "-129", false},
+		{"int32", "2147483647", true},
+		{"int32", "2147483648", false},
+		{"int64", "9223372036854775807", true},
+		{"int64", "9223372036854775808", false},
+		{"uint", "0", true},
+		{"uint", "-1", false},
+		{"uint", "+1", false},
+		{"uint8", "255", true},
+		{"uint8", "256", false},
+		{"f64", "1.5", true},
+		{"f64", "-2e10", true},
+		{"f64", "+1.5", false},
+		{"f64", " 1.5", false},
+		{"f64", "0x1p4", false},
+		{"f64", "NaN", false},
+		{"f64", "Inf", false},
+		{"f64", "1e400", false},
+		{"f32", "3.4e38", true},
+		{"f32", "3.5e38", false},
+		{"bool", "true", true},
+		{"bool", "1", true},
+		{"bool", "F", true},
+		{"bool", "yes", false},
+		{"bool", " true", false},
+		{"ptr", "5", true},
+		{"ptr", "five", false},
+		{"number", "9007199254740993", true},
+		{"number", "+1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field+"="+tt.value, func(t *testing.T) {
+			_, errs := validator.ValidateFromStringMap(map[string]string{tt.field: tt.value})
+			if tt.ok {
+				if len(errs) > 0 {
+					t.Errorf("expected %q to coerce, got %v", tt.value, errs)
+				}
+				return
+			}
+			if len(errs) != 1 {
+				t.Fatalf("expected 1 error for %q, got %v", tt.value, errs)
+			}
+			if errs[0].Type != godantic.ErrorTypeCoercion {
+				t.Errorf("expected coercion error, got %s: %v", errs[0].Type, errs[0])
+			}
+		})
+	}
+}
+
+func TestStringCoercion_Values(t *testing.T) {
+	validator := godantic.NewValidator[TCoercionParams]()
+
+	params, errs := validator.ValidateFromMultiValueMap(map[string][]string{
+		"int8":   {"-128"},
+		"uint8":  {"255"},
+		"f32":    {"0.25"},
+		"bool":   {"TRUE"},
+		"ptr":    {"7"},
+		"number": {"9007199254740993"},
+	})
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if params.Int8 != -128 || params.Uint8 != 255 || params.F32 != 0.25 || !params.Bool {
+		t.Errorf("unexpected values: %+v", params)
+	}
+	if params.Ptr == nil || *params.Ptr != 7 {
+		t.Errorf("expected pointer to 7, got %v", params.Ptr)
+	}
+	if params.Number != "9007199254740993" {
+		t.Errorf("expected exact number, got %s", params.Number)
+	}
+}
+
+func TestStringCoercion_ErrorDetails(t *testing.T) {
+	validator := godantic.NewValidator[TCoercionParams]()
+
+	_, errs := validator.ValidateFromMultiValueMap(map[string][]string{
+		"uint8": {"300"},
+		"int":   {"+5"},
+	})
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 errors, got %v", errs)
+	}
+
+	// Errors follow struct declaration order
+	if errs[0].Loc[0] != "Int" || errs[0].Message != `invalid integer "+5"` {
+		t.Errorf("unexpected first error: %v", errs[0])
+	}
+	if errs[1].Loc[0] != "Uint8" || errs[1].Message != `value "300" out of range for uint8` {
+		t.Errorf("unexpected second error: %v", errs[1])
+	}
+}
+
+func TestStringCoercion_BoolValues(t *testing.T) {
+	validator := godantic.NewValidator[TCoercionParams](
+		godantic.WithBoolValues(
+			[]string{"1", "true", "yes", "on"},
+			[]string{"0", "false", "no", "off"},
+		),
+	)
+
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{"1", true}, {"true", true}, {"yes", true}, {"on", true},
+		{"0", false}, {"false", false}, {"no", false}, {"off", false},
+		{"YES", true}, {"Off", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			params, errs := validator.ValidateFromStringMap(map[string]string{"bool": tt.value})
+			if len(errs) > 0 {
+				t.Fatalf("expected %q to coerce, got %v", tt.value, errs)
+			}
+			if params.Bool != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, params.Bool)
+			}
+		})
+	}
+
+	for _, value := range []string{"maybe", "t", ""} {
+		t.Run("rejects "+value, func(t *testing.T) {
+			_, errs := validator.ValidateFromMultiValueMap(map[string][]string{"bool": {value}})
+			if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeCoercion {
+				t.Fatalf("expected a coercion error for %q, got %v", value, errs)
+			}
+		})
+	}
+
+	_, errs := validator.ValidateFromStringMap(map[string]string{"bool": "maybe"})
+	want := `invalid boolean "maybe", expected one of [1 on true yes] or [0 false no off]`
+	if len(errs) != 1 || errs[0].Message != want {
+		t.Errorf("unexpected error message: %v", errs)
+	}
+}
+
+// ═══════════════════════════════════════════════════════════════════════════
+// encoding.TextUnmarshaler types (JSON bodies and string params)
+// ═══════════════════════════════════════════════════════════════════════════
+
+// TID is a 16-byte identifier written as 32 hex characters
+type TID [16]byte
+
+func (id TID) MarshalText() ([]byte, error) {
+	return []byte(hex.EncodeToString(id[:])), nil
+}
+
+func (id *TID) UnmarshalText(text []byte) error {
+	if len(text) != 32 {
+		return fmt.Errorf("expected 32 hex characters, got %d", len(text))
+	}
+	_, err := hex.Decode(id[:], text)
+	return err
+}
+
+type TShipment struct {
+	ID     TID  `json:"id"`
+	Parent *TID `json:"parent"`
+}
+
+func (s *TShipment) FieldID() godantic.FieldOptions[TID] {
+	return godantic.Field(
+		godantic.Required[TID](),
+		godantic.Validate(func(id TID) error {
+			if id[0] != 0x01 {
+				return fmt.Errorf("unknown ID version %d", id[0])
+			}
+			return nil
+		}),
+	)
+}
+
+const (
+	validTID  = "0102030405060708090a0b0c0d0e0f10"
+	parentTID = "01ffffffffffffffffffffffffffffff"
+)
+
+func TestTextUnmarshaler(t *testing.T) {
+	validator := godantic.NewValidator[TShipment]()
+	want := TID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+
+	t.Run("JSON body", func(t *testing.T) {
+		shipment, errs := validator.Unmarshal([]byte(`{"id": "` + validTID + `", "parent": "` + parentTID + `"}`))
+		if len(errs) > 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if shipment.ID != want || shipment.Parent == nil || shipment.Parent[1] != 0xff {
+			t.Errorf("unexpected shipment: %+v", shipment)
+		}
+
+		data, errs := validator.Marshal(shipment)
+		if len(errs) > 0 || !strings.Contains(string(data), `"id":"`+validTID+`"`) {
+			t.Errorf("expected hex ID in output, got %s %v", data, errs)
+		}
+	})
+
+	t.Run("constraints apply to the decoded value", func(t *testing.T) {
+		_, errs := validator.Unmarshal([]byte(`{"id": "ff02030405060708090a0b0c0d0e0f10"}`))
+		if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeConstraint || errs[0].Message != "unknown ID version 255" {
+			t.Errorf("expected version error, got %v", errs)
+		}
+		_, errs = validator.Unmarshal([]byte(`{}`))
+		if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeRequired {
+			t.Errorf("expected required error, got %v", errs)
+		}
+	})
+
+	t.Run("string params", func(t *testing.T) {
+		shipment, errs := validator.ValidateFromStringMap(map[string]string{"id": validTID, "parent": parentTID})
+		if len(errs) > 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if shipment.ID != want || shipment.Parent == nil {
+			t.Errorf("unexpected shipment: %+v", shipment)
+		}
+
+		shipment, errs = validator.ValidateFromMultiValueMap(map[string][]string{"id": {validTID}})
+		if len(errs) > 0 || shipment.ID != want {
+			t.Errorf("unexpected result: %+v %v", shipment, errs)
+		}
+
+		id, errs := godantic.ValidateParam[TID]("id", []string{validTID})
+		if len(errs) > 0 || id != want {
+			t.Errorf("unexpected ValidateParam result: %v %v", id, errs)
+		}
+	})
+
+	t.Run("UnmarshalText errors are coercion errors", func(t *testing.T) {
+		_, errs := validator.ValidateFromStringMap(map[string]string{"id": "abc"})
+		if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeCoercion || errs[0].Loc[0] != "ID" {
+			t.Fatalf("expected a coercion error, got %v", errs)
+		}
+		if !strings.Contains(errs[0].Message, "expected 32 hex characters") {
+			t.Errorf("expected the UnmarshalText error in the message, got %q", errs[0].Message)
+		}
+
+		_, errs = godantic.ValidateParam[*TID]("id", []string{"zz"})
+		if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeCoercion {
+			t.Errorf("expected a coercion error, got %v", errs)
+		}
+	})
+}
+
+type TCoerceColor string
+
+func TestCoerceString(t *testing.T) {
+	tests := []struct {
+		value string
+		typ   reflect.Type
+		want  any
+	}{
+		{"-42", reflect.TypeFor[int](), -42},
+		{"127", reflect.TypeFor[int8](), int8(127)},
+		{"-7", reflect.TypeFor[int32](), int32(-7)},
+		{"9007199254740993", reflect.TypeFor[int64](), int64(9007199254740993)},
+		{"42", reflect.TypeFor[uint](), uint(42)},
+		{"65535", reflect.TypeFor[uint16](), uint16(65535)},
+		{"1.5", reflect.TypeFor[float32](), float32(1.5)},
+		{"-2.25e3", reflect.TypeFor[float64](), -2250.0},
+		{"true", reflect.TypeFor[bool](), true},
+		{"0", reflect.TypeFor[bool](), false},
+		{"hello", reflect.TypeFor[string](), "hello"},
+		{"red", reflect.TypeFor[TCoerceColor](), TCoerceColor("red")},
+		{"12.50", reflect.TypeFor[json.Number](), json.Number("12.50")},
+		{"1m30s", reflect.TypeFor[time.Duration](), 90 * time.Second},
+		{validTID, reflect.TypeFor[TID](), TID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}},
+		{"5", reflect.TypeFor[godantic.Optional[int]](), godantic.Some(5)},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%v %s", tt.typ, tt.value), func(t *testing.T) {
+			got, err := godantic.CoerceString(tt.value, tt.typ)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Type() != tt.typ || got.Interface() != tt.want {
+				t.Errorf("got %v (%v), want %v (%v)", got, got.Type(), tt.want, tt.typ)
+			}
+		})
+	}
+
+	t.Run("pointer", func(t *testing.T) {
+		got, err := godantic.CoerceString("8", reflect.TypeFor[*int]())
+		if err != nil || got.Type() != reflect.TypeFor[*int]() || *got.Interface().(*int) != 8 {
+			t.Errorf("got %v, %v", got, err)
+		}
+	})
+
+	errorTests := []struct {
+		value string
+		typ   reflect.Type
+		want  string
+	}{
+		{"abc", reflect.TypeFor[int](), `invalid integer "abc"`},
+		{"+1", reflect.TypeFor[int](), `invalid integer "+1"`},
+		{"128", reflect.TypeFor[int8](), `value "128" out of range for int8`},
+		{"-1", reflect.TypeFor[uint](), `invalid unsigned integer "-1"`},
+		{"0x10", reflect.TypeFor[float64](), `invalid number "0x10"`},
+		{"yes", reflect.TypeFor[bool](), `invalid boolean "yes"`},
+		{"30", reflect.TypeFor[time.Duration](), `invalid duration "30"`},
+		{"zz", reflect.TypeFor[TID](), "expected 32 hex characters"},
+		{"a", reflect.TypeFor[[]string](), "cannot coerce a string to []string"},
+		{"a", reflect.TypeFor[map[string]int](), "cannot coerce a string to map[string]int"},
+		{"a", nil, "cannot coerce a string to <nil>"},
+	}
+	for _, tt := range errorTests {
+		t.Run(fmt.Sprintf("error %v %s", tt.typ, tt.value), func(t *testing.T) {
+			_, err := godantic.CoerceString(tt.value, tt.typ)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestCoerceStrings(t *testing.T) {
+	got, err := godantic.CoerceStrings([]string{"1", "2", "3"}, reflect.TypeFor[[]uint8]())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ids := got.Interface().([]uint8); len(ids) != 3 || ids[2] != 3 {
+		t.Errorf("got %v", ids)
+	}
+
+	if _, err := godantic.CoerceStrings([]string{"1", "x"}, reflect.TypeFor[[]int]()); err == nil || err.Error() != `value 1: invalid integer "x"` {
+		t.Errorf("expected the failing index in the error, got %v", err)
+	}
+	if _, err := godantic.CoerceStrings([]string{"1"}, reflect.TypeFor[int]()); err == nil {
+		t.Error("expected an error for a non-slice type")
+	}
+}
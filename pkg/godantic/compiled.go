@@ -0,0 +1,151 @@
+package godantic
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/deepankarm/godantic/pkg/internal/reflectutil"
+	"github.com/deepankarm/godantic/pkg/internal/walk"
+)
+
+// CompiledValidator decodes and validates T like Validator.Unmarshal, but
+// decodes valid input with a single json.Unmarshal when T allows it. Only
+// Unmarshal has a compiled path; use the Validator to validate values already
+// in memory. Validation runs on the same walker as Validator, so results are
+// the same, including error order and locations. It is safe for concurrent use.
+type CompiledValidator[T any] struct {
+	validator *Validator[T]
+	fastJSON  bool                    // T can be decoded with a single json.Unmarshal
+	variants  map[string]*variantPlan // Dispatch by discriminator value, for WithDiscriminator validators
+}
+
+// Compile prepares a fast Unmarshal path for T, for hot paths where the
+// walker's decoding of each nested object shows up in profiles. It checks up
+// front whether T can be decoded with a single json.Unmarshal. For
+// WithDiscriminator validators, Unmarshal dispatches on the discriminator to a
+// variant resolved once per discriminator value.
+//
+// Example:
+//
+//	var orderValidator = godantic.NewValidator[Order]().Compile()
+//
+//	func handle(data []byte) {
+//	    order, errs := orderValidator.Unmarshal(data)
+//	    ...
+//	}
+func (v *Validator[T]) Compile() *CompiledValidator[T] {
+	cv := &CompiledValidator[T]{validator: v}
+	if v.config.discriminator != nil {
+		cv.variants = compileVariants(v.config.discriminator)
+		return cv
+	}
+
+	var zero T
+	if root := reflect.TypeOf(zero); root != nil {
+		cv.fastJSON = !needsWalkerDecode(root, map[reflect.Type]bool{})
+	}
+	return cv
+}
+
+// Unmarshal decodes, applies defaults and validates like Validator.Unmarshal.
+// Valid input is decoded with a single json.Unmarshal and then walked for
+// defaults and validation; field-level decode errors and discriminated union
+// fields use the regular path so error reporting is unchanged.
+func (cv *CompiledValidator[T]) Unmarshal(data []byte) (*T, ValidationErrors) {
+	if cv.variants != nil {
+		return cv.unmarshalVariant(data)
+	}
+	if !cv.fastJSON {
+		return cv.validator.Unmarshal(data)
+	}
+
+	var obj T
+	objPtr := reflect.ValueOf(&obj)
+
+	var transformed []byte
+	var hookErrs ValidationErrors
+	if objPtr.Elem().Kind() == reflect.Slice {
+		transformed, hookErrs = cv.validator.transformSliceHooks(objPtr, data)
+	} else {
+		transformed, hookErrs = applyBeforeValidateHook[[]byte](objPtr, data)
+	}
+	if hookErrs != nil {
+		cv.validator.notify(hookErrs)
+		return nil, hookErrs
+	}
+
+	transformed = fromTagNames(transformed, objPtr.Elem().Type(), cv.validator.config.tagName)
+	if err := walk.DecodeJSON(transformed, &obj, cv.validator.config.useNumber); err != nil {
+		// Let the regular path report decode errors field by field
+		return cv.validator.Unmarshal(data)
+	}
+	// The walker leaves an empty root array as a nil slice
+	if root := objPtr.Elem(); root.Kind() == reflect.Slice && root.Len() == 0 {
+		root.SetZero()
+	}
+
+	errs := walkDecoded(objPtr, &cv.validator.config)
+	if len(errs) > 0 {
+		cv.validator.notify(errs)
+		return &obj, errs
+	}
+
+	if err := callAfterValidateHook(&obj); err != nil {
+		errs = ValidationErrors{{
+			Loc:     []string{},
+			Message: fmt.Sprintf("AfterValidate hook failed: %v", err),
+			Type:    ErrorTypeHookError,
+		}}
+		cv.validator.notify(errs)
+		return nil, errs
+	}
+
+	cv.validator.notify(nil)
+	return &obj, nil
+}
+
+// needsWalkerDecode scans the field options of typ and the types nested in it,
+// caching them for the walker, and reports whether decoding typ needs the
+// walker's unmarshal processor: discriminated unions and interface fields
+// can't be decoded by encoding/json directly, sibling validators and
+// conditional required checks read the raw objects, fixed-size array fields
+// have their JSON length checked, EnumFromInt fields may hold an integer index,
+// and durations may be strings.
+func needsWalkerDecode(typ reflect.Type, seen map[reflect.Type]bool) bool {
+	if seen[typ] {
+		return false
+	}
+	seen[typ] = true
+
+	switch typ.Kind() {
+	case reflect.Interface:
+		return typ.NumMethod() > 0 // any/interface{} decodes fine
+	case reflect.Array:
+		return true
+	case reflect.Pointer, reflect.Slice, reflect.Map:
+		return needsWalkerDecode(typ.Elem(), seen)
+	case reflect.Struct:
+	default:
+		return reflectutil.IsDuration(typ)
+	}
+	if reflectutil.IsDuration(typ) || reflectutil.IsBasicType(typ) {
+		return false
+	}
+
+	for _, opts := range cachedScanner.ScanFieldOptions(typ) {
+		if len(opts.SiblingValidators) > 0 || len(opts.RequiredWhen) > 0 || opts.Constraints[ConstraintEnumFromInt] != nil {
+			return true
+		}
+		for _, key := range []string{ConstraintDiscriminator, ConstraintAnyOf, "anyOfTypes"} {
+			if _, ok := opts.Constraints[key]; ok {
+				return true
+			}
+		}
+	}
+	for i := 0; i < typ.NumField(); i++ {
+		if needsWalkerDecode(typ.Field(i).Type, seen) {
+			return true
+		}
+	}
+	return false
+}
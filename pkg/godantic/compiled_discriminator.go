@@ -0,0 +1,101 @@
+package godantic
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"reflect"
+
+	"github.com/deepankarm/godantic/pkg/internal/reflectutil"
+	"github.com/deepankarm/godantic/pkg/internal/walk"
+)
+
+// variantPlan is the decode target of one discriminator value, resolved once
+// by Compile.
+type variantPlan struct {
+	typ      reflect.Type // The variant as registered, possibly a pointer
+	elem     reflect.Type // The struct type decoded into
+	fastJSON bool         // The variant can be decoded with a single json.Unmarshal
+}
+
+// compileVariants resolves every variant of cfg.
+func compileVariants(cfg *discriminatorConfig) map[string]*variantPlan {
+	types := cfg.variantTypes()
+	variants := make(map[string]*variantPlan, len(types))
+	for value, typ := range types {
+		elem := reflectutil.UnwrapPointer(typ)
+		variants[value] = &variantPlan{
+			typ:      typ,
+			elem:     elem,
+			fastJSON: !needsWalkerDecode(elem, map[reflect.Type]bool{}),
+		}
+	}
+	return variants
+}
+
+// unmarshalVariant decodes data into the variant named by its discriminator and
+// walks it for defaults and validation. Anything the fast path can't settle -
+// invalid JSON, a missing, unknown or non-string discriminator, a variant that
+// needs the walker's decoding, or a field-level decode error - goes through
+// the regular path, so results match Validator.Unmarshal.
+func (cv *CompiledValidator[T]) unmarshalVariant(data []byte) (*T, ValidationErrors) {
+	cfg := cv.validator.config
+	value, ok := peekDiscriminator(data, cfg.discriminator.field)
+	variant := cv.variants[value]
+	if !ok || variant == nil || !variant.fastJSON {
+		return cv.validator.Unmarshal(data)
+	}
+
+	ptr := reflect.New(variant.elem)
+	decoded := fromTagNames(data, variant.typ, cfg.tagName)
+	if err := walk.DecodeJSON(decoded, ptr.Interface(), cfg.useNumber); err != nil {
+		return cv.validator.Unmarshal(data)
+	}
+
+	result := reflectutil.ConvertToInterfaceType[T](ptr, variant.typ)
+	if errs := walkDecoded(ptr, &cfg); len(errs) > 0 {
+		cv.validator.notify(errs)
+		return &result, errs
+	}
+	cv.validator.notify(nil)
+	return &result, nil
+}
+
+// peekDiscriminator returns the string member field of the JSON object data
+// without decoding the other members. As with json.Unmarshal into a map, the
+// last of duplicate members wins. ok is false when data is not a single valid
+// object or the member is absent or not a string.
+func peekDiscriminator(data []byte, field string) (value string, ok bool) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return "", false
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return "", false
+		}
+		if tok != field {
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return "", false
+			}
+			continue
+		}
+		tok, err = dec.Token()
+		if err != nil {
+			return "", false
+		}
+		value, ok = tok.(string)
+		if !ok {
+			return "", false
+		}
+	}
+	if _, err := dec.Token(); err != nil { // Closing brace
+		return "", false
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return "", false
+	}
+	return value, ok
+}
@@ -0,0 +1,110 @@
+package godantic_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════
+// CompiledValidator Tests
+// Every case runs through both the regular and the compiled validator and
+// expects identical objects and errors.
+// ═══════════════════════════════════════════════════════════════════════════
+
+func sameErrors(a, b godantic.ValidationErrors) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return len(a) == len(b)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func assertCompiledUnmarshal[T any](t *testing.T, inputs ...string) {
+	t.Helper()
+	assertCompiledUnmarshalWith(t, godantic.NewValidator[T](), inputs...)
+}
+
+func assertCompiledUnmarshalWith[T any](t *testing.T, validator *godantic.Validator[T], inputs ...string) {
+	t.Helper()
+	compiled := validator.Compile()
+	for _, input := range inputs {
+		want, wantErrs := validator.Unmarshal([]byte(input))
+		got, gotErrs := compiled.Unmarshal([]byte(input))
+		if !sameErrors(wantErrs, gotErrs) {
+			t.Errorf("%s: errors differ\n  validator: %v\n  compiled:  %v", input, wantErrs, gotErrs)
+		}
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("%s: objects differ\n  validator: %+v\n  compiled:  %+v", input, want, got)
+		}
+	}
+}
+
+func TestCompiledValidator_Unmarshal(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		assertCompiledUnmarshal[TTeam](t,
+			`{"members": [{"name": "a"}, {"level": "senior"}], "by_region": {"eu": {"name": "c"}, "us": {}}, "leads": {"eu": {"name": "e"}, "us": null}}`,
+			`{}`,
+		)
+		assertCompiledUnmarshal[PersonWithAddress](t, `{"Name": "Ann"}`, `{"Address": {"City": "Paris"}}`)
+	})
+
+	t.Run("nested validation", func(t *testing.T) {
+		assertCompiledUnmarshal[TOrganization](t,
+			`{"name": "Acme", "employees": [{"name": "a", "email": "a@x"}]}`,
+			`{"employees": [{"name": "a"}, {}]}`,
+		)
+		assertCompiledUnmarshal[[]TPlanTask](t, `[{"Title": "a"}, {"Estimate": {"Hours": -1}}]`, `[]`)
+	})
+
+	t.Run("decode errors", func(t *testing.T) {
+		assertCompiledUnmarshal[TOrganization](t,
+			`{"name": 5, "employees": [{"name": "a", "email": "a@x"}]}`,
+			`{"name": "Acme", "employees": {}}`,
+			`not json`,
+		)
+	})
+
+	t.Run("discriminated unions", func(t *testing.T) {
+		assertCompiledUnmarshal[TDocument](t,
+			`{"title": "T", "blocks": [{"type": "text", "text": "hi"}, {"type": "code", "code": "x"}]}`,
+			`{"blocks": [{"type": "image", "url": "x"}]}`,
+		)
+	})
+
+	t.Run("discriminator dispatch", func(t *testing.T) {
+		inputs := []string{
+			`{"species": "cat", "name": "Whiskers", "lives_left": 7}`,
+			`{"species": "dog", "name": "", "breed": "Lab"}`,
+			`{"species": "bird", "name": "Tweety", "wingspan": -1}`,
+			`{"species": "cat", "name": "Tom", "species": "dog", "breed": "Pug"}`,
+			`{"species": "fish", "name": "Nemo"}`,
+			`{"species": 1, "name": "Nemo"}`,
+			`{"name": "Mystery"}`,
+			`{"species": "cat", "lives_left": "seven"}`,
+			`{"species": "cat"} trailing`,
+			`[{"species": "cat"}]`,
+			`not json`,
+		}
+		assertCompiledUnmarshalWith(t, NewTAnimalValidator(), inputs...)
+		assertCompiledUnmarshalWith(t, godantic.NewValidator[TAnimal](
+			godantic.WithDiscriminator("species", map[string]any{"cat": TCat{}, "dog": TDog{}}),
+		), inputs...)
+	})
+}
+
+func TestCompiledValidator_Concurrent(t *testing.T) {
+	compiled := godantic.NewValidator[TTeam]().Compile()
+	done := make(chan godantic.ValidationErrors)
+	for range 8 {
+		go func() {
+			_, errs := compiled.Unmarshal([]byte(`{"members": [{}], "leads": {"eu": {}}}`))
+			done <- errs
+		}()
+	}
+	for range 8 {
+		if errs := <-done; len(errs) != 2 {
+			t.Errorf("expected 2 errors, got %v", errs)
+		}
+	}
+}
@@ -12,7 +12,10 @@ const (
 	ConstraintWriteOnly   = "writeOnly"
 	ConstraintDeprecated  = "deprecated"
 	ConstraintDefault     = "default"
+	ConstraintDefaultFunc = "defaultFunc"
 	ConstraintConst       = "const"
+	ConstraintExtensions  = "extensions" // Vendor extensions set by Extension, keyed "x-..."
+	ConstraintSchema      = "schema"     // Hand-written schema set by SchemaOverride
 
 	// Numeric constraints
 	ConstraintMinimum          = "minimum"
@@ -32,13 +35,26 @@ const (
 	ConstraintMinItems    = "minItems"
 	ConstraintMaxItems    = "maxItems"
 	ConstraintUniqueItems = "uniqueItems"
+	ConstraintItems       = "items" // Element constraints set by Each
 
 	// Object/Map constraints
 	ConstraintMinProperties = "minProperties"
 	ConstraintMaxProperties = "maxProperties"
+	ConstraintPropertyNames = "propertyNames" // Key pattern set by MapKeyPattern
+
+	// File constraints on *multipart.FileHeader fields
+	ConstraintMaxFileSize  = "maxFileSize"
+	ConstraintContentTypes = "contentTypes"
+
+	// Duration constraints on time.Duration fields
+	ConstraintMinDuration = "minDuration"
+	ConstraintMaxDuration = "maxDuration"
 
 	// Value constraints
-	ConstraintEnum = "enum"
+	ConstraintEnum        = "enum"
+	ConstraintEnumFromInt = "enumFromInt" // Integer indexes decoded by EnumFromInt
+	ConstraintNotEnum     = "notEnum"     // Values excluded by NotOneOf
+	ConstraintNotPattern  = "notPattern"  // Pattern excluded by NotRegex
 
 	// Union constraints
 	ConstraintAnyOf         = "anyOf"
@@ -46,4 +62,16 @@ const (
 
 	// Nullable constraint (anyOf with null)
 	ConstraintNullable = "nullable"
+
+	// StrictRequired keeps a field required even when it has a default
+	ConstraintStrictRequired = "strictRequired"
+
+	// OmitEmpty drops a zero-valued field from Marshal output
+	ConstraintOmitEmpty = "omitEmpty"
+
+	// MarshalAs transforms a field's value in Marshal output
+	ConstraintMarshalAs = "marshalAs"
+
+	// NonEmpty makes a required field fail for empty strings, slices and maps
+	ConstraintNonEmpty = "nonEmpty"
 )
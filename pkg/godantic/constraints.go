@@ -1,8 +1,17 @@
 package godantic
 
 import (
+	"cmp"
+	"encoding/json"
 	"fmt"
+	"maps"
+	"math/big"
+	"reflect"
 	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/deepankarm/godantic/pkg/internal/errors"
 )
 
 // ensureConstraints initializes the Constraints_ map if it's nil
@@ -34,36 +43,74 @@ func Example[T any](ex T) func(FieldOptions[T]) FieldOptions[T] {
 	}
 }
 
-// Min sets a minimum value constraint (for numbers and strings)
+// Min sets a minimum value constraint for numbers, including json.Number.
+// JSON Schema's "minimum" doesn't apply to strings, so on a string field Min
+// fails the field and is reported by Validator.Err; use MinLen to bound the
+// length instead.
 func Min[T Ordered](min T) func(FieldOptions[T]) FieldOptions[T] {
 	return func(fo FieldOptions[T]) FieldOptions[T] {
+		if err := stringBoundErr[T]("Min", min, "MinLen"); err != nil {
+			fo.Errors_ = append(fo.Errors_, err)
+			return fo.validateWith(func(T) error { return err })
+		}
+
 		fo = ensureConstraints(fo)
 		fo.Constraints_[ConstraintMinimum] = min
 
 		return fo.validateWith(func(val T) error {
-			if val < min {
-				return fmt.Errorf("value must be >= %v", min)
+			if compareOrdered(val, min) < 0 {
+				return withInput(fmt.Errorf("value must be >= %v", min), val)
 			}
 			return nil
 		})
 	}
 }
 
-// Max sets a maximum value constraint (for numbers and strings)
+// Max sets a maximum value constraint for numbers, including json.Number.
+// As with Min, a string field fails and is reported by Validator.Err; use
+// MaxLen instead.
 func Max[T Ordered](max T) func(FieldOptions[T]) FieldOptions[T] {
 	return func(fo FieldOptions[T]) FieldOptions[T] {
+		if err := stringBoundErr[T]("Max", max, "MaxLen"); err != nil {
+			fo.Errors_ = append(fo.Errors_, err)
+			return fo.validateWith(func(T) error { return err })
+		}
+
 		fo = ensureConstraints(fo)
 		fo.Constraints_[ConstraintMaximum] = max
 
 		return fo.validateWith(func(val T) error {
-			if val > max {
-				return fmt.Errorf("value must be <= %v", max)
+			if compareOrdered(val, max) > 0 {
+				return withInput(fmt.Errorf("value must be <= %v", max), val)
 			}
 			return nil
 		})
 	}
 }
 
+// stringBoundErr reports a numeric bound on a string field, pointing to the
+// length option to use instead. json.Number fields hold numbers and pass.
+func stringBoundErr[T Ordered](option string, bound T, lengthOption string) error {
+	typ := reflect.TypeFor[T]()
+	if typ.Kind() != reflect.String || typ == reflect.TypeFor[json.Number]() {
+		return nil
+	}
+	return fmt.Errorf("%s(%q) does not apply to %s fields: bounds are numeric in JSON Schema; use %s for the length", option, fmt.Sprint(bound), typ, lengthOption)
+}
+
+// compareOrdered compares two Ordered values. json.Number values are compared
+// numerically with arbitrary precision rather than as strings.
+func compareOrdered[T Ordered](a, b T) int {
+	if an, ok := any(a).(json.Number); ok {
+		x, xok := new(big.Rat).SetString(string(an))
+		y, yok := new(big.Rat).SetString(string(any(b).(json.Number)))
+		if xok && yok {
+			return x.Cmp(y)
+		}
+	}
+	return cmp.Compare(a, b)
+}
+
 // MinLen sets a minimum length constraint for strings
 func MinLen(min int) func(FieldOptions[string]) FieldOptions[string] {
 	return func(fo FieldOptions[string]) FieldOptions[string] {
@@ -72,7 +119,7 @@ func MinLen(min int) func(FieldOptions[string]) FieldOptions[string] {
 
 		return fo.validateWith(func(val string) error {
 			if len(val) < min {
-				return fmt.Errorf("length must be >= %d", min)
+				return withInput(fmt.Errorf("length must be >= %d", min), val)
 			}
 			return nil
 		})
@@ -87,23 +134,56 @@ func MaxLen(max int) func(FieldOptions[string]) FieldOptions[string] {
 
 		return fo.validateWith(func(val string) error {
 			if len(val) > max {
-				return fmt.Errorf("length must be <= %d", max)
+				return withInput(fmt.Errorf("length must be <= %d", max), val)
 			}
 			return nil
 		})
 	}
 }
 
-// Regex sets a pattern constraint for string validation
+// Regex sets a pattern constraint for string validation.
+// An invalid pattern does not panic: the field fails validation with the
+// compile error, is left out of the schema's "pattern", and the error is
+// reported by Validator.Err.
 func Regex(pattern string) func(FieldOptions[string]) FieldOptions[string] {
-	re := regexp.MustCompile(pattern)
+	re, err := regexp.Compile(pattern)
 	return func(fo FieldOptions[string]) FieldOptions[string] {
+		if err != nil {
+			patternErr := fmt.Errorf("invalid regex pattern %q: %w", pattern, err)
+			fo.Errors_ = append(fo.Errors_, patternErr)
+			return fo.validateWith(func(string) error { return patternErr })
+		}
+
 		fo = ensureConstraints(fo)
 		fo.Constraints_[ConstraintPattern] = pattern
 
 		return fo.validateWith(func(val string) error {
 			if !re.MatchString(val) {
-				return fmt.Errorf("value does not match pattern %s", pattern)
+				return withInput(fmt.Errorf("value does not match pattern %s", pattern), val)
+			}
+			return nil
+		})
+	}
+}
+
+// NotRegex rejects strings matching pattern, for blocklists such as forbidden
+// words. The schema expresses it as {"not": {"pattern": ...}}. As with Regex,
+// an invalid pattern fails the field and is reported by Validator.Err.
+func NotRegex(pattern string) func(FieldOptions[string]) FieldOptions[string] {
+	re, err := regexp.Compile(pattern)
+	return func(fo FieldOptions[string]) FieldOptions[string] {
+		if err != nil {
+			patternErr := fmt.Errorf("invalid regex pattern %q: %w", pattern, err)
+			fo.Errors_ = append(fo.Errors_, patternErr)
+			return fo.validateWith(func(string) error { return patternErr })
+		}
+
+		fo = ensureConstraints(fo)
+		fo.Constraints_[ConstraintNotPattern] = pattern
+
+		return fo.validateWith(func(val string) error {
+			if re.MatchString(val) {
+				return fmt.Errorf("value must not match pattern %s", pattern)
 			}
 			return nil
 		})
@@ -112,7 +192,7 @@ func Regex(pattern string) func(FieldOptions[string]) FieldOptions[string] {
 
 // Email is a convenience function for email validation
 func Email() func(FieldOptions[string]) FieldOptions[string] {
-	return Regex(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
+	return Regex(emailRegex.String())
 }
 
 // URL is a convenience function for URL validation
@@ -120,7 +200,9 @@ func URL() func(FieldOptions[string]) FieldOptions[string] {
 	return Regex(`^https?://[^\s/$.?#].[^\s]*$`)
 }
 
-// OneOf sets an enum constraint - value must be one of the allowed values
+// OneOf sets an enum constraint - value must be one of the allowed values.
+// A string close to an allowed value, such as a typo, gets a "did you mean"
+// hint in the error message.
 func OneOf[T comparable](allowed ...T) func(FieldOptions[T]) FieldOptions[T] {
 	return func(fo FieldOptions[T]) FieldOptions[T] {
 		fo = ensureConstraints(fo)
@@ -132,11 +214,56 @@ func OneOf[T comparable](allowed ...T) func(FieldOptions[T]) FieldOptions[T] {
 					return nil
 				}
 			}
-			return fmt.Errorf("value must be one of %v", allowed)
+			if hint := suggestion(val, allowed); hint != "" {
+				return withInput(fmt.Errorf("value must be one of %v; %s", allowed, hint), val)
+			}
+			return withInput(fmt.Errorf("value must be one of %v", allowed), val)
+		})
+	}
+}
+
+// NotOneOf rejects the given values, for blocklists such as reserved
+// usernames. The schema expresses it as {"not": {"enum": [...]}}.
+func NotOneOf[T comparable](excluded ...T) func(FieldOptions[T]) FieldOptions[T] {
+	return func(fo FieldOptions[T]) FieldOptions[T] {
+		fo = ensureConstraints(fo)
+		fo.Constraints_[ConstraintNotEnum] = excluded
+
+		return fo.validateWith(func(val T) error {
+			if slices.Contains(excluded, val) {
+				return fmt.Errorf("value must not be one of %v", excluded)
+			}
+			return nil
 		})
 	}
 }
 
+// EnumFromInt lets Unmarshal accept an enum field as its integer index, for
+// clients that send 0 rather than "low". An incoming integer is replaced with
+// values[index] before decoding, so OneOf and the other options check the enum
+// value; an index missing from values fails. The schema still lists the enum
+// values only.
+//
+// Example:
+//
+//	func (t *Task) FieldPriority() godantic.FieldOptions[Priority] {
+//	    return godantic.Field(
+//	        godantic.OneOf(PriorityLow, PriorityHigh),
+//	        godantic.EnumFromInt(map[int]Priority{0: PriorityLow, 1: PriorityHigh}),
+//	    )
+//	}
+func EnumFromInt[T comparable](values map[int]T) func(FieldOptions[T]) FieldOptions[T] {
+	indexed := make(map[int]any, len(values))
+	for index, value := range values {
+		indexed[index] = value
+	}
+	return func(fo FieldOptions[T]) FieldOptions[T] {
+		fo = ensureConstraints(fo)
+		fo.Constraints_[ConstraintEnumFromInt] = indexed
+		return fo
+	}
+}
+
 // MultipleOf sets a constraint that the value must be a multiple of the given number
 func MultipleOf[T Ordered](divisor T) func(FieldOptions[T]) FieldOptions[T] {
 	return func(fo FieldOptions[T]) FieldOptions[T] {
@@ -147,6 +274,15 @@ func MultipleOf[T Ordered](divisor T) func(FieldOptions[T]) FieldOptions[T] {
 			// This is a simplified check - proper modulo for all numeric types would be more complex
 			// For now, convert to float64 for the check
 			switch any(val).(type) {
+			case json.Number:
+				v, vok := new(big.Rat).SetString(string(any(val).(json.Number)))
+				d, dok := new(big.Rat).SetString(string(any(divisor).(json.Number)))
+				if !vok || !dok || d.Sign() == 0 {
+					return nil
+				}
+				if !new(big.Rat).Quo(v, d).IsInt() {
+					return fmt.Errorf("value must be a multiple of %v", divisor)
+				}
 			case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
 				// Integer types - can check properly
 				var v, d int64
@@ -212,39 +348,114 @@ func Title[T any](title string) func(FieldOptions[T]) FieldOptions[T] {
 	}
 }
 
-// Format sets a format hint for the field (e.g., "date-time", "email", "uri")
+// Extension adds a vendor extension such as "x-ui-widget" to the field's schema,
+// written verbatim for code generators and documentation tools. The key must
+// start with "x-"; any other key is left out of the schema and reported by
+// Validator.Err. It doesn't affect validation.
+//
+// Example:
+//
+//	godantic.Field(godantic.Extension[string]("x-ui-widget", "textarea"))
+func Extension[T any](key string, value any) func(FieldOptions[T]) FieldOptions[T] {
+	return func(fo FieldOptions[T]) FieldOptions[T] {
+		if !strings.HasPrefix(key, "x-") {
+			fo.Errors_ = append(fo.Errors_, fmt.Errorf(`extension key %q must start with "x-"`, key))
+			return fo
+		}
+		fo = ensureConstraints(fo)
+		extensions, _ := fo.Constraints_[ConstraintExtensions].(map[string]any)
+		extensions = maps.Clone(extensions)
+		if extensions == nil {
+			extensions = make(map[string]any)
+		}
+		extensions[key] = value
+		fo.Constraints_[ConstraintExtensions] = extensions
+		return fo
+	}
+}
+
+// SchemaOverride replaces the generated schema of the field with schema,
+// written verbatim, for consumers that need a shape godantic doesn't produce.
+// The field's other options still validate it but no longer show in its
+// schema; the field stays in "required" as before. Refs in schema are left as
+// written.
+//
+// Example:
+//
+//	godantic.Field(
+//	    godantic.MinLen(3),
+//	    godantic.SchemaOverride[string](map[string]any{"type": "string", "x-llm-hint": "a city"}),
+//	)
+func SchemaOverride[T any](schema map[string]any) func(FieldOptions[T]) FieldOptions[T] {
+	return func(fo FieldOptions[T]) FieldOptions[T] {
+		fo = ensureConstraints(fo)
+		fo.Constraints_[ConstraintSchema] = maps.Clone(schema)
+		return fo
+	}
+}
+
+// Format sets the schema format of the field (e.g., "date-time", "email", "uri").
+// String values are also checked by the validator registered for the format
+// with RegisterFormat; "email", "uuid", "date-time" (RFC 3339) and "date" are
+// built in, and other formats are schema hints only.
 func Format[T any](format string) func(FieldOptions[T]) FieldOptions[T] {
 	return func(fo FieldOptions[T]) FieldOptions[T] {
 		fo = ensureConstraints(fo)
 		fo.Constraints_[ConstraintFormat] = format
-		return fo
+
+		return fo.validateWith(func(val T) error {
+			validate := lookupFormat(format)
+			if validate == nil {
+				return nil
+			}
+			s, ok := formatString(val)
+			if !ok {
+				return nil
+			}
+			if err := validate(s); err != nil {
+				return fmt.Errorf("value is not a valid %s: %v", format, err)
+			}
+			return nil
+		})
 	}
 }
 
-// ExclusiveMin sets an exclusive minimum constraint (value must be > min, not >=)
+// ExclusiveMin sets an exclusive minimum constraint (value must be > min, not >=).
+// Like Min, it is rejected on string fields.
 func ExclusiveMin[T Ordered](min T) func(FieldOptions[T]) FieldOptions[T] {
 	return func(fo FieldOptions[T]) FieldOptions[T] {
+		if err := stringBoundErr[T]("ExclusiveMin", min, "MinLen"); err != nil {
+			fo.Errors_ = append(fo.Errors_, err)
+			return fo.validateWith(func(T) error { return err })
+		}
+
 		fo = ensureConstraints(fo)
 		fo.Constraints_[ConstraintExclusiveMinimum] = min
 
 		return fo.validateWith(func(val T) error {
-			if val <= min {
-				return fmt.Errorf("value must be > %v", min)
+			if compareOrdered(val, min) <= 0 {
+				return withInput(fmt.Errorf("value must be > %v", min), val)
 			}
 			return nil
 		})
 	}
 }
 
-// ExclusiveMax sets an exclusive maximum constraint (value must be < max, not <=)
+// ExclusiveMax sets an exclusive maximum constraint (value must be < max, not <=).
+// Like Max, it is rejected on string fields.
 func ExclusiveMax[T Ordered](max T) func(FieldOptions[T]) FieldOptions[T] {
 	return func(fo FieldOptions[T]) FieldOptions[T] {
+		if err := stringBoundErr[T]("ExclusiveMax", max, "MaxLen"); err != nil {
+			fo.Errors_ = append(fo.Errors_, err)
+			return fo.validateWith(func(T) error { return err })
+		}
+
 		fo = ensureConstraints(fo)
 		fo.Constraints_[ConstraintExclusiveMaximum] = max
 
 		return fo.validateWith(func(val T) error {
-			if val >= max {
-				return fmt.Errorf("value must be < %v", max)
+			if compareOrdered(val, max) >= 0 {
+				return withInput(fmt.Errorf("value must be < %v", max), val)
 			}
 			return nil
 		})
@@ -300,13 +511,57 @@ func UniqueItems[T comparable]() func(FieldOptions[[]T]) FieldOptions[[]T] {
 	}
 }
 
+// Each applies element options to every item of a slice or fixed-size array
+// field. T is the field type and E its element type, usually inferred from the
+// options: Each[[3]int](Min(0), Max(255)). A T that is not a slice or array
+// of E fails validation and is reported by Validator.Err.
+func Each[T any, E any](opts ...func(FieldOptions[E]) FieldOptions[E]) func(FieldOptions[T]) FieldOptions[T] {
+	elem := Field(opts...)
+	return func(fo FieldOptions[T]) FieldOptions[T] {
+		typ := reflect.TypeFor[T]()
+		if (typ.Kind() != reflect.Slice && typ.Kind() != reflect.Array) || typ.Elem() != reflect.TypeFor[E]() {
+			typeErr := fmt.Errorf("Each: %s is not a slice or array of %s", typ, reflect.TypeFor[E]())
+			fo.Errors_ = append(fo.Errors_, typeErr)
+			return fo.validateWith(func(T) error { return typeErr })
+		}
+
+		fo = ensureConstraints(fo)
+		fo.Constraints_[ConstraintItems] = elem.Constraints_
+		fo.Errors_ = append(fo.Errors_, elem.Errors_...)
+
+		return fo.validateWith(func(val T) error {
+			items := reflect.ValueOf(val)
+			for i := 0; i < items.Len(); i++ {
+				item, _ := items.Index(i).Interface().(E)
+				for _, validate := range elem.Validators_ {
+					if err := validate(item); err != nil {
+						return fmt.Errorf("item [%d]: %w", i, err)
+					}
+				}
+			}
+			return nil
+		})
+	}
+}
+
 // MinProperties sets a minimum number of properties for maps
 func MinProperties(min int) func(FieldOptions[map[string]any]) FieldOptions[map[string]any] {
-	return func(fo FieldOptions[map[string]any]) FieldOptions[map[string]any] {
+	return MinPropertiesOf[any](min)
+}
+
+// MaxProperties sets a maximum number of properties for maps
+func MaxProperties(max int) func(FieldOptions[map[string]any]) FieldOptions[map[string]any] {
+	return MaxPropertiesOf[any](max)
+}
+
+// MinPropertiesOf sets a minimum number of entries for maps with values of
+// type V, such as MinPropertiesOf[int](1) on a map[string]int field
+func MinPropertiesOf[V any](min int) func(FieldOptions[map[string]V]) FieldOptions[map[string]V] {
+	return func(fo FieldOptions[map[string]V]) FieldOptions[map[string]V] {
 		fo = ensureConstraints(fo)
 		fo.Constraints_[ConstraintMinProperties] = min
 
-		return fo.validateWith(func(val map[string]any) error {
+		return fo.validateWith(func(val map[string]V) error {
 			if len(val) < min {
 				return fmt.Errorf("must have at least %d properties", min)
 			}
@@ -315,13 +570,14 @@ func MinProperties(min int) func(FieldOptions[map[string]any]) FieldOptions[map[
 	}
 }
 
-// MaxProperties sets a maximum number of properties for maps
-func MaxProperties(max int) func(FieldOptions[map[string]any]) FieldOptions[map[string]any] {
-	return func(fo FieldOptions[map[string]any]) FieldOptions[map[string]any] {
+// MaxPropertiesOf sets a maximum number of entries for maps with values of
+// type V, such as MaxPropertiesOf[int](10) on a map[string]int field
+func MaxPropertiesOf[V any](max int) func(FieldOptions[map[string]V]) FieldOptions[map[string]V] {
+	return func(fo FieldOptions[map[string]V]) FieldOptions[map[string]V] {
 		fo = ensureConstraints(fo)
 		fo.Constraints_[ConstraintMaxProperties] = max
 
-		return fo.validateWith(func(val map[string]any) error {
+		return fo.validateWith(func(val map[string]V) error {
 			if len(val) > max {
 				return fmt.Errorf("must have at most %d properties", max)
 			}
@@ -330,6 +586,38 @@ func MaxProperties(max int) func(FieldOptions[map[string]any]) FieldOptions[map[
 	}
 }
 
+// MapKeyPattern requires every key of a map with values of type V to match
+// pattern, such as MapKeyPattern[string](`^[a-z]{2}-[A-Z]{2}$`) for locale
+// codes like "en-US". The schema expresses it as {"propertyNames": {"pattern":
+// ...}}. Each key that doesn't match is reported; as with Regex, an invalid
+// pattern fails the field and is reported by Validator.Err.
+func MapKeyPattern[V any](pattern string) func(FieldOptions[map[string]V]) FieldOptions[map[string]V] {
+	re, err := regexp.Compile(pattern)
+	return func(fo FieldOptions[map[string]V]) FieldOptions[map[string]V] {
+		if err != nil {
+			patternErr := fmt.Errorf("invalid regex pattern %q: %w", pattern, err)
+			fo.Errors_ = append(fo.Errors_, patternErr)
+			return fo.validateWith(func(map[string]V) error { return patternErr })
+		}
+
+		fo = ensureConstraints(fo)
+		fo.Constraints_[ConstraintPropertyNames] = pattern
+
+		return fo.validateWith(func(val map[string]V) error {
+			var errs errors.MultiError
+			for _, key := range slices.Sorted(maps.Keys(val)) {
+				if !re.MatchString(key) {
+					errs = append(errs, withInput(fmt.Errorf("key %q does not match pattern %s", key, pattern), key))
+				}
+			}
+			if len(errs) > 0 {
+				return errs
+			}
+			return nil
+		})
+	}
+}
+
 // Const sets a constant value constraint - the value must equal this exactly
 func Const[T comparable](value T) func(FieldOptions[T]) FieldOptions[T] {
 	return func(fo FieldOptions[T]) FieldOptions[T] {
@@ -338,7 +626,7 @@ func Const[T comparable](value T) func(FieldOptions[T]) FieldOptions[T] {
 
 		return fo.validateWith(func(val T) error {
 			if val != value {
-				return fmt.Errorf("value must be %v", value)
+				return withInput(fmt.Errorf("value must be %v", value), val)
 			}
 			return nil
 		})
@@ -350,6 +638,26 @@ func Default[T any](value T) func(FieldOptions[T]) FieldOptions[T] {
 	return func(fo FieldOptions[T]) FieldOptions[T] {
 		fo = ensureConstraints(fo)
 		fo.Constraints_[ConstraintDefault] = value
+		delete(fo.Constraints_, ConstraintDefaultFunc)
+		return fo
+	}
+}
+
+// DefaultFunc sets a default computed by fn each time it is applied, for
+// values such as the current time or a generated ID. It behaves like Default
+// and replaces it, but the schema has no "default" for the field since the
+// value isn't known up front.
+//
+// Example:
+//
+//	func (e *Event) FieldCreatedAt() godantic.FieldOptions[time.Time] {
+//	    return godantic.Field(godantic.DefaultFunc(time.Now))
+//	}
+func DefaultFunc[T any](fn func() T) func(FieldOptions[T]) FieldOptions[T] {
+	return func(fo FieldOptions[T]) FieldOptions[T] {
+		fo = ensureConstraints(fo)
+		fo.Constraints_[ConstraintDefaultFunc] = func() any { return fn() }
+		delete(fo.Constraints_, ConstraintDefault)
 		return fo
 	}
 }
@@ -1,6 +1,9 @@
 package godantic_test
 
 import (
+	"encoding/json"
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/deepankarm/godantic/pkg/godantic"
@@ -103,6 +106,62 @@ func TestOneOfValidation(t *testing.T) {
 	})
 }
 
+// Test NotRegex and NotOneOf (blocklists)
+type TSignupForm struct {
+	Username string `json:"username"`
+	Bio      string `json:"bio"`
+}
+
+func (s *TSignupForm) FieldUsername() godantic.FieldOptions[string] {
+	return godantic.Field(
+		godantic.NotOneOf("admin", "root", "support"),
+		godantic.NotRegex(`^_`),
+	)
+}
+
+func (s *TSignupForm) FieldBio() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.NotRegex(`(?i)\bspam\b`))
+}
+
+func TestNotConstraints(t *testing.T) {
+	validator := godantic.NewValidator[TSignupForm]()
+
+	tests := []struct {
+		name    string
+		signup  TSignupForm
+		wantLoc string
+		wantMsg string
+	}{
+		{name: "allowed", signup: TSignupForm{Username: "ada", Bio: "Mathematician"}},
+		{name: "not a blocklisted prefix", signup: TSignupForm{Username: "administrator"}},
+		{name: "blocklisted value", signup: TSignupForm{Username: "root"}, wantLoc: "Username", wantMsg: "value must not be one of [admin root support]"},
+		{name: "forbidden pattern", signup: TSignupForm{Username: "_hidden"}, wantLoc: "Username", wantMsg: "value must not match pattern ^_"},
+		{name: "forbidden word", signup: TSignupForm{Username: "ada", Bio: "Buy SPAM now"}, wantLoc: "Bio", wantMsg: `value must not match pattern (?i)\bspam\b`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.Validate(&tt.signup)
+			if tt.wantLoc == "" {
+				if len(errs) != 0 {
+					t.Errorf("expected no errors, got %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || errs[0].Loc[0] != tt.wantLoc || errs[0].Message != tt.wantMsg {
+				t.Errorf("got %v, want %s: %s", errs, tt.wantLoc, tt.wantMsg)
+			}
+		})
+	}
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		opts := godantic.Field(godantic.NotRegex(`[`))
+		if len(opts.Errors_) != 1 || !strings.Contains(opts.Errors_[0].Error(), "invalid regex pattern") {
+			t.Errorf("expected a pattern error, got %v", opts.Errors_)
+		}
+	})
+}
+
 // Test MultipleOf
 type Dimension struct {
 	Width  int
@@ -208,6 +267,65 @@ func TestExclusiveMinMaxValidation(t *testing.T) {
 	})
 }
 
+// Test bounds that don't fit the field's type. Max[uint8](300) is a compile
+// error, but options written for int are accepted on a uint8 field.
+type Pixel struct {
+	Red   uint8
+	Green uint8
+	Alpha *uint8
+	Blue  uint
+}
+
+func (p *Pixel) FieldRed() godantic.FieldOptions[int] {
+	return godantic.Field(godantic.Max(300))
+}
+
+func (p *Pixel) FieldGreen() godantic.FieldOptions[int] {
+	return godantic.Field(godantic.Max(200))
+}
+
+func (p *Pixel) FieldAlpha() godantic.FieldOptions[float64] {
+	return godantic.Field(godantic.ExclusiveMax(256.0))
+}
+
+func (p *Pixel) FieldBlue() godantic.FieldOptions[int] {
+	return godantic.Field(godantic.Min(-1))
+}
+
+type ValidPixel struct {
+	Red uint8
+}
+
+func (p *ValidPixel) FieldRed() godantic.FieldOptions[uint8] {
+	return godantic.Field(godantic.Min[uint8](1), godantic.Max[uint8](200))
+}
+
+func TestBoundRangeErrors(t *testing.T) {
+	err := godantic.NewValidator[Pixel]().Err()
+	if err == nil {
+		t.Fatal("expected a construction error")
+	}
+	for _, want := range []string{
+		"Red: maximum 300 out of range for uint8",
+		"Alpha: exclusiveMaximum 256 out of range for uint8",
+		"Blue: minimum -1 out of range for uint",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected %q in %v", want, err)
+		}
+	}
+	if strings.Contains(err.Error(), "Green") {
+		t.Errorf("expected Max(200) to fit uint8, got %v", err)
+	}
+
+	if err := godantic.NewValidator[ValidPixel]().Err(); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if errs := godantic.NewValidator[ValidPixel]().Validate(&ValidPixel{Red: 250}); len(errs) != 1 {
+		t.Errorf("expected Max(200) to reject 250, got %v", errs)
+	}
+}
+
 // Test MinItems, MaxItems, UniqueItems
 type Playlist struct {
 	Songs     []string
@@ -339,6 +457,179 @@ func TestMapConstraints(t *testing.T) {
 	})
 }
 
+// Test MinPropertiesOf, MaxPropertiesOf on typed maps
+type TScores struct {
+	Scores map[string]int `json:"scores"`
+}
+
+func (s *TScores) FieldScores() godantic.FieldOptions[map[string]int] {
+	return godantic.Field(
+		godantic.MinPropertiesOf[int](1),
+		godantic.MaxPropertiesOf[int](2),
+	)
+}
+
+func TestTypedMapConstraints(t *testing.T) {
+	validator := godantic.NewValidator[TScores]()
+
+	if errs := validator.Validate(&TScores{Scores: map[string]int{"a": 1}}); len(errs) != 0 {
+		t.Errorf("expected no errors, got %v", errs)
+	}
+	if errs := validator.Validate(&TScores{Scores: map[string]int{}}); len(errs) != 1 {
+		t.Errorf("expected an error for an empty map, got %v", errs)
+	}
+	if errs := validator.Validate(&TScores{Scores: map[string]int{"a": 1, "b": 2, "c": 3}}); len(errs) != 1 {
+		t.Errorf("expected an error for too many entries, got %v", errs)
+	}
+}
+
+// Test MapKeyPattern
+type TTranslations struct {
+	Titles map[string]string `json:"titles"`
+}
+
+func (tr *TTranslations) FieldTitles() godantic.FieldOptions[map[string]string] {
+	return godantic.Field(godantic.MapKeyPattern[string](`^[a-z]{2}-[A-Z]{2}$`))
+}
+
+type TBadKeyPattern struct {
+	Counts map[string]int `json:"counts"`
+}
+
+func (b *TBadKeyPattern) FieldCounts() godantic.FieldOptions[map[string]int] {
+	return godantic.Field(godantic.MapKeyPattern[int](`[`))
+}
+
+func TestMapKeyPattern(t *testing.T) {
+	validator := godantic.NewValidator[TTranslations]()
+
+	t.Run("valid locale codes", func(t *testing.T) {
+		errs := validator.Validate(&TTranslations{Titles: map[string]string{"en-US": "Hello", "fr-FR": "Bonjour"}})
+		if len(errs) != 0 {
+			t.Errorf("expected no errors, got %v", errs)
+		}
+	})
+
+	t.Run("each invalid key is reported", func(t *testing.T) {
+		errs := validator.Validate(&TTranslations{Titles: map[string]string{"en-US": "Hello", "english": "Hello", "fr_fr": "Bonjour"}})
+		if len(errs) != 2 {
+			t.Fatalf("expected 2 errors, got %v", errs)
+		}
+		if errs[0].Path() != "titles" || errs[0].Input != "english" || !strings.Contains(errs[0].Message, `key "english" does not match pattern`) {
+			t.Errorf("unexpected first error: %+v", errs[0])
+		}
+		if errs[1].Input != "fr_fr" {
+			t.Errorf("expected the keys in sorted order, got %+v", errs[1])
+		}
+	})
+
+	t.Run("from JSON", func(t *testing.T) {
+		if _, errs := validator.Unmarshal([]byte(`{"titles": {"de-DE": "Hallo", "DE": "Hallo"}}`)); len(errs) != 1 {
+			t.Errorf("expected 1 error, got %v", errs)
+		}
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		if err := godantic.NewValidator[TBadKeyPattern]().Err(); err == nil || !strings.Contains(err.Error(), "invalid regex pattern") {
+			t.Errorf("expected the invalid pattern to be reported, got %v", err)
+		}
+	})
+}
+
+// Test RequiredNonEmpty
+type TProfileForm struct {
+	Name     string            `json:"name"`
+	Tags     []string          `json:"tags"`
+	Attrs    map[string]string `json:"attrs"`
+	Nickname *string           `json:"nickname"`
+	Labels   []string          `json:"labels"` // Required only, for contrast
+}
+
+func (p *TProfileForm) FieldName() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.RequiredNonEmpty[string]())
+}
+
+func (p *TProfileForm) FieldTags() godantic.FieldOptions[[]string] {
+	return godantic.Field(godantic.RequiredNonEmpty[[]string]())
+}
+
+func (p *TProfileForm) FieldAttrs() godantic.FieldOptions[map[string]string] {
+	return godantic.Field(godantic.RequiredNonEmpty[map[string]string]())
+}
+
+func (p *TProfileForm) FieldNickname() godantic.FieldOptions[*string] {
+	return godantic.Field(godantic.RequiredNonEmpty[*string]())
+}
+
+func (p *TProfileForm) FieldLabels() godantic.FieldOptions[[]string] {
+	return godantic.Field(godantic.Required[[]string]())
+}
+
+func TestRequiredNonEmpty(t *testing.T) {
+	validator := godantic.NewValidator[TProfileForm]()
+	compiled := validator.Compile()
+	// form returns a filled-in form with one field replaced (or removed when value is nil)
+	form := func(field string, value any) string {
+		fields := map[string]any{"name": "ann", "tags": []string{"a"}, "attrs": map[string]string{"k": "v"}, "nickname": "annie", "labels": []string{"x"}}
+		if value == nil {
+			delete(fields, field)
+		} else {
+			fields[field] = value
+		}
+		data, _ := json.Marshal(fields)
+		return string(data)
+	}
+
+	tests := []struct {
+		name    string
+		json    string
+		wantLoc string // "" means valid
+	}{
+		{"all filled", form("", nil), ""},
+		{"empty string", form("name", ""), "Name"},
+		{"empty slice", form("tags", []string{}), "Tags"},
+		{"empty map", form("attrs", map[string]string{}), "Attrs"},
+		{"nil pointer", form("nickname", nil), "Nickname"},
+		{"pointer to empty string", form("nickname", ""), "Nickname"},
+		{"missing", form("name", nil), "Name"},
+		{"Required accepts an empty slice", form("labels", []string{}), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, unmarshal := range []func([]byte) (*TProfileForm, godantic.ValidationErrors){validator.Unmarshal, compiled.Unmarshal} {
+				_, errs := unmarshal([]byte(tt.json))
+				if tt.wantLoc == "" {
+					if len(errs) != 0 {
+						t.Errorf("expected no errors, got %v", errs)
+					}
+					continue
+				}
+				if len(errs) != 1 || errs[0].Loc[0] != tt.wantLoc {
+					t.Fatalf("expected one error on %s, got %v", tt.wantLoc, errs)
+				}
+				if errs[0].Type != godantic.ErrorTypeRequired || errs[0].Message != "required field must not be empty" {
+					t.Errorf("expected a required error saying the field is empty, got %v", errs[0])
+				}
+			}
+		})
+	}
+
+	t.Run("validate", func(t *testing.T) {
+		form := TProfileForm{Name: "ann", Tags: []string{}, Attrs: map[string]string{"k": "v"}, Labels: []string{}}
+		errs := validator.Validate(&form)
+		if len(errs) != 2 || errs[0].Loc[0] != "Tags" || errs[1].Loc[0] != "Nickname" {
+			t.Errorf("expected errors on Tags and Nickname, got %v", errs)
+		}
+	})
+
+	t.Run("single parameter", func(t *testing.T) {
+		_, errs := godantic.ValidateParam[string]("q", []string{""}, godantic.RequiredNonEmpty[string]())
+		if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeRequired {
+			t.Errorf("expected a required error, got %v", errs)
+		}
+	})
+}
+
 // Test Const
 type Environment struct {
 	Type string
@@ -526,3 +817,145 @@ func TestSchemaMetadataConstraints(t *testing.T) {
 		}
 	})
 }
+
+type TWidgetForm struct {
+	Notes string `json:"notes"`
+}
+
+func (f *TWidgetForm) FieldNotes() godantic.FieldOptions[string] {
+	return godantic.Field(
+		godantic.Extension[string]("x-ui-widget", "textarea"),
+		godantic.Extension[string]("ui-rows", 4),
+	)
+}
+
+func (TWidgetForm) SchemaExtensions() map[string]any {
+	return map[string]any{"x-go-type": "forms.Widget", "go-type": "forms.Widget"}
+}
+
+func TestExtension(t *testing.T) {
+	opts := (&TWidgetForm{}).FieldNotes()
+	extensions := opts.Constraints_[godantic.ConstraintExtensions].(map[string]any)
+	if len(extensions) != 1 || extensions["x-ui-widget"] != "textarea" {
+		t.Errorf("expected only x-ui-widget to be kept, got %v", extensions)
+	}
+
+	validator := godantic.NewValidator[TWidgetForm]()
+	err := validator.Err()
+	if err == nil {
+		t.Fatal("expected keys without x- to be reported")
+	}
+	for _, want := range []string{
+		`SchemaExtensions: extension key "go-type" must start with "x-"`,
+		`Notes: extension key "ui-rows" must start with "x-"`,
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected %q in %q", want, err)
+		}
+	}
+
+	// Extensions don't affect validation
+	if errs := validator.Validate(&TWidgetForm{Notes: "anything"}); len(errs) != 0 {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+}
+
+type TPasswordForm struct {
+	Password string `json:"password"`
+}
+
+// passwordProblems returns every rule pw breaks
+func passwordProblems(pw string) []error {
+	var errs []error
+	if len(pw) < 12 {
+		errs = append(errs, errors.New("must be at least 12 characters"))
+	}
+	if !strings.ContainsAny(pw, "0123456789") {
+		errs = append(errs, errors.New("must contain a digit"))
+	}
+	if !strings.ContainsAny(pw, "!@#$%^&*") {
+		errs = append(errs, errors.New("must contain a symbol"))
+	}
+	return errs
+}
+
+func (s *TPasswordForm) FieldPassword() godantic.FieldOptions[string] {
+	return godantic.Field(
+		godantic.Required[string](),
+		godantic.ValidateMulti(passwordProblems),
+	)
+}
+
+func TestValidateMulti(t *testing.T) {
+	validator := godantic.NewValidator[TPasswordForm]()
+	compiled := validator.Compile()
+	want := []string{"must be at least 12 characters", "must contain a digit", "must contain a symbol"}
+
+	for name, unmarshal := range map[string]func([]byte) (*TPasswordForm, godantic.ValidationErrors){
+		"walker":   validator.Unmarshal,
+		"compiled": compiled.Unmarshal,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, errs := unmarshal([]byte(`{"password": "hunter"}`))
+			if len(errs) != len(want) {
+				t.Fatalf("expected %d errors, got %v", len(want), errs)
+			}
+			for i, err := range errs {
+				if err.Message != want[i] || err.Loc[0] != "Password" || err.Type != godantic.ErrorTypeConstraint {
+					t.Errorf("error %d: got %v, want %q at Password", i, err, want[i])
+				}
+			}
+
+			if _, errs := unmarshal([]byte(`{"password": "correct-horse-battery-staple-1!"}`)); len(errs) != 0 {
+				t.Errorf("expected a strong password to pass, got %v", errs)
+			}
+		})
+	}
+
+	t.Run("ValidateParam", func(t *testing.T) {
+		_, errs := godantic.ValidateParam("password", []string{"hunter!"}, godantic.ValidateMulti(passwordProblems))
+		if len(errs) != 2 || errs[0].Message != want[0] || errs[1].Message != want[1] {
+			t.Errorf("expected the length and digit errors, got %v", errs)
+		}
+	})
+}
+
+// Numeric bounds on strings
+type TVersionRange struct {
+	From string      `json:"from"`
+	Cost json.Number `json:"cost"`
+}
+
+func (v *TVersionRange) FieldFrom() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Min("a"), godantic.Max("m"))
+}
+
+func (v *TVersionRange) FieldCost() godantic.FieldOptions[json.Number] {
+	return godantic.Field(godantic.Min(json.Number("0")), godantic.ExclusiveMax(json.Number("100")))
+}
+
+func TestNumericBoundsOnStrings(t *testing.T) {
+	validator := godantic.NewValidator[TVersionRange]()
+
+	err := validator.Err()
+	if err == nil {
+		t.Fatal("expected Min and Max on a string field to be reported by Err")
+	}
+	for _, want := range []string{`Min("a") does not apply to string fields`, "use MinLen", `Max("m")`, "use MaxLen"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected %q in %v", want, err)
+		}
+	}
+	if strings.Contains(err.Error(), "Cost") {
+		t.Errorf("json.Number bounds are numeric and must be accepted, got %v", err)
+	}
+
+	errs := validator.Validate(&TVersionRange{From: "b", Cost: "5"})
+	if len(errs) != 2 || errs[0].Path() != "from" || errs[1].Path() != "from" {
+		t.Errorf("expected the string field to fail for both bounds, got %v", errs)
+	}
+
+	if errs := validator.Validate(&TVersionRange{From: "b", Cost: "100"}); len(errs) != 3 {
+		t.Errorf("expected the json.Number bound to still apply, got %v", errs)
+	}
+}
@@ -0,0 +1,210 @@
+package godantic
+
+import (
+	stderrors "errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/deepankarm/godantic/pkg/internal/reflectutil"
+)
+
+// CheckCoverage reports drift between T and its field options, for use in
+// tests as the struct evolves: Field{Name} methods that match no field, that
+// don't return FieldOptions of the field's type (T for an Optional[T]), or
+// that belong to a field the schema leaves out (unexported, `json:"-"` or
+// `jsonschema:"-"`); exported fields that share a JSON name and so can't all
+// appear as schema properties; and exported fields of a type the schema
+// can't describe, such as a func or a channel. Struct types reachable from
+// T's fields are checked too.
+//
+// The checks read T's fields and tags; no schema is generated, so types with
+// their own JSONSchema method are taken as they are. It is the runtime
+// counterpart of tools/godanticlint and needs no analysis framework.
+//
+// Example:
+//
+//	func TestUserCoverage(t *testing.T) {
+//	    if err := godantic.CheckCoverage[User](); err != nil {
+//	        t.Error(err)
+//	    }
+//	}
+func CheckCoverage[T any]() error {
+	return stderrors.Join(checkCoverage(reflect.TypeFor[T](), "", map[reflect.Type]bool{})...)
+}
+
+// checkCoverage collects the coverage problems of typ and the struct types
+// reachable from its fields, prefixed with the field path.
+func checkCoverage(typ reflect.Type, prefix string, visited map[reflect.Type]bool) []error {
+	typ = reflectutil.UnwrapPointer(typ)
+	for typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array || typ.Kind() == reflect.Map {
+		typ = reflectutil.UnwrapPointer(typ.Elem())
+	}
+	if typ.Kind() != reflect.Struct || reflectutil.IsBasicType(typ) || visited[typ] {
+		return nil
+	}
+	visited[typ] = true
+
+	errs := fieldMethodErrors(typ, prefix)
+	errs = append(errs, sharedJSONNameErrors(typ, prefix)...)
+	errs = append(errs, unsupportedFieldErrors(typ, prefix)...)
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		if field.IsExported() || field.Anonymous {
+			errs = append(errs, checkCoverage(field.Type, prefix+field.Name+".", visited)...)
+		}
+	}
+	return errs
+}
+
+// fieldMethodErrors checks every Field{Name} method of typ against its field
+func fieldMethodErrors(typ reflect.Type, prefix string) []error {
+	var errs []error
+	ptrType := reflect.PointerTo(typ)
+	for i := range ptrType.NumMethod() {
+		method := ptrType.Method(i)
+		name, ok := strings.CutPrefix(method.Name, "Field")
+		if !ok || name == "" || promotedMethod(typ, method.Name) {
+			continue // Promoted methods are checked on the embedded type
+		}
+		where := fmt.Sprintf("%s.%s()", typ.Name(), method.Name)
+		if prefix != "" {
+			where = strings.TrimSuffix(prefix, ".") + ": " + where
+		}
+
+		field, found := typ.FieldByName(name)
+		if !found {
+			msg := fmt.Sprintf("%s does not correspond to any field", where)
+			if closest := closestFieldName(typ, name); closest != "" {
+				msg += fmt.Sprintf(" (did you mean %s?)", closest)
+			}
+			errs = append(errs, stderrors.New(msg))
+			continue
+		}
+
+		optionsType, ok := fieldOptionsType(method.Type)
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s must take no arguments and return FieldOptions[T], got %v", where, method.Type))
+			continue
+		}
+		if optionsType != field.Type && optionsType != reflectutil.OptionalElem(field.Type) {
+			errs = append(errs, fmt.Errorf("%s returns FieldOptions[%v] but field %s has type %v", where, optionsType, name, field.Type))
+		}
+
+		switch {
+		case !field.IsExported():
+			errs = append(errs, fmt.Errorf("%s validates unexported field %s, which is not in the schema", where, name))
+		case reflectutil.JSONFieldName(field) == "-":
+			errs = append(errs, fmt.Errorf(`%s validates field %s, which is left out of the schema by json:"-"`, where, name))
+		case strings.Split(field.Tag.Get("jsonschema"), ",")[0] == "-":
+			errs = append(errs, fmt.Errorf(`%s validates field %s, which is left out of the schema by jsonschema:"-"`, where, name))
+		}
+	}
+	return errs
+}
+
+// promotedMethod reports whether typ gets the method from an embedded struct
+func promotedMethod(typ reflect.Type, name string) bool {
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		if !field.Anonymous {
+			continue
+		}
+		if _, ok := reflect.PointerTo(reflectutil.UnwrapPointer(field.Type)).MethodByName(name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldOptionsType returns T for a method func(*S) FieldOptions[T]
+func fieldOptionsType(methodType reflect.Type) (reflect.Type, bool) {
+	if methodType.NumIn() != 1 || methodType.NumOut() != 1 {
+		return nil, false
+	}
+	out := methodType.Out(0)
+	if out.PkgPath() != reflect.TypeFor[FieldOptions[any]]().PkgPath() || !strings.HasPrefix(out.Name(), "FieldOptions[") {
+		return nil, false
+	}
+	validators, ok := out.FieldByName("Validators_")
+	if !ok {
+		return nil, false
+	}
+	return validators.Type.Elem().In(0), true
+}
+
+// closestFieldName returns the field of typ nearest to name, if it is a near miss
+func closestFieldName(typ reflect.Type, name string) string {
+	best, bestDist := "", -1
+	for i := range typ.NumField() {
+		candidate := typ.Field(i).Name
+		dist, ok := nearMiss(strings.ToLower(name), strings.ToLower(candidate), len(candidate))
+		if !ok {
+			continue
+		}
+		if bestDist < 0 || dist < bestDist {
+			best, bestDist = candidate, dist
+		}
+	}
+	return best
+}
+
+// sharedJSONNameErrors reports exported fields of typ with the same JSON
+// name. encoding/json, and so the schema, keeps at most one of them.
+func sharedJSONNameErrors(typ reflect.Type, prefix string) []error {
+	var errs []error
+	byName := make(map[string]string)
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		if !field.IsExported() || field.Anonymous {
+			continue
+		}
+		jsonName := reflectutil.JSONFieldName(field)
+		if jsonName == "" {
+			jsonName = field.Name
+		}
+		if jsonName == "-" {
+			continue
+		}
+		if other, ok := byName[jsonName]; ok {
+			errs = append(errs, fmt.Errorf("%s%s and %s%s share the JSON name %q, so the schema drops at least one of them", prefix, other, prefix, field.Name, jsonName))
+			continue
+		}
+		byName[jsonName] = field.Name
+	}
+	return errs
+}
+
+// unsupportedFieldErrors reports exported JSON fields of typ whose type the
+// schema generator can't describe
+func unsupportedFieldErrors(typ reflect.Type, prefix string) []error {
+	var errs []error
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		if !field.IsExported() || field.Anonymous || reflectutil.JSONFieldName(field) == "-" {
+			continue
+		}
+		if t := unsupportedSchemaType(field.Type); t != nil {
+			errs = append(errs, fmt.Errorf("%s%s has type %v, which the schema can't describe", prefix, field.Name, t))
+		}
+	}
+	return errs
+}
+
+// unsupportedSchemaType returns the type in t, looking through pointers and
+// containers, that has no JSON schema, or nil if there is none
+func unsupportedSchemaType(t reflect.Type) reflect.Type {
+	for {
+		if _, ok := reflect.PointerTo(t).MethodByName("JSONSchema"); ok {
+			return nil
+		}
+		switch t.Kind() {
+		case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+			t = t.Elem()
+		case reflect.Func, reflect.Chan, reflect.Complex64, reflect.Complex128, reflect.UnsafePointer, reflect.Uintptr:
+			return t
+		default:
+			return nil
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package godantic_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════
+// CheckCoverage
+// ═══════════════════════════════════════════════════════════════════════════
+
+type TCoveredAddress struct {
+	City string `json:"city"`
+}
+
+func (a *TCoveredAddress) FieldCity() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Required[string]())
+}
+
+type TCoveredBase struct {
+	ID int `json:"id"`
+}
+
+func (b *TCoveredBase) FieldID() godantic.FieldOptions[int] {
+	return godantic.Field(godantic.Min(1))
+}
+
+type TCovered struct {
+	TCoveredBase
+	Name    string                     `json:"name"`
+	Email   *string                    `json:"email,omitempty"`
+	Homes   []TCoveredAddress          `json:"homes"`
+	Offices map[string]TCoveredAddress `json:"offices"`
+}
+
+func (c *TCovered) FieldName() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Required[string]())
+}
+
+func (c *TCovered) FieldEmail() godantic.FieldOptions[*string] {
+	return godantic.Field[*string]()
+}
+
+type TDriftedAddress struct {
+	City string `json:"city"`
+}
+
+// Renamed from Zip to City, but the method stayed
+func (a *TDriftedAddress) FieldZip() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Required[string]())
+}
+
+type TDrifted struct {
+	Username string
+	Age      int             `json:"age"`
+	Secret   string          `json:"-"`
+	Hidden   string          `json:"hidden" jsonschema:"-"`
+	Nick     string          `json:"Username"`
+	OnSave   func()          `json:"on_save"`
+	Address  TDriftedAddress `json:"address"`
+	internal string
+}
+
+func (d *TDrifted) FieldUserName() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Required[string]())
+}
+
+func (d *TDrifted) FieldAge() godantic.FieldOptions[int64] {
+	return godantic.Field(godantic.Min[int64](0))
+}
+
+func (d *TDrifted) FieldHidden() godantic.FieldOptions[string] {
+	return godantic.Field[string]()
+}
+
+func (d *TDrifted) FieldSecret() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.MinLen(8))
+}
+
+func (d *TDrifted) Fieldinternal() godantic.FieldOptions[string] {
+	return godantic.Field[string]()
+}
+
+func TestCheckCoverage(t *testing.T) {
+	t.Run("fully covered", func(t *testing.T) {
+		if err := godantic.CheckCoverage[TCovered](); err != nil {
+			t.Errorf("expected no coverage problems, got %v", err)
+		}
+	})
+
+	t.Run("drifted", func(t *testing.T) {
+		err := godantic.CheckCoverage[TDrifted]()
+		if err == nil {
+			t.Fatal("expected coverage problems")
+		}
+		want := []string{
+			"TDrifted.FieldAge() returns FieldOptions[int64] but field Age has type int",
+			`TDrifted.FieldHidden() validates field Hidden, which is left out of the schema by jsonschema:"-"`,
+			`TDrifted.FieldSecret() validates field Secret, which is left out of the schema by json:"-"`,
+			"TDrifted.FieldUserName() does not correspond to any field (did you mean Username?)",
+			"TDrifted.Fieldinternal() validates unexported field internal, which is not in the schema",
+			`Username and Nick share the JSON name "Username", so the schema drops at least one of them`,
+			"OnSave has type func(), which the schema can't describe",
+			"Address: TDriftedAddress.FieldZip() does not correspond to any field",
+		}
+		lines := strings.Split(err.Error(), "\n")
+		if len(lines) != len(want) {
+			t.Fatalf("expected %d problems, got:\n%v", len(want), err)
+		}
+		for i := range want {
+			if lines[i] != want[i] {
+				t.Errorf("problem %d = %q, want %q", i, lines[i], want[i])
+			}
+		}
+	})
+}
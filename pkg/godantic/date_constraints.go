@@ -0,0 +1,74 @@
+package godantic
+
+import (
+	"fmt"
+	"time"
+)
+
+// FieldAfter requires a date field to be later than the sibling field named
+// field (its JSON name), as in an end_date that must follow start_date. The
+// field may be a time.Time or a string holding an RFC 3339 date-time or a
+// YYYY-MM-DD date, and the sibling may be either form. The check is skipped
+// while the sibling is missing or not a date. The schema has no keyword for
+// it, so it is documented with an "x-after" extension.
+//
+// Example:
+//
+//	func (b *Booking) FieldEndDate() godantic.FieldOptions[string] {
+//	    return godantic.Field(godantic.Format[string]("date"), godantic.FieldAfter[string]("start_date"))
+//	}
+func FieldAfter[T any](field string) func(FieldOptions[T]) FieldOptions[T] {
+	return fieldOrder[T](field, "x-after", "after", func(val, other time.Time) bool { return val.After(other) })
+}
+
+// FieldAfterOrEqual is FieldAfter allowing both dates to be equal, as in a
+// one-day booking, documented with an "x-after-or-equal" extension.
+func FieldAfterOrEqual[T any](field string) func(FieldOptions[T]) FieldOptions[T] {
+	return fieldOrder[T](field, "x-after-or-equal", "on or after", func(val, other time.Time) bool { return !val.Before(other) })
+}
+
+// fieldOrder checks a date field against a sibling date with ordered
+func fieldOrder[T any](field, extension, relation string, ordered func(val, other time.Time) bool) func(FieldOptions[T]) FieldOptions[T] {
+	return func(fo FieldOptions[T]) FieldOptions[T] {
+		fo = Extension[T](extension, field)(fo)
+		return ValidateWithContext(func(val T, siblings map[string]any) error {
+			own, ok := dateValue(val)
+			if !ok {
+				return nil
+			}
+			other, ok := dateValue(siblings[field])
+			if !ok {
+				return nil
+			}
+			if !ordered(own, other) {
+				return fmt.Errorf("must be %s %s (%v)", relation, field, siblings[field])
+			}
+			return nil
+		})(fo)
+	}
+}
+
+// dateValue reads a time.Time, or a string holding an RFC 3339 date-time or a
+// YYYY-MM-DD date, as it appears in a field or in sibling values
+func dateValue(val any) (time.Time, bool) {
+	switch v := val.(type) {
+	case time.Time:
+		return v, !v.IsZero()
+	case *time.Time:
+		if v == nil {
+			return time.Time{}, false
+		}
+		return *v, !v.IsZero()
+	}
+	s, ok := formatString(val)
+	if !ok {
+		return time.Time{}, false
+	}
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t, true
+	}
+	if t, err := time.Parse(time.DateOnly, s); err == nil {
+		return t, true
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,102 @@
+package godantic_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════
+// Date ordering across fields
+// ═══════════════════════════════════════════════════════════════════════════
+
+type TBooking struct {
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+}
+
+func (b *TBooking) FieldEndDate() godantic.FieldOptions[string] {
+	return godantic.Field(
+		godantic.Required[string](),
+		godantic.Format[string]("date"),
+		godantic.FieldAfter[string]("start_date"),
+	)
+}
+
+type TStay struct {
+	CheckIn  time.Time `json:"check_in"`
+	CheckOut time.Time `json:"check_out"`
+}
+
+func (s *TStay) FieldCheckOut() godantic.FieldOptions[time.Time] {
+	return godantic.Field(godantic.FieldAfterOrEqual[time.Time]("check_in"))
+}
+
+func TestFieldAfter(t *testing.T) {
+	validator := godantic.NewValidator[TBooking]()
+	compiled := validator.Compile()
+
+	tests := []struct {
+		name    string
+		start   string
+		end     string
+		wantErr bool
+	}{
+		{"end after start", "2025-03-01", "2025-03-04", false},
+		{"end equal to start", "2025-03-01", "2025-03-01", true},
+		{"end before start", "2025-03-04", "2025-03-01", true},
+		{"date-time start", "2025-03-01T12:00:00Z", "2025-03-02", false},
+		{"start missing", "", "2025-03-01", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := `{"end_date": "` + tt.end + `"}`
+			if tt.start != "" {
+				body = `{"start_date": "` + tt.start + `", "end_date": "` + tt.end + `"}`
+			}
+			results := map[string]godantic.ValidationErrors{
+				"validate": validator.Validate(&TBooking{StartDate: tt.start, EndDate: tt.end}),
+			}
+			_, results["unmarshal"] = validator.Unmarshal([]byte(body))
+			_, results["compiled"] = compiled.Unmarshal([]byte(body))
+
+			for name, errs := range results {
+				if !tt.wantErr {
+					if len(errs) != 0 {
+						t.Errorf("%s: unexpected errors: %v", name, errs)
+					}
+					continue
+				}
+				if len(errs) != 1 {
+					t.Fatalf("%s: expected one error, got %v", name, errs)
+				}
+				if msg := errs.Error(); !strings.Contains(msg, "EndDate") || !strings.Contains(msg, "must be after start_date ("+tt.start+")") {
+					t.Errorf("%s: expected the error to name both fields, got %q", name, msg)
+				}
+			}
+		})
+	}
+}
+
+func TestFieldAfterOrEqual(t *testing.T) {
+	validator := godantic.NewValidator[TStay]()
+	day := time.Date(2025, 3, 1, 15, 0, 0, 0, time.UTC)
+
+	if errs := validator.Validate(&TStay{CheckIn: day, CheckOut: day.Add(24 * time.Hour)}); len(errs) != 0 {
+		t.Errorf("unexpected errors for a later check-out: %v", errs)
+	}
+	if errs := validator.Validate(&TStay{CheckIn: day, CheckOut: day}); len(errs) != 0 {
+		t.Errorf("expected an equal check-out to pass, got %v", errs)
+	}
+	errs := validator.Validate(&TStay{CheckIn: day, CheckOut: day.Add(-time.Hour)})
+	if len(errs) != 1 || !strings.Contains(errs[0].Message, "must be on or after check_in") {
+		t.Errorf("expected an ordering error, got %v", errs)
+	}
+
+	_, errs = validator.Unmarshal([]byte(`{"check_in": "2025-03-02T10:00:00Z", "check_out": "2025-03-01T10:00:00Z"}`))
+	if len(errs) != 1 || errs[0].Loc[0] != "CheckOut" {
+		t.Errorf("expected an ordering error on CheckOut, got %v", errs)
+	}
+}
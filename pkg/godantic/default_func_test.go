@@ -0,0 +1,89 @@
+package godantic_test
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════
+// DefaultFunc Tests
+// ═══════════════════════════════════════════════════════════════════════════
+
+var tAuditSeq atomic.Int64
+
+type TAuditEvent struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (e *TAuditEvent) FieldID() godantic.FieldOptions[string] {
+	return godantic.Field(
+		godantic.Required[string](),
+		godantic.DefaultFunc(func() string { return fmt.Sprintf("evt-%d", tAuditSeq.Add(1)) }),
+	)
+}
+
+func (e *TAuditEvent) FieldCreatedAt() godantic.FieldOptions[time.Time] {
+	return godantic.Field(godantic.DefaultFunc(time.Now))
+}
+
+func TestDefaultFunc(t *testing.T) {
+	validator := godantic.NewValidator[TAuditEvent]()
+	compiled := validator.Compile()
+
+	for name, unmarshal := range map[string]func([]byte) (*TAuditEvent, godantic.ValidationErrors){
+		"walker":   validator.Unmarshal,
+		"compiled": compiled.Unmarshal,
+	} {
+		t.Run(name, func(t *testing.T) {
+			before := time.Now()
+			first, errs := unmarshal([]byte(`{"name": "signup"}`))
+			if len(errs) != 0 {
+				t.Fatalf("unexpected errors: %v", errs)
+			}
+			second, errs := unmarshal([]byte(`{"name": "login"}`))
+			if len(errs) != 0 {
+				t.Fatalf("unexpected errors: %v", errs)
+			}
+
+			if first.ID == "" || first.ID == second.ID {
+				t.Errorf("expected a fresh ID per call, got %q and %q", first.ID, second.ID)
+			}
+			if first.CreatedAt.Before(before) || second.CreatedAt.Before(first.CreatedAt) {
+				t.Errorf("expected CreatedAt to be the time of each call, got %v and %v", first.CreatedAt, second.CreatedAt)
+			}
+		})
+	}
+
+	t.Run("explicit value is kept", func(t *testing.T) {
+		event, errs := validator.Unmarshal([]byte(`{"id": "evt-given", "created_at": "2024-01-02T03:04:05Z"}`))
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if event.ID != "evt-given" || event.CreatedAt.Year() != 2024 {
+			t.Errorf("expected the given values, got %+v", event)
+		}
+	})
+
+	t.Run("ApplyDefaults", func(t *testing.T) {
+		var event TAuditEvent
+		if err := validator.ApplyDefaults(&event); err != nil {
+			t.Fatal(err)
+		}
+		if event.ID == "" || event.CreatedAt.IsZero() {
+			t.Errorf("expected computed defaults, got %+v", event)
+		}
+	})
+
+	t.Run("ValidateParam", func(t *testing.T) {
+		id, errs := godantic.ValidateParam[string]("id", nil, godantic.DefaultFunc(func() string { return "generated" }))
+		if len(errs) != 0 || id != "generated" {
+			t.Errorf("expected the computed default, got %q, %v", id, errs)
+		}
+	})
+}
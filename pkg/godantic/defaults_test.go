@@ -2,6 +2,8 @@ package godantic_test
 
 import (
 	"encoding/json"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/deepankarm/godantic/pkg/godantic"
@@ -601,3 +603,345 @@ func TestDefaultsWithTypeValidation(t *testing.T) {
 		}
 	})
 }
+
+// Test StrictRequired: a default is advertised but the value must be provided
+type DeployConfig struct {
+	Region  string
+	Workers int
+}
+
+func (c *DeployConfig) FieldRegion() godantic.FieldOptions[string] {
+	return godantic.Field(
+		godantic.StrictRequired[string](),
+		godantic.Default("us-east-1"),
+	)
+}
+
+func (c *DeployConfig) FieldWorkers() godantic.FieldOptions[int] {
+	return godantic.Field(
+		godantic.Required[int](),
+		godantic.Default(4),
+	)
+}
+
+func TestStrictRequired(t *testing.T) {
+	validator := godantic.NewValidator[DeployConfig]()
+
+	t.Run("missing strict-required field is an error", func(t *testing.T) {
+		_, errs := validator.Unmarshal([]byte(`{}`))
+		if len(errs) != 1 {
+			t.Fatalf("expected 1 error, got %v", errs)
+		}
+		if errs[0].Type != godantic.ErrorTypeRequired || errs[0].Loc[0] != "Region" {
+			t.Errorf("expected required error on Region, got %v", errs[0])
+		}
+	})
+
+	t.Run("default is not applied to strict-required field", func(t *testing.T) {
+		cfg := &DeployConfig{}
+		if err := validator.ApplyDefaults(cfg); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if cfg.Region != "" {
+			t.Errorf("expected Region to stay empty, got %q", cfg.Region)
+		}
+		if cfg.Workers != 4 {
+			t.Errorf("expected Workers default 4, got %d", cfg.Workers)
+		}
+	})
+
+	t.Run("provided value passes", func(t *testing.T) {
+		cfg, errs := validator.Unmarshal([]byte(`{"Region": "eu-west-1"}`))
+		if len(errs) > 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if cfg.Region != "eu-west-1" || cfg.Workers != 4 {
+			t.Errorf("unexpected config: %+v", cfg)
+		}
+	})
+}
+
+// Tagged types for UnmarshalWithReport JSON paths
+type ReportItem struct {
+	SKU string `json:"sku"`
+	Qty int    `json:"qty"`
+}
+
+func (i *ReportItem) FieldQty() godantic.FieldOptions[int] {
+	return godantic.Field(godantic.Default(1))
+}
+
+type ReportOrder struct {
+	ID       string        `json:"id"`
+	Currency string        `json:"currency"`
+	Rush     bool          `json:"rush"`
+	Address  NestedAddress `json:"address"`
+	Items    []ReportItem  `json:"items"`
+}
+
+func (o *ReportOrder) FieldCurrency() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Default("USD"))
+}
+
+func (o *ReportOrder) FieldRush() godantic.FieldOptions[bool] {
+	return godantic.Field(godantic.Default(true))
+}
+
+func TestUnmarshalWithReport(t *testing.T) {
+	validator := godantic.NewValidator[ReportOrder]()
+
+	t.Run("provided fields are not reported", func(t *testing.T) {
+		order, report, errs := validator.UnmarshalWithReport([]byte(
+			`{"id":"o1","currency":"EUR","rush":true,"address":{"City":"Paris","Country":"FR"},"items":[{"sku":"a","qty":2}]}`,
+		))
+		if errs != nil {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if len(report.DefaultedFields) != 0 {
+			t.Errorf("expected no defaulted fields, got %v", report.DefaultedFields)
+		}
+		if order.Currency != "EUR" {
+			t.Errorf("expected Currency=EUR, got %s", order.Currency)
+		}
+	})
+
+	t.Run("absent fields are reported with JSON paths", func(t *testing.T) {
+		order, report, errs := validator.UnmarshalWithReport([]byte(
+			`{"id":"o1","address":{"City":"Paris"},"items":[{"sku":"a","qty":2},{"sku":"b"}]}`,
+		))
+		if errs != nil {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		want := []string{"currency", "rush", "address.Country", "items[1].qty"}
+		if !slices.Equal(report.DefaultedFields, want) {
+			t.Errorf("expected %v, got %v", want, report.DefaultedFields)
+		}
+		if order.Currency != "USD" || order.Items[1].Qty != 1 {
+			t.Errorf("defaults not applied: %+v", order)
+		}
+	})
+
+	t.Run("explicit zero is not reported", func(t *testing.T) {
+		// The default still replaces the zero value (see TestApplyDefaults),
+		// but the field was provided, so it is not listed
+		order, report, errs := validator.UnmarshalWithReport([]byte(
+			`{"id":"o1","currency":"","rush":false,"address":{"City":"Paris","Country":"FR"}}`,
+		))
+		if errs != nil {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if len(report.DefaultedFields) != 0 {
+			t.Errorf("expected no defaulted fields, got %v", report.DefaultedFields)
+		}
+		if !order.Rush {
+			t.Error("expected Rush default to replace explicit false")
+		}
+	})
+
+	t.Run("Unmarshal behaves the same", func(t *testing.T) {
+		order, errs := validator.Unmarshal([]byte(`{"id":"o1"}`))
+		if errs != nil || order.Currency != "USD" {
+			t.Errorf("expected defaults without report, got %+v, %v", order, errs)
+		}
+	})
+}
+
+// Types for defaults inside slice and map elements
+type TMember struct {
+	Name  string `json:"name"`
+	Level string `json:"level"`
+}
+
+func (m *TMember) FieldName() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Required[string]())
+}
+
+func (m *TMember) FieldLevel() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Default("junior"))
+}
+
+type TTeam struct {
+	Members  []TMember           `json:"members"`
+	ByRegion map[string]TMember  `json:"by_region"`
+	Leads    map[string]*TMember `json:"leads"`
+}
+
+func TestDeepDefaults(t *testing.T) {
+	validator := godantic.NewValidator[TTeam]()
+
+	t.Run("Unmarshal fills slice and map elements", func(t *testing.T) {
+		team, report, errs := validator.UnmarshalWithReport([]byte(`{
+			"members": [{"name": "a"}, {"name": "b", "level": "senior"}],
+			"by_region": {"eu": {"name": "c"}, "us": {"name": "d", "level": "senior"}},
+			"leads": {"eu": {"name": "e"}}
+		}`))
+		if errs != nil {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if team.Members[0].Level != "junior" || team.Members[1].Level != "senior" {
+			t.Errorf("unexpected slice levels: %+v", team.Members)
+		}
+		if team.ByRegion["eu"].Level != "junior" || team.ByRegion["us"].Level != "senior" {
+			t.Errorf("unexpected map levels: %+v", team.ByRegion)
+		}
+		if team.Leads["eu"].Level != "junior" {
+			t.Errorf("unexpected pointer map level: %+v", team.Leads["eu"])
+		}
+		want := []string{"members[0].level", "by_region.eu.level", "leads.eu.level"}
+		if !slices.Equal(report.DefaultedFields, want) {
+			t.Errorf("expected %v, got %v", want, report.DefaultedFields)
+		}
+	})
+
+	t.Run("ApplyDefaults fills slice and map elements", func(t *testing.T) {
+		team := TTeam{
+			Members:  []TMember{{Name: "a"}},
+			ByRegion: map[string]TMember{"eu": {Name: "b"}},
+			Leads:    map[string]*TMember{"eu": {Name: "c"}, "us": nil},
+		}
+		if err := validator.ApplyDefaults(&team); err != nil {
+			t.Fatalf("ApplyDefaults failed: %v", err)
+		}
+		if team.Members[0].Level != "junior" || team.ByRegion["eu"].Level != "junior" || team.Leads["eu"].Level != "junior" {
+			t.Errorf("defaults not applied: %+v", team)
+		}
+		if team.ByRegion["eu"].Name != "b" {
+			t.Errorf("map element fields should be preserved, got %+v", team.ByRegion["eu"])
+		}
+	})
+
+	t.Run("map elements are validated", func(t *testing.T) {
+		errs := validator.Validate(&TTeam{ByRegion: map[string]TMember{"us": {}, "eu": {Name: "ok"}}})
+		if len(errs) != 1 {
+			t.Fatalf("expected 1 error, got %v", errs)
+		}
+		if loc := strings.Join(errs[0].Loc, "."); loc != "ByRegion.us.Name" {
+			t.Errorf("expected ByRegion.us.Name, got %s", loc)
+		}
+	})
+}
+
+// TProfileResponse mixes omitempty tags, OmitEmpty options and defaults
+type TProfileResponse struct {
+	ID       string            `json:"id"`
+	Nickname string            `json:"nickname"`
+	Bio      string            `json:"bio,omitempty"`
+	Theme    string            `json:"theme"`
+	Locale   string            `json:"locale,omitempty"`
+	Links    []TProfileLink    `json:"links"`
+	Extra    map[string]string `json:"extra"`
+}
+
+type TProfileLink struct {
+	URL   string `json:"url"`
+	Label string `json:"label"`
+}
+
+func (p *TProfileResponse) FieldNickname() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.OmitEmpty[string]())
+}
+
+func (p *TProfileResponse) FieldTheme() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.OmitEmpty[string](), godantic.Default("light"))
+}
+
+func (p *TProfileResponse) FieldLocale() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Default("en"))
+}
+
+func (p *TProfileResponse) FieldExtra() godantic.FieldOptions[map[string]string] {
+	return godantic.Field(godantic.OmitEmpty[map[string]string]())
+}
+
+func (l *TProfileLink) FieldLabel() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.OmitEmpty[string]())
+}
+
+func TestMarshal_OmitEmpty(t *testing.T) {
+	validator := godantic.NewValidator[TProfileResponse]()
+
+	tests := []struct {
+		name    string
+		profile TProfileResponse
+		want    string
+	}{
+		{
+			// Theme and Locale are zero but get defaults, so both are kept;
+			// Bio (tag) and Nickname/Extra (option) are dropped
+			name:    "zero fields",
+			profile: TProfileResponse{ID: "u1"},
+			want:    `{"id":"u1","theme":"light","locale":"en","links":null}`,
+		},
+		{
+			name:    "set fields are kept",
+			profile: TProfileResponse{ID: "u1", Nickname: "ann", Bio: "hi", Extra: map[string]string{"k": "v"}},
+			want:    `{"id":"u1","nickname":"ann","bio":"hi","theme":"light","locale":"en","links":null,"extra":{"k":"v"}}`,
+		},
+		{
+			name:    "nested slice elements",
+			profile: TProfileResponse{ID: "u1", Links: []TProfileLink{{URL: "a"}, {URL: "b", Label: "B"}}},
+			want:    `{"id":"u1","theme":"light","locale":"en","links":[{"url":"a"},{"url":"b","label":"B"}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, errs := validator.Marshal(&tt.profile)
+			if len(errs) > 0 {
+				t.Fatalf("unexpected errors: %v", errs)
+			}
+			if string(data) != tt.want {
+				t.Errorf("got  %s\nwant %s", data, tt.want)
+			}
+		})
+	}
+
+	t.Run("plain json.Marshal ignores the option", func(t *testing.T) {
+		data, _ := json.Marshal(TProfileResponse{ID: "u1"})
+		if !strings.Contains(string(data), `"nickname":""`) {
+			t.Errorf("expected nickname in json.Marshal output, got %s", data)
+		}
+	})
+}
+
+func TestWithoutDefaults(t *testing.T) {
+	base := godantic.NewValidator[ServerSettings]()
+	validator := base.With(godantic.WithoutDefaults())
+
+	t.Run("defaults are not applied", func(t *testing.T) {
+		settings, errs := validator.Unmarshal([]byte(`{"Name":"api","Type":"default","Port":80,"Enabled":true,"Tags":["a"]}`))
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if settings.MaxRetries != 0 {
+			t.Errorf("expected MaxRetries to stay 0, got %d", settings.MaxRetries)
+		}
+	})
+
+	t.Run("missing required fields with defaults error", func(t *testing.T) {
+		_, errs := validator.Unmarshal([]byte(`{"Name":"api"}`))
+		var locs []string
+		for _, err := range errs {
+			if err.Type != godantic.ErrorTypeRequired {
+				t.Errorf("expected a required error, got %v", err)
+			}
+			locs = append(locs, err.Loc[0])
+		}
+		if want := []string{"Type", "Port", "Enabled", "Tags"}; !slices.Equal(locs, want) {
+			t.Errorf("expected required errors at %v, got %v", want, locs)
+		}
+	})
+
+	t.Run("base validator still applies defaults", func(t *testing.T) {
+		settings, errs := base.Unmarshal([]byte(`{"Name":"api"}`))
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if settings.Port != 8080 || settings.MaxRetries != 3 {
+			t.Errorf("expected defaults, got %+v", settings)
+		}
+	})
+
+	t.Run("compiled", func(t *testing.T) {
+		assertCompiledUnmarshalWith(t, validator, `{"Name":"api"}`, `{"Name":"api","Type":"default","Port":80,"Enabled":true,"Tags":[]}`)
+	})
+}
@@ -0,0 +1,139 @@
+package godantic
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/deepankarm/godantic/pkg/internal/reflectutil"
+)
+
+// DependentRule makes fields required when another field of the same struct
+// has a given value. Rules are returned by the struct's Dependencies method.
+// Field names are JSON names, or names under the WithTagName tag.
+type DependentRule struct {
+	WhenField     string   // Field whose value is tested
+	Equals        any      // Value of WhenField that triggers the rule
+	RequireFields []string // Fields required when the rule applies
+}
+
+// DependentRequired requires requireFields when whenField equals equals, for
+// conditional fields on a single struct without a discriminated union. The
+// rule is enforced by Validate and Unmarshal, and generated schemas express it
+// with if/then. Values compare by their JSON encoding, and a zero or missing
+// required field counts as not provided, as with Required.
+//
+// Return rules from a Dependencies method on the struct:
+//
+//	func (p *Plan) Dependencies() []godantic.DependentRule {
+//	    return []godantic.DependentRule{
+//	        godantic.DependentRequired("type", "premium", "seats"),
+//	    }
+//	}
+func DependentRequired(whenField string, equals any, requireFields ...string) DependentRule {
+	return DependentRule{WhenField: whenField, Equals: equals, RequireFields: requireFields}
+}
+
+// dependentRuler is implemented by structs declaring DependentRules
+type dependentRuler interface {
+	Dependencies() []DependentRule
+}
+
+// ScanTypeDependentRules returns the rules declared by t's Dependencies
+// method, if any. It is the struct-level counterpart of ScanTypeFieldOptions.
+func ScanTypeDependentRules(t reflect.Type) []DependentRule {
+	t = reflectutil.UnwrapPointer(t)
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	if ruler, ok := reflect.New(t).Interface().(dependentRuler); ok {
+		return ruler.Dependencies()
+	}
+	return nil
+}
+
+// applies reports whether the rule's condition holds for the raw values of
+// the object holding its fields, where its WhenField is named key
+func (r DependentRule) applies(siblings map[string]any, key string) bool {
+	value, ok := siblings[key]
+	if !ok {
+		return false
+	}
+	got, err1 := json.Marshal(value)
+	want, err2 := json.Marshal(r.Equals)
+	return err1 == nil && err2 == nil && string(got) == string(want)
+}
+
+// ruleFields resolves the field names of rules on t, under tag ("" = json),
+// to their fields. A name matches a field's name under tag first, then its
+// JSON name, as in generated schemas.
+func ruleFields(t reflect.Type, tag string) map[string]reflectutil.TaggedField {
+	if tag == "" {
+		tag = "json"
+	}
+	fields := make(map[string]reflectutil.TaggedField)
+	tagged := reflectutil.TaggedFields(t, tag)
+	for _, field := range tagged {
+		fields[field.JSONName] = field
+	}
+	for _, field := range tagged {
+		if field.TagName != "-" {
+			fields[field.TagName] = field
+		}
+	}
+	return fields
+}
+
+// requiredWhen returns the conditional required checks the rules of t put on
+// each of its fields, keyed by Go field name. The checks read siblings named
+// by tag ("" = json), as the walker passes them.
+func requiredWhen(t reflect.Type, tag string) map[string][]func(map[string]any) error {
+	rules := ScanTypeDependentRules(t)
+	if len(rules) == 0 {
+		return nil
+	}
+	fields := ruleFields(t, tag)
+
+	checks := make(map[string][]func(map[string]any) error)
+	for _, rule := range rules {
+		when, ok := fields[rule.WhenField]
+		if !ok {
+			continue // Reported by dependentRuleErrors
+		}
+		equals, _ := json.Marshal(rule.Equals)
+		for _, name := range rule.RequireFields {
+			field, ok := fields[name]
+			if !ok {
+				continue // Reported by dependentRuleErrors
+			}
+			goName := field.Field.Name
+			checks[goName] = append(checks[goName], func(siblings map[string]any) error {
+				if rule.applies(siblings, when.TagName) {
+					return fmt.Errorf("required field when %s is %s", rule.WhenField, equals)
+				}
+				return nil
+			})
+		}
+	}
+	return checks
+}
+
+// dependentRuleErrors reports rules of t naming fields t does not have under
+// tag ("" = json)
+func dependentRuleErrors(t reflect.Type, tag string) []error {
+	rules := ScanTypeDependentRules(t)
+	if len(rules) == 0 {
+		return nil
+	}
+	fields := ruleFields(t, tag)
+
+	var errs []error
+	for _, rule := range rules {
+		for _, name := range append([]string{rule.WhenField}, rule.RequireFields...) {
+			if _, ok := fields[name]; !ok {
+				errs = append(errs, fmt.Errorf("dependent rule on %q: no field named %q", rule.WhenField, name))
+			}
+		}
+	}
+	return errs
+}
@@ -0,0 +1,178 @@
+package godantic_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════
+// DependentRequired Tests
+// ═══════════════════════════════════════════════════════════════════════════
+
+type TSubscription struct {
+	Type    string `json:"type"`
+	Seats   int    `json:"seats"`
+	Billing string `json:"billing"`
+}
+
+func (s *TSubscription) FieldType() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Required[string](), godantic.OneOf("free", "premium"))
+}
+
+func (s *TSubscription) Dependencies() []godantic.DependentRule {
+	return []godantic.DependentRule{
+		godantic.DependentRequired("type", "premium", "seats", "billing"),
+	}
+}
+
+type TAccount struct {
+	Owner        string          `json:"owner"`
+	Subscription TSubscription   `json:"subscription"`
+	History      []TSubscription `json:"history"`
+}
+
+func TestDependentRequired(t *testing.T) {
+	validator := godantic.NewValidator[TSubscription]()
+	if err := validator.Err(); err != nil {
+		t.Fatalf("unexpected construction error: %v", err)
+	}
+	compiled := validator.Compile()
+
+	tests := []struct {
+		name    string
+		json    string
+		sub     TSubscription
+		wantLoc []string // fields reported as required, nil means valid
+	}{
+		{"condition met, fields provided", `{"type": "premium", "seats": 5, "billing": "card"}`, TSubscription{Type: "premium", Seats: 5, Billing: "card"}, nil},
+		{"condition met, fields missing", `{"type": "premium", "seats": 5}`, TSubscription{Type: "premium", Seats: 5}, []string{"Billing"}},
+		{"condition met, all missing", `{"type": "premium"}`, TSubscription{Type: "premium"}, []string{"Seats", "Billing"}},
+		{"condition not met", `{"type": "free"}`, TSubscription{Type: "free"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := func(mode string, errs godantic.ValidationErrors) {
+				var got []string
+				for _, e := range errs {
+					if e.Type != godantic.ErrorTypeRequired {
+						t.Errorf("%s: unexpected error %v", mode, e)
+					}
+					got = append(got, e.Loc[0])
+				}
+				if !reflect.DeepEqual(got, tt.wantLoc) {
+					t.Errorf("%s: required errors at %v, want %v (%v)", mode, got, tt.wantLoc, errs)
+				}
+			}
+			_, errs := validator.Unmarshal([]byte(tt.json))
+			check("unmarshal", errs)
+			_, errs = compiled.Unmarshal([]byte(tt.json))
+			check("compiled unmarshal", errs)
+			check("validate", validator.Validate(&tt.sub))
+		})
+	}
+
+	t.Run("message names the condition", func(t *testing.T) {
+		errs := validator.Validate(&TSubscription{Type: "premium", Seats: 1})
+		if len(errs) != 1 || errs[0].Message != `required field when type is "premium"` {
+			t.Errorf("expected a conditional required message, got %v", errs)
+		}
+	})
+
+	t.Run("nested structs use their own object", func(t *testing.T) {
+		accounts := godantic.NewValidator[TAccount]()
+		_, errs := accounts.Unmarshal([]byte(`{
+			"owner": "ann",
+			"subscription": {"type": "premium", "seats": 2},
+			"history": [{"type": "free"}, {"type": "premium", "billing": "card"}]
+		}`))
+		var got []string
+		for _, e := range errs {
+			got = append(got, strings.Join(e.Loc, "."))
+		}
+		if want := []string{"Subscription.Billing", "History.[1].Seats"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("got errors at %v, want %v", got, want)
+		}
+	})
+}
+
+type TBadDependency struct {
+	Type string `json:"type"`
+}
+
+func (b *TBadDependency) Dependencies() []godantic.DependentRule {
+	return []godantic.DependentRule{godantic.DependentRequired("type", "x", "missing")}
+}
+
+func TestDependentRequired_UnknownField(t *testing.T) {
+	err := godantic.NewValidator[TBadDependency]().Err()
+	if err == nil || !strings.Contains(err.Error(), `no field named "missing"`) {
+		t.Errorf("expected an unknown field error, got %v", err)
+	}
+}
+
+type TTaggedPlan struct {
+	Kind  string `api:"kind" json:"type"`
+	Seats int    `api:"n" json:"seats"`
+}
+
+func (p *TTaggedPlan) Dependencies() []godantic.DependentRule {
+	return []godantic.DependentRule{godantic.DependentRequired("kind", "premium", "n")}
+}
+
+type TTaggedPlanByJSON struct {
+	Kind  string `api:"kind" json:"type"`
+	Seats int    `api:"n" json:"seats"`
+}
+
+func (p *TTaggedPlanByJSON) Dependencies() []godantic.DependentRule {
+	return []godantic.DependentRule{godantic.DependentRequired("type", "premium", "seats")}
+}
+
+func TestDependentRequired_WithTagName(t *testing.T) {
+	check := func(t *testing.T, mode string, errs godantic.ValidationErrors, wantErr bool) {
+		t.Helper()
+		if !wantErr {
+			if len(errs) != 0 {
+				t.Errorf("%s: unexpected errors: %v", mode, errs)
+			}
+			return
+		}
+		if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeRequired || errs[0].Loc[0] != "n" {
+			t.Errorf("%s: expected a required error at n, got %v", mode, errs)
+		}
+	}
+
+	t.Run("rule names fields by tag", func(t *testing.T) {
+		validator := godantic.NewValidator[TTaggedPlan](godantic.WithTagName("api"))
+		if err := validator.Err(); err != nil {
+			t.Fatalf("unexpected construction error: %v", err)
+		}
+		_, errs := validator.Unmarshal([]byte(`{"kind": "premium"}`))
+		check(t, "unmarshal", errs, true)
+		_, errs = validator.Unmarshal([]byte(`{"kind": "premium", "n": 2}`))
+		check(t, "unmarshal", errs, false)
+		check(t, "validate", validator.Validate(&TTaggedPlan{Kind: "premium"}), true)
+		check(t, "validate", validator.Validate(&TTaggedPlan{Kind: "free"}), false)
+	})
+
+	t.Run("rule names fields by JSON name", func(t *testing.T) {
+		validator := godantic.NewValidator[TTaggedPlanByJSON](godantic.WithTagName("api"))
+		if err := validator.Err(); err != nil {
+			t.Fatalf("unexpected construction error: %v", err)
+		}
+		_, errs := validator.Unmarshal([]byte(`{"kind": "premium"}`))
+		check(t, "unmarshal", errs, true)
+		check(t, "validate", validator.Validate(&TTaggedPlanByJSON{Kind: "premium"}), true)
+	})
+
+	t.Run("json validator keeps json names", func(t *testing.T) {
+		err := godantic.NewValidator[TTaggedPlan]().Err()
+		if err == nil || !strings.Contains(err.Error(), `no field named "kind"`) {
+			t.Errorf("expected tag names to be unknown without WithTagName, got %v", err)
+		}
+	})
+}
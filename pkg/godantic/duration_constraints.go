@@ -0,0 +1,37 @@
+package godantic
+
+import (
+	"fmt"
+	"time"
+)
+
+// DurationMin sets a lower bound for a time.Duration field. Durations are
+// decoded from strings such as "30s" or "1h30m".
+func DurationMin(min time.Duration) func(FieldOptions[time.Duration]) FieldOptions[time.Duration] {
+	return func(fo FieldOptions[time.Duration]) FieldOptions[time.Duration] {
+		fo = ensureConstraints(fo)
+		fo.Constraints_[ConstraintMinDuration] = min
+
+		return fo.validateWith(func(d time.Duration) error {
+			if d < min {
+				return fmt.Errorf("duration must be >= %v", min)
+			}
+			return nil
+		})
+	}
+}
+
+// DurationMax sets an upper bound for a time.Duration field.
+func DurationMax(max time.Duration) func(FieldOptions[time.Duration]) FieldOptions[time.Duration] {
+	return func(fo FieldOptions[time.Duration]) FieldOptions[time.Duration] {
+		fo = ensureConstraints(fo)
+		fo.Constraints_[ConstraintMaxDuration] = max
+
+		return fo.validateWith(func(d time.Duration) error {
+			if d > max {
+				return fmt.Errorf("duration must be <= %v", max)
+			}
+			return nil
+		})
+	}
+}
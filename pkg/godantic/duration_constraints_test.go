@@ -0,0 +1,171 @@
+package godantic_test
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════
+// time.Duration fields
+// ═══════════════════════════════════════════════════════════════════════════
+
+type TTimeouts struct {
+	Read  time.Duration  `json:"read"`
+	Write *time.Duration `json:"write"`
+}
+
+func (t *TTimeouts) FieldRead() godantic.FieldOptions[time.Duration] {
+	return godantic.Field(
+		godantic.Required[time.Duration](),
+		godantic.DurationMin(time.Second),
+		godantic.DurationMax(time.Hour),
+	)
+}
+
+func TestDurationFields(t *testing.T) {
+	validator := godantic.NewValidator[TTimeouts]()
+
+	t.Run("parses duration strings", func(t *testing.T) {
+		got, errs := validator.Unmarshal([]byte(`{"read": "1h", "write": "1m30s"}`))
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if got.Read != time.Hour || got.Write == nil || *got.Write != 90*time.Second {
+			t.Errorf("got read=%v write=%v", got.Read, got.Write)
+		}
+	})
+
+	t.Run("numbers are nanoseconds", func(t *testing.T) {
+		got, errs := validator.Unmarshal([]byte(`{"read": 2000000000}`))
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if got.Read != 2*time.Second {
+			t.Errorf("got %v, want 2s", got.Read)
+		}
+	})
+
+	t.Run("rejects a missing unit", func(t *testing.T) {
+		got, errs := validator.Unmarshal([]byte(`{"read": "30"}`))
+		if got != nil || len(errs) == 0 || errs[0].Type != godantic.ErrorTypeJSONDecode {
+			t.Fatalf("expected a decode error, got %v, %v", got, errs)
+		}
+		if !strings.Contains(errs[0].Message, `"30"`) || errs[0].Loc[0] != "Read" {
+			t.Errorf("expected the error to name the value and field, got %v", errs[0])
+		}
+	})
+
+	t.Run("bare seconds", func(t *testing.T) {
+		seconds := validator.With(godantic.WithDurationSeconds())
+		got, errs := seconds.Unmarshal([]byte(`{"read": "30", "write": 1.5}`))
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if got.Read != 30*time.Second || *got.Write != 1500*time.Millisecond {
+			t.Errorf("got read=%v write=%v", got.Read, *got.Write)
+		}
+	})
+
+	t.Run("min and max", func(t *testing.T) {
+		for input, want := range map[string]string{
+			`{"read": "500ms"}`: "duration must be >= 1s",
+			`{"read": "2h"}`:    "duration must be <= 1h0m0s",
+		} {
+			_, errs := validator.Unmarshal([]byte(input))
+			if len(errs) != 1 || errs[0].Message != want {
+				t.Errorf("%s: expected %q, got %v", input, want, errs)
+			}
+		}
+	})
+
+	t.Run("query params", func(t *testing.T) {
+		got, errs := validator.ValidateFromMultiValueMap(map[string][]string{"read": {"1m30s"}})
+		if len(errs) != 0 || got.Read != 90*time.Second {
+			t.Fatalf("got %v, %v", got, errs)
+		}
+		_, errs = validator.ValidateFromMultiValueMap(map[string][]string{"read": {"90"}})
+		if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeCoercion {
+			t.Errorf("expected a coercion error, got %v", errs)
+		}
+		got, errs = validator.With(godantic.WithDurationSeconds()).ValidateFromMultiValueMap(map[string][]string{"read": {"90"}})
+		if len(errs) != 0 || got.Read != 90*time.Second {
+			t.Errorf("expected 90s, got %v, %v", got, errs)
+		}
+	})
+
+	t.Run("compiled", func(t *testing.T) {
+		assertCompiledUnmarshal[TTimeouts](t, `{"read": "1h"}`, `{"read": "30"}`, `{"read": "2h"}`)
+		assertCompiledUnmarshalWith(t, validator.With(godantic.WithDurationSeconds()), `{"read": 30}`)
+	})
+}
+
+type TRetryPolicy struct {
+	Backoff  []time.Duration          `json:"backoff"`
+	Timeouts map[string]time.Duration `json:"timeouts"`
+	Grace    *time.Duration           `json:"grace,omitempty"`
+}
+
+func TestDurationCollections(t *testing.T) {
+	validator := godantic.NewValidator[TRetryPolicy]()
+
+	t.Run("parses slice and map elements", func(t *testing.T) {
+		got, errs := validator.Unmarshal([]byte(`{"backoff": ["100ms", "1s"], "timeouts": {"read": "5s", "write": 2000000000}}`))
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if !slices.Equal(got.Backoff, []time.Duration{100 * time.Millisecond, time.Second}) {
+			t.Errorf("got backoff %v", got.Backoff)
+		}
+		if got.Timeouts["read"] != 5*time.Second || got.Timeouts["write"] != 2*time.Second {
+			t.Errorf("got timeouts %v", got.Timeouts)
+		}
+	})
+
+	t.Run("reports the invalid element", func(t *testing.T) {
+		_, errs := validator.Unmarshal([]byte(`{"backoff": ["1s", "soon"], "timeouts": {"read": "30"}}`))
+		if len(errs) != 2 {
+			t.Fatalf("expected 2 errors, got %v", errs)
+		}
+		if errs[0].Path() != "backoff[1]" || errs[1].Path() != "timeouts.read" {
+			t.Errorf("expected the element paths, got %q and %q", errs[0].Path(), errs[1].Path())
+		}
+	})
+
+	t.Run("marshals duration strings", func(t *testing.T) {
+		grace := 90 * time.Second
+		policy := TRetryPolicy{
+			Backoff:  []time.Duration{time.Second, 2 * time.Second},
+			Timeouts: map[string]time.Duration{"read": 5 * time.Second},
+			Grace:    &grace,
+		}
+		data, errs := validator.Marshal(&policy)
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		want := `{"backoff":["1s","2s"],"timeouts":{"read":"5s"},"grace":"1m30s"}`
+		if string(data) != want {
+			t.Errorf("got %s, want %s", data, want)
+		}
+
+		back, errs := validator.Unmarshal(data)
+		if len(errs) != 0 || !reflect.DeepEqual(*back, policy) {
+			t.Errorf("expected the marshaled policy back, got %+v, %v", back, errs)
+		}
+	})
+
+	t.Run("compiled", func(t *testing.T) {
+		assertCompiledUnmarshal[TRetryPolicy](t, `{"backoff": ["1s"], "timeouts": {"read": "5s"}}`, `{"backoff": ["soon"]}`)
+	})
+}
+
+func TestValidateParam_Duration(t *testing.T) {
+	got, errs := godantic.ValidateParam("timeout", []string{"45s"}, godantic.DurationMax(time.Minute))
+	if len(errs) != 0 || got != 45*time.Second {
+		t.Errorf("got %v, %v", got, errs)
+	}
+}
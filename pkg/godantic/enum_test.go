@@ -1,6 +1,7 @@
 package godantic_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/deepankarm/godantic/pkg/godantic"
@@ -158,3 +159,111 @@ func TestTypeValidationOverride(t *testing.T) {
 		}
 	})
 }
+
+type TPriority string
+
+const (
+	TPriorityLow    TPriority = "low"
+	TPriorityMedium TPriority = "medium"
+	TPriorityHigh   TPriority = "high"
+)
+
+type TTicket struct {
+	Title    string     `json:"title"`
+	Priority TPriority  `json:"priority"`
+	Backup   *TPriority `json:"backup"`
+}
+
+func (t *TTicket) FieldPriority() godantic.FieldOptions[TPriority] {
+	return godantic.Field(
+		godantic.Required[TPriority](),
+		godantic.OneOf(TPriorityLow, TPriorityMedium, TPriorityHigh),
+		godantic.EnumFromInt(map[int]TPriority{0: TPriorityLow, 1: TPriorityMedium, 2: TPriorityHigh, 9: "urgent"}),
+	)
+}
+
+func (t *TTicket) FieldBackup() godantic.FieldOptions[TPriority] {
+	return godantic.Field(
+		godantic.EnumFromInt(map[int]TPriority{0: TPriorityLow, 1: TPriorityMedium, 2: TPriorityHigh}),
+	)
+}
+
+func TestEnumFromInt(t *testing.T) {
+	validator := godantic.NewValidator[TTicket]()
+	compiled := validator.Compile()
+
+	tests := []struct {
+		name     string
+		data     string
+		want     TPriority
+		wantType godantic.ErrorType // empty means valid
+		wantMsg  string
+	}{
+		{"integer index", `{"title": "a", "priority": 0}`, TPriorityLow, "", ""},
+		{"string value", `{"title": "a", "priority": "high"}`, TPriorityHigh, "", ""},
+		{"out of range index", `{"title": "a", "priority": 5}`, "", godantic.ErrorTypeCoercion, "enum index 5 is out of range, expected one of [0 1 2 9]"},
+		{"indexed value checked by OneOf", `{"title": "a", "priority": 9}`, "", godantic.ErrorTypeConstraint, "value must be one of [low medium high]"},
+		{"non-integer number", `{"title": "a", "priority": 1.5}`, "", godantic.ErrorTypeJSONDecode, ""},
+	}
+
+	for _, tt := range tests {
+		for name, unmarshal := range map[string]func([]byte) (*TTicket, godantic.ValidationErrors){
+			"walker":   validator.Unmarshal,
+			"compiled": compiled.Unmarshal,
+		} {
+			t.Run(tt.name+"/"+name, func(t *testing.T) {
+				ticket, errs := unmarshal([]byte(tt.data))
+				if tt.wantType == "" {
+					if len(errs) != 0 {
+						t.Fatalf("unexpected errors: %v", errs)
+					}
+					if ticket.Priority != tt.want {
+						t.Errorf("got priority %q, want %q", ticket.Priority, tt.want)
+					}
+					return
+				}
+				// A field that fails to decode is also reported as missing
+				if len(errs) == 0 || errs[0].Type != tt.wantType || errs[0].Loc[0] != "Priority" {
+					t.Fatalf("expected a %s error at Priority, got %v", tt.wantType, errs)
+				}
+				if tt.wantMsg != "" && errs[0].Message != tt.wantMsg {
+					t.Errorf("got message %q, want %q", errs[0].Message, tt.wantMsg)
+				}
+			})
+		}
+	}
+
+	t.Run("pointer field", func(t *testing.T) {
+		ticket, errs := validator.Unmarshal([]byte(`{"title": "a", "priority": 1, "backup": 2}`))
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if ticket.Priority != TPriorityMedium || ticket.Backup == nil || *ticket.Backup != TPriorityHigh {
+			t.Errorf("expected medium with high backup, got %+v", ticket)
+		}
+	})
+}
+
+func TestOneOfSuggestion(t *testing.T) {
+	validator := godantic.NewValidator[TTicket]()
+
+	tests := []struct {
+		input   string
+		wantMsg string
+	}{
+		{"hgih", `value must be one of [low medium high]; did you mean "high"?`},
+		{"meduim", `value must be one of [low medium high]; did you mean "medium"?`},
+		{"LOW", `value must be one of [low medium high]; did you mean "low"?`},
+		{"critical", "value must be one of [low medium high]"},
+		{"lo-fi", "value must be one of [low medium high]"},
+		{strings.Repeat("high", 1000), "value must be one of [low medium high]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input[:min(len(tt.input), 16)], func(t *testing.T) {
+			errs := validator.Validate(&TTicket{Title: "a", Priority: TPriority(tt.input)})
+			if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeConstraint || errs[0].Message != tt.wantMsg {
+				t.Errorf("got %v, want %q", errs, tt.wantMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package godantic
+
+import (
+	"reflect"
+
+	"github.com/deepankarm/godantic/pkg/internal/errors"
+)
+
+// maxInputLen caps the runes of a string reported as the Input of an error
+const maxInputLen = 64
+
+// withInput attaches val to err as the Input of the constraint error. Only
+// booleans, numbers and strings are reported; strings longer than maxInputLen
+// runes are truncated with "...".
+func withInput(err error, val any) error {
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.String:
+		if s := []rune(rv.String()); len(s) > maxInputLen {
+			return errors.InputError{Err: err, Input: string(s[:maxInputLen]) + "..."}
+		}
+		return errors.InputError{Err: err, Input: val}
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return errors.InputError{Err: err, Input: val}
+	}
+	return err
+}
+
+// withoutInput removes the values attached by withInput, including those of
+// the members of a MultiError.
+func withoutInput(err error) error {
+	switch e := err.(type) {
+	case errors.InputError:
+		return e.Err
+	case errors.MultiError:
+		members := make(errors.MultiError, len(e))
+		for i, member := range e {
+			members[i] = withoutInput(member)
+		}
+		return members
+	}
+	return err
+}
+
+// hideInputs wraps validators so their errors don't report the value, for
+// write-only fields such as passwords.
+func hideInputs(validators []func(any) error) []func(any) error {
+	hidden := make([]func(any) error, len(validators))
+	for i, validate := range validators {
+		hidden[i] = func(val any) error {
+			if err := validate(val); err != nil {
+				return withoutInput(err)
+			}
+			return nil
+		}
+	}
+	return hidden
+}
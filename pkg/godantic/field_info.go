@@ -0,0 +1,60 @@
+package godantic
+
+import (
+	"maps"
+	"reflect"
+
+	"github.com/deepankarm/godantic/pkg/internal/reflectutil"
+)
+
+// FieldInfo describes a field of a validated struct as the validator sees it
+type FieldInfo struct {
+	Name        string         // Go field name, as used in error Locs
+	JSONName    string         // Name on the wire, under the WithTagName tag if set
+	Type        reflect.Type   // Go type of the field
+	Required    bool           // Whether the field must be provided
+	Default     any            // Value set by Default, nil if none
+	Constraints map[string]any // Constraint keys such as ConstraintPattern, see constraint_keys.go
+}
+
+// Fields lists the fields of T in declaration order with their field
+// options, for building forms or documentation without parsing the schema.
+// Fields of embedded structs are listed in place of the struct, as they
+// appear in JSON; fields left out of JSON are skipped. Fields without options
+// have an empty Constraints map. Returns nil for WithDiscriminator unions.
+//
+// Example:
+//
+//	for _, f := range godantic.NewValidator[User]().Fields() {
+//	    fmt.Println(f.JSONName, f.Required, f.Constraints[godantic.ConstraintPattern])
+//	}
+func (v *Validator[T]) Fields() []FieldInfo {
+	typ := reflectutil.UnwrapPointer(reflect.TypeFor[T]())
+	if v.config.discriminator != nil || typ.Kind() != reflect.Struct {
+		return nil
+	}
+	tag := v.config.tagName
+	if tag == "" {
+		tag = "json"
+	}
+
+	var fields []FieldInfo
+	for _, tf := range reflectutil.TaggedFields(typ, tag) {
+		info := FieldInfo{
+			Name:        tf.Field.Name,
+			JSONName:    tf.TagName,
+			Type:        tf.Field.Type,
+			Constraints: map[string]any{},
+		}
+		if holder, ok := v.fieldOptions[tf.Field.Name]; ok {
+			info.Required = holder.required
+			info.Default = holder.constraints[ConstraintDefault]
+			info.Constraints = maps.Clone(holder.constraints)
+			if info.Constraints == nil {
+				info.Constraints = map[string]any{}
+			}
+		}
+		fields = append(fields, info)
+	}
+	return fields
+}
@@ -0,0 +1,62 @@
+package godantic
+
+import (
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"slices"
+	"strings"
+
+	"github.com/deepankarm/godantic/pkg/internal/errors"
+)
+
+// MaxFileSize limits the size of an uploaded file to bytes. It applies to
+// *multipart.FileHeader fields, such as those bound from a multipart form, and
+// checks the part's recorded size without reading its content. A larger file
+// is reported as ErrorTypeFileTooLarge, for a 413 response.
+func MaxFileSize(bytes int64) func(FieldOptions[*multipart.FileHeader]) FieldOptions[*multipart.FileHeader] {
+	return func(fo FieldOptions[*multipart.FileHeader]) FieldOptions[*multipart.FileHeader] {
+		fo = ensureConstraints(fo)
+		fo.Constraints_[ConstraintMaxFileSize] = bytes
+
+		return fo.validateWith(func(fh *multipart.FileHeader) error {
+			if fh != nil && fh.Size > bytes {
+				return errors.TypedError{
+					Err:  fmt.Errorf("file size %d exceeds the %d byte limit", fh.Size, bytes),
+					Type: errors.ErrorTypeFileTooLarge,
+				}
+			}
+			return nil
+		})
+	}
+}
+
+// AllowedContentTypes limits an uploaded file to the given media types, read
+// from the part's Content-Type header. Parameters such as charset are ignored
+// and types compare case-insensitively. Any other type is reported as
+// ErrorTypeUnsupportedMediaType, for a 415 response.
+func AllowedContentTypes(types ...string) func(FieldOptions[*multipart.FileHeader]) FieldOptions[*multipart.FileHeader] {
+	allowed := make([]string, len(types))
+	for i, t := range types {
+		allowed[i] = strings.ToLower(t)
+	}
+	return func(fo FieldOptions[*multipart.FileHeader]) FieldOptions[*multipart.FileHeader] {
+		fo = ensureConstraints(fo)
+		fo.Constraints_[ConstraintContentTypes] = types
+
+		return fo.validateWith(func(fh *multipart.FileHeader) error {
+			if fh == nil {
+				return nil
+			}
+			header := fh.Header.Get("Content-Type")
+			mediaType, _, err := mime.ParseMediaType(header)
+			if err != nil || !slices.Contains(allowed, mediaType) {
+				return errors.TypedError{
+					Err:  fmt.Errorf("content type %q is not allowed, expected one of %v", header, types),
+					Type: errors.ErrorTypeUnsupportedMediaType,
+				}
+			}
+			return nil
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package godantic_test
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════
+// File Upload Constraint Tests
+// ═══════════════════════════════════════════════════════════════════════════
+
+type TUpload struct {
+	Title  string                `json:"title"`
+	Avatar *multipart.FileHeader `json:"avatar"`
+}
+
+func (u *TUpload) FieldAvatar() godantic.FieldOptions[*multipart.FileHeader] {
+	return godantic.Field(
+		godantic.Required[*multipart.FileHeader](),
+		godantic.MaxFileSize(1024),
+		godantic.AllowedContentTypes("image/png", "image/jpeg"),
+	)
+}
+
+// uploadedFile parses a multipart form holding one file part and returns its header
+func uploadedFile(t *testing.T, contentType string, size int) *multipart.FileHeader {
+	t.Helper()
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", `form-data; name="avatar"; filename="avatar.bin"`)
+	h.Set("Content-Type", contentType)
+	part, err := w.CreatePart(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	part.Write(bytes.Repeat([]byte{0}, size))
+	w.Close()
+
+	form, err := multipart.NewReader(&body, w.Boundary()).ReadForm(1 << 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { form.RemoveAll() })
+	return form.File["avatar"][0]
+}
+
+func TestFileConstraints(t *testing.T) {
+	validator := godantic.NewValidator[TUpload]()
+
+	tests := []struct {
+		name        string
+		contentType string
+		size        int
+		wantMsg     string // empty means valid
+		wantType    godantic.ErrorType
+	}{
+		{"valid png", "image/png", 512, "", ""},
+		{"media type parameters ignored", "IMAGE/JPEG; q=1", 1024, "", ""},
+		{"oversized part", "image/png", 1025, "file size 1025 exceeds the 1024 byte limit", godantic.ErrorTypeFileTooLarge},
+		{"disallowed content type", "application/pdf", 10, `content type "application/pdf" is not allowed, expected one of [image/png image/jpeg]`, godantic.ErrorTypeUnsupportedMediaType},
+		{"missing content type", "", 10, `content type "" is not allowed`, godantic.ErrorTypeUnsupportedMediaType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			upload := TUpload{Title: "me", Avatar: uploadedFile(t, tt.contentType, tt.size)}
+			errs := validator.Validate(&upload)
+			if tt.wantMsg == "" {
+				if len(errs) != 0 {
+					t.Fatalf("expected no errors, got %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 {
+				t.Fatalf("expected 1 error, got %v", errs)
+			}
+			if errs[0].Loc[0] != "Avatar" || !strings.Contains(errs[0].Message, tt.wantMsg) {
+				t.Errorf("got %v, want %q at Avatar", errs[0], tt.wantMsg)
+			}
+			if errs[0].Type != tt.wantType {
+				t.Errorf("got type %q, want %q", errs[0].Type, tt.wantType)
+			}
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		errs := validator.Validate(&TUpload{Title: "me"})
+		if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeRequired {
+			t.Errorf("expected a required error, got %v", errs)
+		}
+	})
+}
@@ -0,0 +1,95 @@
+package godantic
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"sync"
+	"time"
+)
+
+// formatRegistry holds the validators run for Format constraints
+var formatRegistry = struct {
+	sync.RWMutex
+	validators map[string]func(string) error
+}{validators: make(map[string]func(string) error)}
+
+var (
+	emailRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
+	uuidRegex  = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+)
+
+func init() {
+	RegisterFormat("email", func(s string) error {
+		if !emailRegex.MatchString(s) {
+			return errors.New("malformed address")
+		}
+		return nil
+	})
+	RegisterFormat("uuid", func(s string) error {
+		if !uuidRegex.MatchString(s) {
+			return errors.New("expected 8-4-4-4-12 hex digits")
+		}
+		return nil
+	})
+	RegisterFormat("date-time", func(s string) error {
+		_, err := time.Parse(time.RFC3339, s)
+		return err
+	})
+	RegisterFormat("date", func(s string) error {
+		_, err := time.Parse(time.DateOnly, s)
+		return err
+	})
+}
+
+// RegisterFormat registers the validator run for fields with Format(name), so a
+// domain format (SKU codes, account numbers) is defined once instead of as a
+// Regex in every Field method. It replaces any validator already registered for
+// name, including the built-in "email", "uuid", "date-time" and "date"; a nil
+// validate removes it. Formats without a validator are schema hints only.
+//
+// Validators run on string fields (and named string types) when the field is
+// validated, so formats may be registered after the Field methods are defined.
+// Register formats at startup: RegisterFormat is safe for concurrent use, but
+// changing a format while validating gives unpredictable results.
+//
+// Example:
+//
+//	godantic.RegisterFormat("sku", func(s string) error {
+//	    if !strings.HasPrefix(s, "SKU-") {
+//	        return errors.New(`must start with "SKU-"`)
+//	    }
+//	    return nil
+//	})
+func RegisterFormat(name string, validate func(string) error) {
+	formatRegistry.Lock()
+	defer formatRegistry.Unlock()
+	if validate == nil {
+		delete(formatRegistry.validators, name)
+		return
+	}
+	formatRegistry.validators[name] = validate
+}
+
+// lookupFormat returns the validator registered for a format, if any
+func lookupFormat(name string) func(string) error {
+	formatRegistry.RLock()
+	defer formatRegistry.RUnlock()
+	return formatRegistry.validators[name]
+}
+
+// formatString returns the string held by val (or the string it points to),
+// or false for non-string values such as time.Time
+func formatString(val any) (string, bool) {
+	rv := reflect.ValueOf(val)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return "", false
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.String {
+		return "", false
+	}
+	return rv.String(), true
+}
@@ -0,0 +1,97 @@
+package godantic_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════
+// Format Registry Tests
+// ═══════════════════════════════════════════════════════════════════════════
+
+type TSKU string
+
+type TOrderLine struct {
+	SKU      string  `json:"sku"`
+	Alt      TSKU    `json:"alt"`
+	ID       string  `json:"id"`
+	Due      *string `json:"due"`
+	Homepage string  `json:"homepage"`
+}
+
+func (o *TOrderLine) FieldSKU() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Required[string](), godantic.Format[string]("test-sku"))
+}
+
+func (o *TOrderLine) FieldAlt() godantic.FieldOptions[TSKU] {
+	return godantic.Field(godantic.Format[TSKU]("test-sku"))
+}
+
+func (o *TOrderLine) FieldID() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Format[string]("uuid"))
+}
+
+func (o *TOrderLine) FieldDue() godantic.FieldOptions[*string] {
+	return godantic.Field(godantic.Format[*string]("date-time"))
+}
+
+func (o *TOrderLine) FieldHomepage() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Format[string]("test-unregistered"))
+}
+
+func TestRegisterFormat(t *testing.T) {
+	godantic.RegisterFormat("test-sku", func(s string) error {
+		if !strings.HasPrefix(s, "SKU-") {
+			return errors.New(`must start with "SKU-"`)
+		}
+		return nil
+	})
+	t.Cleanup(func() { godantic.RegisterFormat("test-sku", nil) })
+
+	validator := godantic.NewValidator[TOrderLine]()
+	due := "2025-01-02T15:04:05Z"
+	badDue := "tomorrow"
+
+	tests := []struct {
+		name    string
+		line    TOrderLine
+		wantLoc string // "" means valid
+		wantMsg string
+	}{
+		{"custom format passes", TOrderLine{SKU: "SKU-1", Alt: "SKU-2"}, "", ""},
+		{"custom format fails", TOrderLine{SKU: "ABC"}, "SKU", `value is not a valid test-sku: must start with "SKU-"`},
+		{"named string type", TOrderLine{SKU: "SKU-1", Alt: "X"}, "Alt", "value is not a valid test-sku"},
+		{"built-in uuid passes", TOrderLine{SKU: "SKU-1", ID: "123e4567-e89b-12d3-a456-426614174000"}, "", ""},
+		{"built-in uuid fails", TOrderLine{SKU: "SKU-1", ID: "123"}, "ID", "value is not a valid uuid"},
+		{"pointer date-time passes", TOrderLine{SKU: "SKU-1", Due: &due}, "", ""},
+		{"pointer date-time fails", TOrderLine{SKU: "SKU-1", Due: &badDue}, "Due", "value is not a valid date-time"},
+		{"unregistered format is a hint", TOrderLine{SKU: "SKU-1", Homepage: "anything"}, "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.Validate(&tt.line)
+			if tt.wantLoc == "" {
+				if len(errs) != 0 {
+					t.Errorf("expected no errors, got %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || errs[0].Loc[0] != tt.wantLoc || errs[0].Type != godantic.ErrorTypeConstraint {
+				t.Fatalf("expected one constraint error on %s, got %v", tt.wantLoc, errs)
+			}
+			if !strings.HasPrefix(errs[0].Message, tt.wantMsg) {
+				t.Errorf("expected message %q, got %q", tt.wantMsg, errs[0].Message)
+			}
+		})
+	}
+
+	t.Run("removing a format makes it a hint", func(t *testing.T) {
+		godantic.RegisterFormat("test-sku", nil)
+		if errs := validator.Validate(&TOrderLine{SKU: "ABC"}); len(errs) != 0 {
+			t.Errorf("expected no errors, got %v", errs)
+		}
+	})
+}
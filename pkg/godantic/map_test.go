@@ -124,3 +124,71 @@ func TestMaps(t *testing.T) {
 		}
 	})
 }
+
+// Map values with their own Field methods
+type TSettingValue struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+func (s *TSettingValue) FieldValue() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Required[string]())
+}
+
+func (s *TSettingValue) FieldCount() godantic.FieldOptions[int] {
+	return godantic.Field(godantic.Min(0), godantic.Max(10))
+}
+
+type TSettingsConfig struct {
+	Settings map[string]TSettingValue  `json:"settings"`
+	Backups  map[string]*TSettingValue `json:"backups"`
+}
+
+func TestMaps_StructValues(t *testing.T) {
+	validator := godantic.NewValidator[TSettingsConfig]()
+
+	wantErrs := func(t *testing.T, errs godantic.ValidationErrors, want ...string) {
+		t.Helper()
+		if len(errs) != len(want) {
+			t.Fatalf("expected %d errors, got %v", len(want), errs)
+		}
+		for i, e := range errs {
+			if e.Error() != want[i] {
+				t.Errorf("error %d: expected %q, got %q", i, want[i], e.Error())
+			}
+		}
+	}
+
+	t.Run("Validate", func(t *testing.T) {
+		errs := validator.Validate(&TSettingsConfig{
+			Settings: map[string]TSettingValue{
+				"key1": {Count: 1},
+				"key2": {Value: "ok", Count: 11},
+				"key3": {Value: "ok"},
+			},
+			Backups: map[string]*TSettingValue{"primary": {Value: "ok", Count: -1}, "none": nil},
+		})
+		wantErrs(t, errs,
+			"Settings.key1.Value: required field",
+			"Settings.key2.Count: value must be <= 10",
+			"Backups.primary.Count: value must be >= 0",
+		)
+		if errs[0].Type != godantic.ErrorTypeRequired || errs[1].Type != godantic.ErrorTypeConstraint {
+			t.Errorf("unexpected error types: %v", errs)
+		}
+	})
+
+	t.Run("Unmarshal", func(t *testing.T) {
+		cfg, errs := validator.Unmarshal([]byte(`{
+			"settings": {"key1": {"count": 1}, "key2": {"value": "ok", "count": 11}},
+			"backups": {"primary": {"value": "ok", "count": 2}}
+		}`))
+		wantErrs(t, errs,
+			"Settings.key1.Value: required field",
+			"Settings.key2.Count: value must be <= 10",
+		)
+		if cfg == nil || cfg.Backups["primary"].Count != 2 {
+			t.Errorf("expected decoded values alongside errors, got %+v", cfg)
+		}
+	})
+}
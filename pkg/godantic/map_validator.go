@@ -1,42 +1,82 @@
 package godantic
 
 import (
+	"context"
 	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"maps"
+	"net/textproto"
 	"reflect"
+	"slices"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/deepankarm/godantic/pkg/internal/errors"
 	"github.com/deepankarm/godantic/pkg/internal/reflectutil"
+	"github.com/deepankarm/godantic/pkg/internal/walk"
 )
 
 // ValidateFromStringMap validates data from a map[string]string (for path params, cookies)
-// Converts string values to appropriate Go types based on struct field types
+// Converts string values to appropriate Go types based on struct field types.
+// Values that don't parse as the field's type are reported as ErrorTypeCoercion.
+// Defaults fill only keys missing from data; a present empty or zero value is
+// kept and validated. The same holds for ValidateFromMultiValueMap and
+// ValidateFromHeaders.
 func (v *Validator[T]) ValidateFromStringMap(data map[string]string) (*T, ValidationErrors) {
-	var zero T
-	typ := reflectutil.UnwrapPointer(reflect.TypeOf(zero))
+	return v.validateStringMap(data, false)
+}
 
-	// Map JSON field names to struct field types
-	fieldTypes := make(map[string]reflect.Type)
-	for i := 0; i < typ.NumField(); i++ {
-		field := typ.Field(i)
-		jsonTag := field.Tag.Get("json")
-		if jsonTag != "" && jsonTag != "-" {
-			fieldName := strings.Split(jsonTag, ",")[0]
-			fieldTypes[fieldName] = field.Type
-		}
+// ValidateFromCookies validates cookies, keyed by name, against T. Cookie names
+// are case-sensitive: a field tagged `json:"session_id"` matches only the
+// "session_id" cookie, never "Session_ID". A missing required cookie is
+// reported as ErrorTypeRequired with a message naming the cookie. Otherwise it
+// behaves like ValidateFromStringMap.
+func (v *Validator[T]) ValidateFromCookies(cookies map[string]string) (*T, ValidationErrors) {
+	result, errs := v.validateStringMap(cookies, true)
+	return result, paramRequiredErrors(errs, v.rootType(), v.config.tagName, "cookie")
+}
+
+// validateStringMap converts single-value string data and validates it. With
+// exactKeys, keys matching a field only case-insensitively are dropped instead
+// of passed through, where json.Unmarshal would match them.
+func (v *Validator[T]) validateStringMap(data map[string]string, exactKeys bool) (*T, ValidationErrors) {
+	fields := multiValueFields(v.rootType(), v.config.tagName, func(name string) string { return name })
+	var folded map[string]multiValueField
+	if exactKeys {
+		folded = multiValueFields(v.rootType(), v.config.tagName, strings.ToLower)
 	}
 
-	// Convert string values to appropriate types
-	dataMap := make(map[string]any)
+	// Unknown fields pass through as strings
+	dataMap := make(map[string]any, len(data))
 	for key, value := range data {
-		fieldType, ok := fieldTypes[key]
-		if !ok {
-			// Unknown field, pass as string
-			dataMap[key] = value
+		if _, ok := fields[key]; ok {
 			continue
 		}
+		if _, ok := folded[strings.ToLower(key)]; ok {
+			continue
+		}
+		dataMap[key] = value
+	}
 
-		// Convert based on field type
-		dataMap[key] = convertStringToType(value, fieldType)
+	// Convert known fields in declaration order so errors are deterministic
+	var errs ValidationErrors
+	for _, mvf := range orderedFields(fields) {
+		value, ok := data[mvf.jsonName]
+		if !ok {
+			continue
+		}
+		converted, err := v.coerceField(value, mvf.field.Type)
+		if err != nil {
+			errs = append(errs, mvf.error(nil, err.Error(), ErrorTypeCoercion))
+			continue
+		}
+		dataMap[mvf.jsonName] = converted
+	}
+	if len(errs) > 0 {
+		v.notify(errs)
+		return nil, errs
 	}
 
 	// Marshal to JSON and validate
@@ -51,47 +91,214 @@ func (v *Validator[T]) ValidateFromStringMap(data map[string]string) (*T, Valida
 		}
 	}
 
-	return v.Unmarshal(jsonData)
+	return v.paramValidator().Unmarshal(jsonData)
 }
 
 // ValidateFromMultiValueMap validates data from a map[string][]string (for query params, headers)
-// Converts string values to appropriate Go types based on struct field types
+// Converts string values to appropriate Go types based on struct field types.
+// Slice fields collect all values; scalar fields take the first value, or report an
+// error when more than one is given if the validator uses WithStrictSingleValue.
+// Keys match JSON field names exactly first, then case-insensitively as with
+// json.Unmarshal: "Page" sets a field tagged `json:"page"`, after the values of
+// a "page" key if both are given.
 func (v *Validator[T]) ValidateFromMultiValueMap(data map[string][]string) (*T, ValidationErrors) {
+	return v.validateMultiValue(data, func(name string) string { return name })
+}
+
+// ValidateFromHeaders validates HTTP headers (e.g. http.Header) against T.
+// Header names are canonicalized with textproto.CanonicalMIMEHeaderKey before
+// matching, so a field tagged `json:"X-API-Key"` matches "X-Api-Key" and
+// "x-api-key". A missing required header is reported as ErrorTypeRequired with
+// a message naming the header. Multiple values follow the same rules as
+// ValidateFromMultiValueMap.
+func (v *Validator[T]) ValidateFromHeaders(headers map[string][]string) (*T, ValidationErrors) {
+	result, errs := v.validateMultiValue(headers, textproto.CanonicalMIMEHeaderKey)
+	return result, paramRequiredErrors(errs, v.rootType(), v.config.tagName, "header")
+}
+
+// paramRequiredErrors names the missing parameter in the required errors of
+// top-level fields, e.g. `missing required header "X-API-Key"`, since the Loc
+// holds the Go field name rather than the name the client has to send.
+func paramRequiredErrors(errs ValidationErrors, typ reflect.Type, tag, location string) ValidationErrors {
+	if len(errs) == 0 {
+		return errs
+	}
+	names := make(map[string]bool)
+	for _, mvf := range multiValueFields(typ, tag, func(name string) string { return name }) {
+		names[mvf.jsonName] = true
+	}
+	for i, e := range errs {
+		if loc := errors.JSONLoc(e); len(loc) == 1 && e.Type == ErrorTypeRequired && names[loc[0]] {
+			errs[i].Message = fmt.Sprintf("missing required %s %q", location, loc[0])
+		}
+	}
+	return errs
+}
+
+// paramValidator returns v configured for parameter maps: defaults fill only
+// parameters that were absent, so an explicit empty or zero value is kept and
+// validated like any other value.
+func (v *Validator[T]) paramValidator() *Validator[T] {
+	clone := *v
+	clone.config.absentOnlyDefaults = true
+	return &clone
+}
+
+// rootType returns the struct type being validated
+func (v *Validator[T]) rootType() reflect.Type {
 	var zero T
-	typ := reflectutil.UnwrapPointer(reflect.TypeOf(zero))
+	return reflectutil.UnwrapPointer(reflect.TypeOf(zero))
+}
+
+// multiValueField is a struct field addressed by its JSON name
+type multiValueField struct {
+	jsonName string
+	field    reflect.StructField
+	tagged   bool // Named under a WithTagName tag, which Loc uses too
+}
 
-	// Map JSON field names to struct field types
-	fieldTypes := make(map[string]reflect.Type)
+// error builds an error located at the field, followed by the segments of
+// loc, with the field named by jsonName on the wire
+func (mvf multiValueField) error(loc []string, message string, errType ErrorType) ValidationError {
+	wireLoc := append([]string{mvf.jsonName}, loc...)
+	if mvf.tagged {
+		return ValidationError{Loc: wireLoc, Message: message, Type: errType}
+	}
+	e := ValidationError{Loc: append([]string{mvf.field.Name}, loc...), Message: message, Type: errType}
+	errors.SetJSONLoc(&e, wireLoc)
+	return e
+}
+
+// multiValueFields maps normalized JSON field names to struct fields.
+// With a tag (see WithTagName), fields carrying it are named by it instead.
+func multiValueFields(typ reflect.Type, tag string, normalize func(string) string) map[string]multiValueField {
+	fields := make(map[string]multiValueField)
 	for i := 0; i < typ.NumField(); i++ {
 		field := typ.Field(i)
+		if !field.IsExported() {
+			continue // Unexported fields are never populated by encoding/json
+		}
 		jsonTag := field.Tag.Get("json")
+		if name, _, _ := strings.Cut(field.Tag.Get(tag), ","); tag != "" && name != "" {
+			jsonTag = name
+		}
 		if jsonTag != "" && jsonTag != "-" {
 			fieldName := strings.Split(jsonTag, ",")[0]
-			fieldTypes[fieldName] = field.Type
+			fields[normalize(fieldName)] = multiValueField{jsonName: fieldName, field: field, tagged: tag != ""}
 		}
 	}
+	return fields
+}
+
+// orderedFields returns fields in struct declaration order
+func orderedFields(fields map[string]multiValueField) []multiValueField {
+	ordered := make([]multiValueField, 0, len(fields))
+	for _, mvf := range fields {
+		ordered = append(ordered, mvf)
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		return ordered[i].field.Index[0] < ordered[j].field.Index[0]
+	})
+	return ordered
+}
 
-	// Convert multi-value string data to appropriate types
+// coerceField coerces a value for a struct field. TextUnmarshaler values stay
+// raw strings in the JSON handed to Unmarshal, which decodes them with
+// UnmarshalText again, since they may not marshal back to that text.
+// Durations are handed on in their canonical form ("1m30s").
+func (v *Validator[T]) coerceField(value string, fieldType reflect.Type) (any, error) {
+	converted, err := coerceString(value, fieldType, v.config.coerce)
+	if d, ok := converted.(time.Duration); ok && err == nil {
+		return d.String(), nil
+	}
+	if err != nil || !reflectutil.IsTextUnmarshaler(reflectutil.UnwrapPointer(fieldType)) {
+		return converted, err
+	}
+	return value, nil
+}
+
+// coerceElements coerces the values of a list field to its element type,
+// with an error located at the index of each value that doesn't convert
+func (v *Validator[T]) coerceElements(values []string, elemType reflect.Type, mvf multiValueField) ([]any, ValidationErrors) {
+	elems := make([]any, len(values))
+	var errs ValidationErrors
+	for i, value := range values {
+		converted, err := v.coerceField(value, elemType)
+		if err != nil {
+			errs = append(errs, mvf.error([]string{fmt.Sprintf("[%d]", i)}, err.Error(), ErrorTypeCoercion))
+			continue
+		}
+		elems[i] = converted
+	}
+	return elems, errs
+}
+
+// validateMultiValue converts multi-value string data and validates it.
+// Incoming keys and field names are matched after normalize, falling back to
+// a case-insensitive match whose values come after those of matching keys.
+func (v *Validator[T]) validateMultiValue(data map[string][]string, normalize func(string) string) (*T, ValidationErrors) {
+	fields := multiValueFields(v.rootType(), v.config.tagName, normalize)
+	folded := multiValueFields(v.rootType(), v.config.tagName, strings.ToLower)
+
+	// Group values per field in key order, so the values of keys normalizing
+	// to the same field, such as "x-id" and "X-Id", are merged deterministically
 	dataMap := make(map[string]any)
-	for key, values := range data {
+	grouped := make(map[string][]string)
+	var fallback []string
+	for _, key := range slices.Sorted(maps.Keys(data)) {
+		values := data[key]
 		if len(values) == 0 {
 			continue
 		}
-
-		fieldType, ok := fieldTypes[key]
-		if !ok {
+		if mvf, ok := fields[normalize(key)]; ok {
+			grouped[mvf.jsonName] = append(grouped[mvf.jsonName], values...)
+		} else if _, ok := folded[strings.ToLower(key)]; ok {
+			fallback = append(fallback, key)
+		} else {
 			// Unknown field, use first value as string
 			dataMap[key] = values[0]
+		}
+	}
+	for _, key := range fallback {
+		mvf := folded[strings.ToLower(key)]
+		grouped[mvf.jsonName] = append(grouped[mvf.jsonName], data[key]...)
+	}
+
+	// Convert multi-value string data to appropriate types
+	var errs ValidationErrors
+	for _, mvf := range orderedFields(fields) {
+		values, ok := grouped[mvf.jsonName]
+		if !ok {
 			continue
 		}
+		fieldType := mvf.field.Type
 
-		// For array/slice types, use all values
-		if fieldType.Kind() == reflect.Slice || fieldType.Kind() == reflect.Array {
-			dataMap[key] = values
-		} else {
-			// For non-array types, use first value
-			dataMap[key] = convertStringToType(values[0], fieldType)
+		// For array/slice types, use all values, coercing each element so a
+		// bad one is reported at its index
+		isList := fieldType.Kind() == reflect.Slice || fieldType.Kind() == reflect.Array
+		if isList && !reflectutil.IsTextUnmarshaler(fieldType) {
+			elems, elemErrs := v.coerceElements(values, fieldType.Elem(), mvf)
+			errs = append(errs, elemErrs...)
+			dataMap[mvf.jsonName] = elems
+			continue
+		}
+
+		if v.config.strictSingleValue && len(values) > 1 {
+			errs = append(errs, mvf.error(nil, fmt.Sprintf("expected a single value, got %d", len(values)), ErrorTypeConstraint))
+			continue
+		}
+
+		// For non-array types, use first value
+		converted, err := v.coerceField(values[0], fieldType)
+		if err != nil {
+			errs = append(errs, mvf.error(nil, err.Error(), ErrorTypeCoercion))
+			continue
 		}
+		dataMap[mvf.jsonName] = converted
+	}
+	if len(errs) > 0 {
+		v.notify(errs)
+		return nil, errs
 	}
 
 	// Marshal to JSON and validate
@@ -106,34 +313,114 @@ func (v *Validator[T]) ValidateFromMultiValueMap(data map[string][]string) (*T,
 		}
 	}
 
-	return v.Unmarshal(jsonData)
+	return v.paramValidator().Unmarshal(jsonData)
 }
 
-// convertStringToType converts a string value to the appropriate Go type
-func convertStringToType(value string, fieldType reflect.Type) any {
-	switch fieldType.Kind() {
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		if intVal, err := json.Number(value).Int64(); err == nil {
-			return intVal
-		}
-		return value // Let validator handle the error
+// ValidateParam coerces a single path, query, header or cookie value to T and
+// checks it against the given field options, for one-off parameters that don't
+// warrant a struct with a Field method. name is used as the error Loc.
+//
+// values holds every value sent for the parameter; nil or empty means it was
+// absent, in which case a Default is used (and checked against the other
+// options) or Required is reported. A value that is present but empty is not
+// replaced by the Default. When several values are given, the first is used.
+// Errors share the types of struct-based validation: ErrorTypeCoercion,
+// ErrorTypeRequired and ErrorTypeConstraint. Mistakes in the options
+// themselves, such as a Regex that does not compile, are reported as
+// ErrorTypeInternal without checking the value, as Validator.Err reports them.
+//
+// Example:
+//
+//	id, errs := godantic.ValidateParam[int]("id", []string{"42"}, godantic.Min(1))
+func ValidateParam[T any](name string, values []string, opts ...func(FieldOptions[T]) FieldOptions[T]) (T, ValidationErrors) {
+	return ValidateParamWith(name, values, nil, opts...)
+}
 
-	case reflect.Float32, reflect.Float64:
-		if floatVal, err := json.Number(value).Float64(); err == nil {
-			return floatVal
+// ValidateParamWith is ValidateParam with validator options for coercing the
+// value, such as WithBoolValues and WithDurationSeconds, as a Validator
+// applies them to its parameter maps. Options that don't concern coercion are
+// ignored.
+//
+// Example:
+//
+//	checkbox := []godantic.ValidatorOption{godantic.WithBoolValues([]string{"on"}, []string{"off"})}
+//	notify, errs := godantic.ValidateParamWith[bool]("notify", []string{"on"}, checkbox)
+func ValidateParamWith[T any](name string, values []string, validatorOpts []ValidatorOption, opts ...func(FieldOptions[T]) FieldOptions[T]) (T, ValidationErrors) {
+	var zero T
+	fo := Field(opts...)
+	loc := []string{name}
+	if len(fo.Errors_) > 0 {
+		errs := make(ValidationErrors, len(fo.Errors_))
+		for i, err := range fo.Errors_ {
+			errs[i] = ValidationError{Loc: loc, Message: err.Error(), Type: ErrorTypeInternal}
 		}
-		return value
+		return zero, errs
+	}
+	var cfg validatorConfig
+	for _, opt := range validatorOpts {
+		opt.apply(&cfg)
+	}
 
-	case reflect.Bool:
-		switch value {
-		case "true", "1":
-			return true
-		case "false", "0":
-			return false
+	if len(values) == 0 {
+		strict, _ := fo.Constraints_[ConstraintStrictRequired].(bool)
+		def, ok := fo.Constraints_[ConstraintDefault].(T)
+		if fn, isFunc := fo.Constraints_[ConstraintDefaultFunc].(func() any); isFunc {
+			def, ok = fn().(T)
+		}
+		if ok && !strict {
+			// The default must satisfy the parameter's own constraints
+			return validateParamValue(loc, def, fo)
+		}
+		if fo.Required_ {
+			return zero, ValidationErrors{{Loc: loc, Message: "required field", Type: ErrorTypeRequired}}
 		}
-		return value
+		return zero, nil
+	}
+
+	typ := reflect.TypeOf(zero)
+	rv, err := coerceValue(values[0], typ, cfg.coerce)
+	if stderrors.Is(err, errUnsupportedCoercion) {
+		return zero, ValidationErrors{{Loc: loc, Message: fmt.Sprintf("unsupported parameter type %v", typ), Type: ErrorTypeInternal}}
+	}
+	if err != nil {
+		return zero, ValidationErrors{{Loc: loc, Message: err.Error(), Type: ErrorTypeCoercion}}
+	}
+	value := rv.Interface().(T)
+	if nonEmpty, _ := fo.Constraints_[ConstraintNonEmpty].(bool); nonEmpty && walk.IsEmpty(rv) {
+		return zero, ValidationErrors{{Loc: loc, Message: "required field must not be empty", Type: ErrorTypeRequired}}
+	}
+	return validateParamValue(loc, value, fo)
+}
 
-	default:
-		return value
+// validateParamValue runs the validators of a single parameter on value
+func validateParamValue[T any](loc []string, value T, fo FieldOptions[T]) (T, ValidationErrors) {
+	var zero T
+	var errs ValidationErrors
+	writeOnly, _ := fo.Constraints_[ConstraintWriteOnly].(bool)
+	for _, validate := range fo.Validators_ {
+		if err := validate(value); err != nil {
+			if writeOnly {
+				err = withoutInput(err)
+			}
+			errs = append(errs, errors.ConstraintErrors(loc, err)...)
+		}
+	}
+	// A lone parameter has no siblings
+	for _, validate := range fo.SiblingValidators_ {
+		if err := validate(value, map[string]any{}); err != nil {
+			errs = append(errs, errors.ConstraintErrors(loc, err)...)
+		}
+	}
+	if len(errs) > 0 {
+		return zero, errs
+	}
+	for _, validate := range fo.ContextValidators_ {
+		if err := validate(context.Background(), value); err != nil {
+			errs = append(errs, errors.ConstraintErrors(loc, err)...)
+		}
+	}
+	if len(errs) > 0 {
+		return zero, errs
 	}
+	return value, nil
 }
@@ -1,7 +1,11 @@
 package godantic_test
 
 import (
+	"fmt"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/deepankarm/godantic/pkg/godantic"
 )
@@ -336,3 +340,396 @@ func TestValidateFromMultiValueMap_EmptySlice(t *testing.T) {
 		t.Errorf("Items = %v, want nil", result.Items)
 	}
 }
+
+type TIDFilter struct {
+	IDs []int `json:"ids"`
+}
+
+func (f *TIDFilter) FieldIDs() godantic.FieldOptions[[]int] {
+	return godantic.Field(godantic.MinItems[int](2))
+}
+
+func TestValidateFromMultiValueMap_SliceElements(t *testing.T) {
+	validator := godantic.NewValidator[TIDFilter]()
+
+	tests := []struct {
+		name     string
+		ids      []string
+		wantIDs  []int
+		wantErrs []string // "Loc: Type"
+	}{
+		{
+			name:    "all_valid",
+			ids:     []string{"1", "2", "3"},
+			wantIDs: []int{1, 2, 3},
+		},
+		{
+			name:     "one_bad_element",
+			ids:      []string{"1", "2", "abc"},
+			wantErrs: []string{"IDs.[2]: coercion"},
+		},
+		{
+			name:     "several_bad_elements",
+			ids:      []string{"x", "2", "1.5"},
+			wantErrs: []string{"IDs.[0]: coercion", "IDs.[2]: coercion"},
+		},
+		{
+			name:     "valid_elements_below_min_items",
+			ids:      []string{"7"},
+			wantErrs: []string{"IDs: constraint"},
+		},
+		{
+			name:     "bad_element_below_min_items",
+			ids:      []string{"seven"},
+			wantErrs: []string{"IDs.[0]: coercion"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, errs := validator.ValidateFromMultiValueMap(map[string][]string{"ids": tt.ids})
+			var got []string
+			for _, err := range errs {
+				got = append(got, fmt.Sprintf("%s: %s", strings.Join(err.Loc, "."), err.Type))
+			}
+			if !slices.Equal(got, tt.wantErrs) {
+				t.Fatalf("errors = %v, want %v", got, tt.wantErrs)
+			}
+			if len(tt.wantErrs) == 0 && !slices.Equal(result.IDs, tt.wantIDs) {
+				t.Errorf("IDs = %v, want %v", result.IDs, tt.wantIDs)
+			}
+		})
+	}
+}
+
+func TestValidateFromMultiValueMap_CaseInsensitiveKeys(t *testing.T) {
+	validator := godantic.NewValidator[TIDFilter]()
+
+	result, errs := validator.ValidateFromMultiValueMap(map[string][]string{"IDs": {"1", "2"}})
+	if len(errs) > 0 || !slices.Equal(result.IDs, []int{1, 2}) {
+		t.Errorf("expected IDs to set ids, got %+v %v", result, errs)
+	}
+
+	for range 10 {
+		result, errs = validator.ValidateFromMultiValueMap(map[string][]string{
+			"Ids": {"4"},
+			"ids": {"1", "2"},
+			"IDS": {"3"},
+		})
+		if len(errs) > 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if !slices.Equal(result.IDs, []int{1, 2, 3, 4}) {
+			t.Fatalf("IDs = %v, want the values of the exact key first", result.IDs)
+		}
+	}
+}
+
+// ═══════════════════════════════════════════════════════════════════════════
+// ValidateFromHeaders Tests
+// Canonical header matching and repeated header values
+// ═══════════════════════════════════════════════════════════════════════════
+
+type tForwardedHeaders struct {
+	APIKey       string   `json:"X-API-Key"`
+	ForwardedFor []string `json:"X-Forwarded-For"`
+	RetryCount   int      `json:"X-Retry-Count"`
+}
+
+func (h *tForwardedHeaders) FieldAPIKey() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Required[string]())
+}
+
+func TestValidateFromHeaders(t *testing.T) {
+	validator := godantic.NewValidator[tForwardedHeaders]()
+
+	t.Run("repeated header collected into slice", func(t *testing.T) {
+		result, errs := validator.ValidateFromHeaders(map[string][]string{
+			"X-Api-Key":       {"secret"},
+			"X-Forwarded-For": {"10.0.0.1", "10.0.0.2"},
+		})
+		if len(errs) > 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if len(result.ForwardedFor) != 2 || result.ForwardedFor[1] != "10.0.0.2" {
+			t.Errorf("ForwardedFor = %v, want both values", result.ForwardedFor)
+		}
+	})
+
+	t.Run("case-insensitive matching with type conversion", func(t *testing.T) {
+		result, errs := validator.ValidateFromHeaders(map[string][]string{
+			"x-api-key":     {"secret"},
+			"x-retry-count": {"3"},
+		})
+		if len(errs) > 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if result.APIKey != "secret" {
+			t.Errorf("APIKey = %q, want %q", result.APIKey, "secret")
+		}
+		if result.RetryCount != 3 {
+			t.Errorf("RetryCount = %d, want 3", result.RetryCount)
+		}
+	})
+
+	t.Run("names differing in case merge in sorted order", func(t *testing.T) {
+		for range 10 {
+			result, errs := validator.ValidateFromHeaders(map[string][]string{
+				"X-Api-Key":       {"secret"},
+				"x-forwarded-for": {"10.0.0.3"},
+				"X-Forwarded-For": {"10.0.0.1", "10.0.0.2"},
+			})
+			if len(errs) > 0 {
+				t.Fatalf("unexpected errors: %v", errs)
+			}
+			if !slices.Equal(result.ForwardedFor, []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"}) {
+				t.Fatalf("ForwardedFor = %v, want the values of X-Forwarded-For first", result.ForwardedFor)
+			}
+		}
+	})
+
+	t.Run("scalar takes first value", func(t *testing.T) {
+		result, errs := validator.ValidateFromHeaders(map[string][]string{
+			"X-Api-Key": {"first", "second"},
+		})
+		if len(errs) > 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if result.APIKey != "first" {
+			t.Errorf("APIKey = %q, want %q", result.APIKey, "first")
+		}
+	})
+
+	t.Run("missing required header", func(t *testing.T) {
+		_, errs := validator.ValidateFromHeaders(map[string][]string{})
+		if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeRequired {
+			t.Fatalf("expected required error, got %v", errs)
+		}
+		if errs[0].Message != `missing required header "X-API-Key"` || errs[0].Path() != "X-API-Key" {
+			t.Errorf("expected the header to be named, got %v", errs[0])
+		}
+	})
+}
+
+type tSessionCookies struct {
+	SessionID string `json:"session_id"`
+	Theme     string `json:"theme"`
+}
+
+func (c *tSessionCookies) FieldSessionID() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Required[string]())
+}
+
+func TestValidateFromCookies(t *testing.T) {
+	validator := godantic.NewValidator[tSessionCookies]()
+
+	t.Run("exact names", func(t *testing.T) {
+		result, errs := validator.ValidateFromCookies(map[string]string{"session_id": "abc", "theme": "dark"})
+		if len(errs) > 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if result.SessionID != "abc" || result.Theme != "dark" {
+			t.Errorf("got %+v", result)
+		}
+	})
+
+	t.Run("names differing in case don't match", func(t *testing.T) {
+		_, errs := validator.ValidateFromCookies(map[string]string{"Session_ID": "abc"})
+		if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeRequired {
+			t.Fatalf("expected required error, got %v", errs)
+		}
+		if errs[0].Message != `missing required cookie "session_id"` {
+			t.Errorf("expected the cookie to be named, got %v", errs[0])
+		}
+
+		result, errs := validator.ValidateFromCookies(map[string]string{"session_id": "abc", "THEME": "dark"})
+		if len(errs) > 0 || result.Theme != "" {
+			t.Errorf("expected THEME to be ignored, got %+v %v", result, errs)
+		}
+	})
+}
+
+func TestWithStrictSingleValue(t *testing.T) {
+	validator := godantic.NewValidator[tForwardedHeaders](godantic.WithStrictSingleValue())
+
+	_, errs := validator.ValidateFromHeaders(map[string][]string{
+		"X-Api-Key":       {"first", "second"},
+		"X-Forwarded-For": {"10.0.0.1", "10.0.0.2"},
+	})
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %v", errs)
+	}
+	if errs[0].Type != godantic.ErrorTypeConstraint || errs[0].Loc[0] != "APIKey" {
+		t.Errorf("expected constraint error on APIKey, got %v", errs[0])
+	}
+
+	// Header names differing only in case count as the same header
+	_, errs = validator.ValidateFromHeaders(map[string][]string{
+		"X-API-Key": {"first"},
+		"x-api-key": {"second"},
+	})
+	if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeConstraint {
+		t.Errorf("expected constraint error for duplicate keys, got %v", errs)
+	}
+
+	_, errs = validator.ValidateFromHeaders(map[string][]string{"X-Api-Key": {"only"}})
+	if len(errs) > 0 {
+		t.Errorf("unexpected errors for single value: %v", errs)
+	}
+}
+
+type TParamDefaults struct {
+	Sort  string `json:"sort"`
+	Limit int    `json:"limit"`
+}
+
+func (p *TParamDefaults) FieldSort() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Default("name"))
+}
+
+func (p *TParamDefaults) FieldLimit() godantic.FieldOptions[int] {
+	return godantic.Field(godantic.Default(20), godantic.Min(1), godantic.Max(100))
+}
+
+// TestParamMapDefaults checks that every parameter map fills defaults only for
+// absent keys, keeping and validating explicit empty or zero values
+func TestParamMapDefaults(t *testing.T) {
+	validator := godantic.NewValidator[TParamDefaults]()
+	locations := map[string]func(values map[string]string) (*TParamDefaults, godantic.ValidationErrors){
+		"string map": validator.ValidateFromStringMap,
+		"multi-value map": func(values map[string]string) (*TParamDefaults, godantic.ValidationErrors) {
+			data := make(map[string][]string)
+			for k, v := range values {
+				data[k] = []string{v}
+			}
+			return validator.ValidateFromMultiValueMap(data)
+		},
+		"headers": func(values map[string]string) (*TParamDefaults, godantic.ValidationErrors) {
+			data := make(map[string][]string)
+			for k, v := range values {
+				data[k] = []string{v}
+			}
+			return validator.ValidateFromHeaders(data)
+		},
+	}
+
+	for name, validate := range locations {
+		t.Run(name, func(t *testing.T) {
+			result, errs := validate(map[string]string{})
+			if len(errs) > 0 || result.Sort != "name" || result.Limit != 20 {
+				t.Errorf("expected defaults for absent keys, got %+v %v", result, errs)
+			}
+
+			result, errs = validate(map[string]string{"sort": ""})
+			if len(errs) > 0 || result.Sort != "" {
+				t.Errorf("expected an empty value to be kept, got %+v %v", result, errs)
+			}
+
+			_, errs = validate(map[string]string{"limit": "0"})
+			if len(errs) != 1 || errs[0].Loc[0] != "Limit" || errs[0].Type != godantic.ErrorTypeConstraint {
+				t.Errorf("expected a constraint error for an explicit zero, got %v", errs)
+			}
+		})
+	}
+
+	// JSON bodies keep replacing zero values with defaults
+	result, errs := validator.Unmarshal([]byte(`{"sort": "", "limit": 0}`))
+	if len(errs) > 0 || result.Sort != "name" || result.Limit != 20 {
+		t.Errorf("expected Unmarshal to default zero values, got %+v %v", result, errs)
+	}
+}
+
+// ═══════════════════════════════════════════════════════════════════════════
+// ValidateParam Tests
+// Single values checked against field options, without a struct
+// ═══════════════════════════════════════════════════════════════════════════
+
+type TParamStatus string
+
+func TestValidateParam(t *testing.T) {
+	t.Run("coerces and validates", func(t *testing.T) {
+		id, errs := godantic.ValidateParam("id", []string{"42"}, godantic.Min(1))
+		if len(errs) > 0 || id != 42 {
+			t.Errorf("expected 42, got %d %v", id, errs)
+		}
+	})
+
+	t.Run("named and pointer types", func(t *testing.T) {
+		status, errs := godantic.ValidateParam("status", []string{"open"}, godantic.OneOf[TParamStatus]("open", "closed"))
+		if len(errs) > 0 || status != "open" {
+			t.Errorf("expected open, got %q %v", status, errs)
+		}
+		ratio, errs := godantic.ValidateParam[*float64]("ratio", []string{"0.5"})
+		if len(errs) > 0 || ratio == nil || *ratio != 0.5 {
+			t.Errorf("expected pointer to 0.5, got %v %v", ratio, errs)
+		}
+	})
+
+	t.Run("absent values", func(t *testing.T) {
+		limit, errs := godantic.ValidateParam("limit", nil, godantic.Default(10))
+		if len(errs) > 0 || limit != 10 {
+			t.Errorf("expected default 10, got %d %v", limit, errs)
+		}
+		page, errs := godantic.ValidateParam[int]("page", nil)
+		if len(errs) > 0 || page != 0 {
+			t.Errorf("expected zero value, got %d %v", page, errs)
+		}
+		_, errs = godantic.ValidateParam("q", nil, godantic.Required[string]())
+		if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeRequired {
+			t.Errorf("expected required error, got %v", errs)
+		}
+		_, errs = godantic.ValidateParam("limit", nil, godantic.Default(500), godantic.Max(100))
+		if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeConstraint {
+			t.Errorf("expected the default to be validated, got %v", errs)
+		}
+		sort, errs := godantic.ValidateParam("sort", []string{""}, godantic.Default("name"))
+		if len(errs) > 0 || sort != "" {
+			t.Errorf("expected an empty value to be kept, got %q %v", sort, errs)
+		}
+	})
+
+	t.Run("errors match struct validation", func(t *testing.T) {
+		_, errs := godantic.ValidateParam[int]("id", []string{"abc"})
+		if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeCoercion || errs[0].Loc[0] != "id" {
+			t.Errorf("expected coercion error at id, got %v", errs)
+		}
+		_, errs = godantic.ValidateParam("id", []string{"0"}, godantic.Min(1))
+		if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeConstraint {
+			t.Errorf("expected constraint error, got %v", errs)
+		}
+	})
+
+	t.Run("unsupported types", func(t *testing.T) {
+		_, errs := godantic.ValidateParam[TUser]("user", []string{"x"})
+		if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeInternal {
+			t.Errorf("expected internal error, got %v", errs)
+		}
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		for _, values := range [][]string{{"abc"}, nil} {
+			_, errs := godantic.ValidateParam("q", values, godantic.Regex("[a-"))
+			if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeInternal || !strings.Contains(errs[0].Message, "invalid regex pattern") {
+				t.Errorf("%v: expected an internal error for the pattern, got %v", values, errs)
+			}
+		}
+	})
+
+	t.Run("coercion options", func(t *testing.T) {
+		checkbox := []godantic.ValidatorOption{godantic.WithBoolValues([]string{"on"}, []string{"off"})}
+		notify, errs := godantic.ValidateParamWith[bool]("notify", []string{"ON"}, checkbox)
+		if len(errs) > 0 || !notify {
+			t.Errorf("expected true, got %v %v", notify, errs)
+		}
+		_, errs = godantic.ValidateParamWith[bool]("notify", []string{"true"}, checkbox)
+		if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeCoercion {
+			t.Errorf("expected only the configured spellings, got %v", errs)
+		}
+
+		seconds := []godantic.ValidatorOption{godantic.WithDurationSeconds()}
+		timeout, errs := godantic.ValidateParamWith("timeout", []string{"90"}, seconds, godantic.DurationMax(2*time.Minute))
+		if len(errs) > 0 || timeout != 90*time.Second {
+			t.Errorf("expected 90s, got %v %v", timeout, errs)
+		}
+	})
+}
@@ -0,0 +1,91 @@
+package godantic_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════
+// MarshalAs Tests
+// ═══════════════════════════════════════════════════════════════════════════
+
+type TInvoiceLine struct {
+	Item   string  `json:"item"`
+	Cents  int     `json:"price"`
+	Secret *string `json:"secret"`
+}
+
+func (l *TInvoiceLine) FieldCents() godantic.FieldOptions[int] {
+	return godantic.Field(
+		godantic.Min(0),
+		godantic.MarshalAs(func(cents int) any {
+			return fmt.Sprintf("$%d.%02d", cents/100, cents%100)
+		}),
+	)
+}
+
+func (l *TInvoiceLine) FieldSecret() godantic.FieldOptions[string] {
+	return godantic.Field(
+		godantic.MinLen(4),
+		godantic.MarshalAs(func(s string) any { return "****" + s[len(s)-4:] }),
+	)
+}
+
+type TInvoice struct {
+	Lines []TInvoiceLine `json:"lines"`
+}
+
+func TestMarshalAs(t *testing.T) {
+	validator := godantic.NewValidator[TInvoiceLine]()
+	secret := "sk-123456"
+
+	t.Run("transforms the output", func(t *testing.T) {
+		data, errs := validator.Marshal(&TInvoiceLine{Item: "pen", Cents: 123, Secret: &secret})
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		want := `{"item":"pen","price":"$1.23","secret":"****3456"}`
+		if string(data) != want {
+			t.Errorf("got %s, want %s", data, want)
+		}
+	})
+
+	t.Run("nil pointer stays null", func(t *testing.T) {
+		data, errs := validator.Marshal(&TInvoiceLine{Item: "pen", Cents: 5})
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		want := `{"item":"pen","price":"$0.05","secret":null}`
+		if string(data) != want {
+			t.Errorf("got %s, want %s", data, want)
+		}
+	})
+
+	t.Run("validation uses the raw value", func(t *testing.T) {
+		_, errs := validator.Marshal(&TInvoiceLine{Item: "pen", Cents: -1})
+		if len(errs) != 1 || errs[0].Message != "value must be >= 0" {
+			t.Errorf("expected a minimum error, got %v", errs)
+		}
+	})
+
+	t.Run("nested in slices", func(t *testing.T) {
+		invoice := TInvoice{Lines: []TInvoiceLine{{Item: "pen", Cents: 250}, {Item: "ink", Cents: 1999}}}
+		data, errs := godantic.NewValidator[TInvoice]().Marshal(&invoice)
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		want := `{"lines":[{"item":"pen","price":"$2.50","secret":null},{"item":"ink","price":"$19.99","secret":null}]}`
+		if string(data) != want {
+			t.Errorf("got %s, want %s", data, want)
+		}
+	})
+
+	t.Run("unmarshal reads the raw value", func(t *testing.T) {
+		line, errs := validator.Unmarshal([]byte(`{"item":"pen","price":123}`))
+		if len(errs) != 0 || line.Cents != 123 {
+			t.Errorf("expected cents 123, got %+v, %v", line, errs)
+		}
+	})
+}
@@ -0,0 +1,240 @@
+package godantic
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/deepankarm/godantic/pkg/internal/reflectutil"
+)
+
+// marshalOptionTypes caches whether a type reaches any OmitEmpty or MarshalAs field
+var marshalOptionTypes sync.Map // map[reflect.Type]bool
+
+// applyMarshalOptions rewrites data, the JSON encoding of val, for Marshal: it
+// drops OmitEmpty fields holding their zero value and re-encodes MarshalAs
+// fields from their transformed value. time.Duration values are written as
+// duration strings such as "1m30s", the form their schema and Unmarshal use.
+// Key order and all other bytes are preserved, and data is returned untouched
+// when val's type has no such fields or durations anywhere.
+func applyMarshalOptions(val reflect.Value, data []byte) []byte {
+	if !hasMarshalOptions(val.Type()) {
+		return data
+	}
+	return marshalOptionsValue(val, data)
+}
+
+// hasMarshalOptions reports whether typ, or any type reachable from its fields,
+// has an OmitEmpty or MarshalAs field or is a time.Duration
+func hasMarshalOptions(typ reflect.Type) bool {
+	if cached, ok := marshalOptionTypes.Load(typ); ok {
+		return cached.(bool)
+	}
+	// Recursive types see false while in progress, which is correct for the cycle itself
+	marshalOptionTypes.Store(typ, false)
+
+	found := reflectutil.IsDuration(typ)
+	switch typ.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+		found = hasMarshalOptions(typ.Elem())
+	case reflect.Interface:
+		found = true // Concrete type only known at runtime
+	case reflect.Struct:
+		if reflectutil.IsBasicType(typ) {
+			break
+		}
+		for _, opts := range cachedScanner.ScanFieldOptions(typ) {
+			omit, _ := opts.Constraints[ConstraintOmitEmpty].(bool)
+			if _, as := opts.Constraints[ConstraintMarshalAs]; omit || as {
+				found = true
+				break
+			}
+		}
+		for i := 0; i < typ.NumField() && !found; i++ {
+			if typ.Field(i).IsExported() || typ.Field(i).Anonymous {
+				found = hasMarshalOptions(typ.Field(i).Type)
+			}
+		}
+	}
+
+	marshalOptionTypes.Store(typ, found)
+	return found
+}
+
+// marshalOptionsValue rewrites data for val, recursing into nested values
+func marshalOptionsValue(val reflect.Value, data []byte) []byte {
+	if bytes.Equal(data, []byte("null")) {
+		return data
+	}
+	if reflectutil.IsDuration(val.Type()) {
+		encoded, _ := json.Marshal(time.Duration(val.Int()).String())
+		return encoded
+	}
+	switch val.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if val.IsNil() {
+			return data
+		}
+		return marshalOptionsValue(val.Elem(), data)
+
+	case reflect.Struct:
+		if reflectutil.IsBasicType(val.Type()) || implementsMarshaler(val) {
+			return data
+		}
+		fields := make(map[string]marshalField)
+		collectJSONFields(val, fields)
+		return rewriteObject(data, func(key string, raw json.RawMessage) (json.RawMessage, bool) {
+			field, ok := fields[key]
+			if !ok {
+				return raw, true
+			}
+			if field.omit && field.value.IsZero() {
+				return nil, false
+			}
+			if field.as != nil {
+				return marshalAs(field, raw), true
+			}
+			return marshalOptionsValue(field.value, raw), true
+		})
+
+	case reflect.Slice, reflect.Array:
+		if val.Kind() == reflect.Slice && val.Type().Elem().Kind() == reflect.Uint8 {
+			return data // []byte is encoded as a base64 string
+		}
+		var elements []json.RawMessage
+		if err := json.Unmarshal(data, &elements); err != nil || len(elements) != val.Len() {
+			return data
+		}
+		for i := range elements {
+			elements[i] = marshalOptionsValue(val.Index(i), elements[i])
+		}
+		result, err := json.Marshal(elements)
+		if err != nil {
+			return data
+		}
+		return result
+
+	case reflect.Map:
+		if val.Type().Key().Kind() != reflect.String {
+			return data
+		}
+		return rewriteObject(data, func(key string, raw json.RawMessage) (json.RawMessage, bool) {
+			elem := val.MapIndex(reflect.ValueOf(key).Convert(val.Type().Key()))
+			if !elem.IsValid() {
+				return raw, true
+			}
+			return marshalOptionsValue(elem, raw), true
+		})
+	}
+	return data
+}
+
+// marshalField is a struct field seen by Marshal and its output options
+type marshalField struct {
+	value reflect.Value
+	omit  bool          // OmitEmpty
+	as    func(any) any // MarshalAs
+}
+
+// marshalAs encodes the transformed value of a MarshalAs field. Nil pointers
+// stay null, and a result that cannot be encoded keeps the field's raw encoding.
+func marshalAs(field marshalField, raw json.RawMessage) json.RawMessage {
+	if field.value.Kind() == reflect.Pointer && field.value.IsNil() {
+		return raw
+	}
+	data, err := json.Marshal(field.as(reflectutil.UnwrapValue(field.value).Interface()))
+	if err != nil {
+		return raw
+	}
+	return data
+}
+
+// collectJSONFields maps the JSON names of val's fields to their values and output
+// options, flattening embedded structs the way encoding/json does.
+func collectJSONFields(val reflect.Value, fields map[string]marshalField) {
+	typ := val.Type()
+	options := cachedScanner.ScanFieldOptions(typ)
+	for i := 0; i < typ.NumField(); i++ {
+		structField := typ.Field(i)
+		fieldVal := val.Field(i)
+
+		if structField.Anonymous && structField.Tag.Get("json") == "" &&
+			reflectutil.UnwrapPointer(structField.Type).Kind() == reflect.Struct {
+			fieldVal = reflectutil.UnwrapValue(fieldVal)
+			if fieldVal.Kind() == reflect.Struct {
+				collectJSONFields(fieldVal, fields)
+			}
+			continue
+		}
+		if !structField.IsExported() {
+			continue
+		}
+
+		jsonName := reflectutil.JSONFieldName(structField)
+		if jsonName == "-" {
+			continue
+		}
+		field := marshalField{value: fieldVal}
+		if opts, ok := options[structField.Name]; ok {
+			field.omit, _ = opts.Constraints[ConstraintOmitEmpty].(bool)
+			field.as, _ = opts.Constraints[ConstraintMarshalAs].(func(any) any)
+		}
+		fields[jsonName] = field
+	}
+}
+
+// implementsMarshaler reports whether val encodes itself, so its JSON shape is unknown
+func implementsMarshaler(val reflect.Value) bool {
+	marshalerType := reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	return val.Type().Implements(marshalerType) || reflect.PointerTo(val.Type()).Implements(marshalerType)
+}
+
+// rewriteObject re-encodes a JSON object member by member in its original order.
+// fn returns the new value for each member, or false to drop it.
+func rewriteObject(data []byte, fn func(key string, raw json.RawMessage) (json.RawMessage, bool)) []byte {
+	return rewriteMembers(data, func(key string, raw json.RawMessage) (string, json.RawMessage, bool) {
+		value, keep := fn(key, raw)
+		return key, value, keep
+	})
+}
+
+// rewriteMembers is rewriteObject with fn also choosing each member's key.
+func rewriteMembers(data []byte, fn func(key string, raw json.RawMessage) (string, json.RawMessage, bool)) []byte {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return data
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return data
+		}
+		key, _ := tok.(string)
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return data
+		}
+
+		key, value, keep := fn(key, raw)
+		if !keep {
+			continue
+		}
+		encodedKey, err := json.Marshal(key)
+		if err != nil {
+			return data
+		}
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		buf.Write(encodedKey)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes()
+}
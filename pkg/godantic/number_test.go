@@ -0,0 +1,137 @@
+package godantic_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════
+// WithUseNumber Tests
+// 9007199254740993 (2^53 + 1) is the smallest integer float64 can't represent.
+// ═══════════════════════════════════════════════════════════════════════════
+
+type TEvent struct {
+	ID      any            `json:"id"`
+	Payload map[string]any `json:"payload"`
+}
+
+type TLedgerEntry struct {
+	Amount json.Number `json:"amount"`
+	Seq    int64       `json:"seq"`
+}
+
+func (TLedgerEntry) FieldAmount() godantic.FieldOptions[json.Number] {
+	return godantic.Field(
+		godantic.Required[json.Number](),
+		godantic.Min(json.Number("9007199254740993")),
+		godantic.Max(json.Number("9007199254741000")),
+		godantic.MultipleOf(json.Number("3")),
+	)
+}
+
+func (TLedgerEntry) FieldSeq() godantic.FieldOptions[int64] {
+	return godantic.Field(godantic.Min[int64](9007199254740993))
+}
+
+type TFlexibleID struct {
+	Value any `json:"value"`
+}
+
+func (TFlexibleID) FieldValue() godantic.FieldOptions[any] {
+	return godantic.Field(godantic.Union[any]("integer", "string"))
+}
+
+func TestWithUseNumber(t *testing.T) {
+	data := []byte(`{"id": 9007199254740993, "payload": {"count": 42, "ratio": 0.5}}`)
+
+	t.Run("default decodes numbers as float64", func(t *testing.T) {
+		event, errs := godantic.NewValidator[TEvent]().Unmarshal(data)
+		if len(errs) > 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if _, ok := event.ID.(float64); !ok {
+			t.Errorf("expected float64, got %T", event.ID)
+		}
+	})
+
+	t.Run("preserves large integers in interface fields", func(t *testing.T) {
+		event, errs := godantic.NewValidator[TEvent](godantic.WithUseNumber()).Unmarshal(data)
+		if len(errs) > 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		id, ok := event.ID.(json.Number)
+		if !ok {
+			t.Fatalf("expected json.Number, got %T", event.ID)
+		}
+		if id.String() != "9007199254740993" {
+			t.Errorf("expected exact ID, got %s", id)
+		}
+		if count, _ := event.Payload["count"].(json.Number); count.String() != "42" {
+			t.Errorf("expected count 42, got %v", event.Payload["count"])
+		}
+		if ratio, _ := event.Payload["ratio"].(json.Number); ratio.String() != "0.5" {
+			t.Errorf("expected ratio 0.5, got %v", event.Payload["ratio"])
+		}
+	})
+
+	t.Run("root slice of any", func(t *testing.T) {
+		values, errs := godantic.NewValidator[[]any](godantic.WithUseNumber()).Unmarshal([]byte(`[9007199254740993, "x"]`))
+		if len(errs) > 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if n, _ := (*values)[0].(json.Number); n.String() != "9007199254740993" {
+			t.Errorf("expected exact value, got %v", (*values)[0])
+		}
+	})
+
+	t.Run("union type checks classify json.Number", func(t *testing.T) {
+		validator := godantic.NewValidator[TFlexibleID](godantic.WithUseNumber())
+		if _, errs := validator.Unmarshal([]byte(`{"value": 9007199254740993}`)); len(errs) > 0 {
+			t.Errorf("integer should match union, got %v", errs)
+		}
+		if _, errs := validator.Unmarshal([]byte(`{"value": 1.5}`)); len(errs) == 0 {
+			t.Error("expected non-integer number to fail integer|string union")
+		}
+	})
+}
+
+func TestJSONNumberConstraints(t *testing.T) {
+	validator := godantic.NewValidator[TLedgerEntry]()
+
+	tests := []struct {
+		name    string
+		json    string
+		wantErr bool
+	}{
+		{"within bounds and multiple", `{"amount": 9007199254740996}`, false},
+		{"below min by one", `{"amount": 9007199254740992}`, true},
+		{"above max", `{"amount": 9007199254741001}`, true},
+		{"not a multiple", `{"amount": 9007199254740994}`, true},
+		{"int64 below min by one", `{"amount": 9007199254740996, "seq": 9007199254740992}`, true},
+		{"int64 at min", `{"amount": 9007199254740996, "seq": 9007199254740993}`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, errs := validator.Unmarshal([]byte(tt.json))
+			if tt.wantErr && len(errs) == 0 {
+				t.Errorf("expected validation error, got %+v", entry)
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("unexpected errors: %v", errs)
+			}
+		})
+	}
+
+	t.Run("string map coercion", func(t *testing.T) {
+		entry, errs := validator.ValidateFromStringMap(map[string]string{"amount": "9007199254740996"})
+		if len(errs) > 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if entry.Amount != "9007199254740996" {
+			t.Errorf("expected exact amount, got %s", entry.Amount)
+		}
+	})
+}
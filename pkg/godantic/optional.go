@@ -0,0 +1,77 @@
+package godantic
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Optional holds a value of type T and whether it was present in the input,
+// to tell an absent field from one explicitly set to its zero value without
+// using a pointer. Decoding sets Set for any value except null; encoding
+// writes the value when Set and null otherwise. With `json:",omitzero"`
+// (Go 1.24+) an unset Optional is left out of the output.
+//
+// Field options are written for the wrapped type, and the field counts as
+// missing exactly when it is unset: Required rejects an absent field but
+// accepts an explicit zero, and constraints run only on set values. Defaults
+// are not applied to Optional fields. Generated schemas describe the field
+// as T and never mark it required on their own.
+//
+// Optional is meant for scalar and collection values; a nested struct field
+// declared as Optional is not walked, so use a pointer for those.
+//
+// Example:
+//
+//	type UpdateUser struct {
+//	    Age godantic.Optional[int] `json:"age,omitzero"`
+//	}
+//
+//	func (u *UpdateUser) FieldAge() godantic.FieldOptions[int] {
+//	    return godantic.Field(godantic.Min(0))
+//	}
+type Optional[T any] struct {
+	Value T
+	Set   bool
+}
+
+// Some returns an Optional holding value.
+func Some[T any](value T) Optional[T] {
+	return Optional[T]{Value: value, Set: true}
+}
+
+// Get returns the value and whether it was set.
+func (o Optional[T]) Get() (T, bool) {
+	return o.Value, o.Set
+}
+
+// OptionalValue returns the value and whether it was set, without knowing T.
+func (o Optional[T]) OptionalValue() (any, bool) {
+	return o.Value, o.Set
+}
+
+// IsZero reports whether o is unset, for `json:",omitzero"`.
+func (o Optional[T]) IsZero() bool {
+	return !o.Set
+}
+
+// MarshalJSON encodes the value, or null when o is unset.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.Set {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.Value)
+}
+
+// UnmarshalJSON decodes the value and marks o as set; null leaves it unset.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = Optional[T]{}
+		return nil
+	}
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*o = Optional[T]{Value: value, Set: true}
+	return nil
+}
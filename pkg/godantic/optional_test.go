@@ -0,0 +1,136 @@
+package godantic_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════
+// Optional[T]: absent vs explicit zero
+// ═══════════════════════════════════════════════════════════════════════════
+
+type TOptionalPatch struct {
+	Age  godantic.Optional[int]    `json:"age,omitzero"`
+	Name godantic.Optional[string] `json:"name,omitzero"`
+	Rank godantic.Optional[int]    `json:"rank,omitzero"`
+}
+
+func (p *TOptionalPatch) FieldAge() godantic.FieldOptions[int] {
+	return godantic.Field(godantic.Min(1))
+}
+
+func (p *TOptionalPatch) FieldName() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.MinLen(3))
+}
+
+func (p *TOptionalPatch) FieldRank() godantic.FieldOptions[int] {
+	return godantic.Field(godantic.Required[int]())
+}
+
+func TestOptional(t *testing.T) {
+	validator := godantic.NewValidator[TOptionalPatch]()
+
+	tests := []struct {
+		name     string
+		input    string
+		want     TOptionalPatch
+		wantLocs []string
+	}{
+		{
+			name:     "absent",
+			input:    `{}`,
+			wantLocs: []string{"Rank"},
+		},
+		{
+			name:     "null is absent",
+			input:    `{"age": null, "name": null, "rank": null}`,
+			wantLocs: []string{"Rank"},
+		},
+		{
+			name:     "present zero",
+			input:    `{"age": 0, "name": "", "rank": 0}`,
+			wantLocs: []string{"Age", "Name"},
+		},
+		{
+			name:  "present zero satisfies required",
+			input: `{"rank": 0}`,
+			want:  TOptionalPatch{Rank: godantic.Some(0)},
+		},
+		{
+			name:  "present non-zero",
+			input: `{"age": 30, "name": "Ada", "rank": 2}`,
+			want:  TOptionalPatch{Age: godantic.Some(30), Name: godantic.Some("Ada"), Rank: godantic.Some(2)},
+		},
+		{
+			name:     "present non-zero failing constraints",
+			input:    `{"age": -1, "name": "Al", "rank": 1}`,
+			wantLocs: []string{"Age", "Name"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, errs := validator.Unmarshal([]byte(tt.input))
+			var locs []string
+			for _, err := range errs {
+				locs = append(locs, err.Loc[0])
+			}
+			if len(locs) != len(tt.wantLocs) {
+				t.Fatalf("errors at %v, want %v (errs: %v)", locs, tt.wantLocs, errs)
+			}
+			for i := range locs {
+				if locs[i] != tt.wantLocs[i] {
+					t.Fatalf("errors at %v, want %v", locs, tt.wantLocs)
+				}
+			}
+			if len(errs) == 0 && *got != tt.want {
+				t.Errorf("got %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+
+	t.Run("compiled", func(t *testing.T) {
+		for _, tt := range tests {
+			assertCompiledUnmarshal[TOptionalPatch](t, tt.input)
+		}
+	})
+
+	t.Run("validate", func(t *testing.T) {
+		errs := validator.Validate(&TOptionalPatch{Rank: godantic.Some(0)})
+		if len(errs) != 0 {
+			t.Errorf("set zero should be valid, got %v", errs)
+		}
+		errs = validator.Validate(&TOptionalPatch{Age: godantic.Some(0), Rank: godantic.Some(1)})
+		if len(errs) != 1 || errs[0].Loc[0] != "Age" {
+			t.Errorf("set zero age should fail Min(1), got %v", errs)
+		}
+	})
+
+	t.Run("marshal", func(t *testing.T) {
+		data, err := json.Marshal(TOptionalPatch{Age: godantic.Some(0)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != `{"age":0}` {
+			t.Errorf("got %s, want {\"age\":0}", data)
+		}
+	})
+
+	t.Run("query params", func(t *testing.T) {
+		got, errs := validator.ValidateFromMultiValueMap(map[string][]string{"rank": {"0"}, "age": {"5"}})
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if got.Rank != godantic.Some(0) || got.Age != godantic.Some(5) || got.Name.Set {
+			t.Errorf("got %+v", *got)
+		}
+	})
+
+	t.Run("coverage", func(t *testing.T) {
+		if err := godantic.CheckCoverage[TOptionalPatch](); err != nil {
+			t.Errorf("FieldOptions of the wrapped type should be accepted: %v", err)
+		}
+	})
+}
@@ -2,6 +2,7 @@ package godantic
 
 import (
 	"reflect"
+	"slices"
 	"strings"
 
 	"github.com/deepankarm/godantic/pkg/internal/partialjson"
@@ -14,6 +15,22 @@ type PartialState struct {
 
 	// IncompleteFields lists fields that were truncated
 	IncompleteFields []IncompleteField
+
+	// TruncationReason says where the input was cut off, from the innermost
+	// value reached: "string" (mid-string), "value" (after a colon or inside a
+	// number or literal), "key", "array" or "object". Empty when complete.
+	TruncationReason string
+
+	// IncompletePaths are the JSON paths reported by the partial parser, with
+	// array indices as "[n]", e.g. [["items", "[2]", "name"]]. IncompleteFields
+	// may add paths found while decoding.
+	IncompletePaths [][]string
+
+	// PendingFields are the JSON paths of struct fields that haven't started
+	// arriving, e.g. "user.email" or "items[0].price". Such fields are left
+	// as decoded from nothing - nil for pointers, slices and maps - while those
+	// in IncompleteFields are in progress. Empty when complete.
+	PendingFields []string
 }
 
 // IncompleteField describes a single incomplete field.
@@ -60,6 +77,12 @@ func (ps *PartialState) WaitingFor() []string {
 	return result
 }
 
+// IsFieldPending reports whether a field hasn't started arriving yet.
+// Path should be JSON field names, e.g., ["user", "email"]
+func (ps *PartialState) IsFieldPending(path ...string) bool {
+	return slices.Contains(ps.PendingFields, partialjson.JoinPath(path))
+}
+
 // MergeIncompleteFields adds additional incomplete fields to the state.
 func (ps *PartialState) MergeIncompleteFields(paths [][]string, reason string) {
 	for _, path := range paths {
@@ -77,8 +100,13 @@ func (ps *PartialState) MergeIncompleteFields(paths [][]string, reason string) {
 // buildPartialStateFromPaths converts parser incomplete paths to PartialState.
 func buildPartialStateFromPaths(incompletePaths [][]string, truncatedAt string) *PartialState {
 	partialState := &PartialState{
-		IsComplete:       len(incompletePaths) == 0,
+		// Containers cut off between values leave no incomplete path, only TruncatedAt
+		IsComplete:       len(incompletePaths) == 0 && (truncatedAt == "" || truncatedAt == "complete"),
 		IncompleteFields: make([]IncompleteField, 0, len(incompletePaths)),
+		IncompletePaths:  incompletePaths,
+	}
+	if truncatedAt != "complete" {
+		partialState.TruncationReason = truncatedAt
 	}
 
 	// Use TruncatedAt from parser for root-level truncation
@@ -1,10 +1,15 @@
 package godantic
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"slices"
+	"strconv"
 
 	"github.com/deepankarm/godantic/pkg/internal/partialjson"
+	"github.com/deepankarm/godantic/pkg/internal/reflectutil"
+	"github.com/deepankarm/godantic/pkg/internal/walk"
 )
 
 // parsePartialJSON repairs and parses incomplete JSON.
@@ -37,35 +42,238 @@ func applyAfterValidateIfComplete[T any](obj *T, state *PartialState) Validation
 
 // unmarshalPartialCommon handles the common flow for partial JSON unmarshaling.
 // This is used by both regular structs and discriminated unions.
-func unmarshalPartialCommon[T any](objPtr reflect.Value, parseResult *partialjson.ParseResult) (*T, *PartialState, ValidationErrors) {
+// tag names the fields in the data ("" = json; see WithTagName).
+func unmarshalPartialCommon[T any](objPtr reflect.Value, parseResult *partialjson.ParseResult, tag string) (*T, *PartialState, ValidationErrors) {
+	partialState, errs, ok := decodePartial(objPtr, parseResult, false, tag)
+	if !ok {
+		return nil, partialState, errs
+	}
+
+	// Get the result
+	obj := objPtr.Elem().Interface().(T)
+
+	// Apply AfterValidate hook if complete
+	if hookErrs := applyAfterValidateIfComplete(&obj, partialState); hookErrs != nil {
+		return &obj, partialState, hookErrs
+	}
+
+	return &obj, partialState, errs
+}
+
+// decodePartial applies the BeforeValidate hook and walks repaired JSON into objPtr.
+// When reuse is true, values left over from a previous decode into objPtr are reset
+// first so that absent fields don't keep stale data. tag names the fields in the data.
+// Returns ok=false if the value could not be decoded (hook or JSON decode failure).
+func decodePartial(objPtr reflect.Value, parseResult *partialjson.ParseResult, reuse bool, tag string) (*PartialState, ValidationErrors, bool) {
+	// Build partial state from parser results
+	partialState := buildPartialStateFromPaths(parseResult.Incomplete, parseResult.TruncatedAt)
+
 	// Apply BeforeValidate hook
 	repairedData, hookErrs := applyBeforeValidateHook[[]byte](objPtr, parseResult.Repaired)
 	if hookErrs != nil {
-		partialState := buildPartialStateFromPaths(parseResult.Incomplete, parseResult.TruncatedAt)
-		return nil, partialState, hookErrs
+		return partialState, hookErrs, false
 	}
 
-	// Use walkParsePartial for partial JSON support
-	partialResult, errs := walkParsePartial(objPtr, repairedData)
+	tree := &jsonTree{data: repairedData}
+	if reuse {
+		if root, ok := tree.get(); ok {
+			resetStaleValues(objPtr.Elem(), root, tag)
+		}
+	}
 
-	// Build partial state from parser results
-	partialState := buildPartialStateFromPaths(parseResult.Incomplete, parseResult.TruncatedAt)
+	// Use walkParsePartial for partial JSON support
+	partialResult, errs := walkParsePartial(objPtr, repairedData, parseResult.Incomplete, tag)
+	if partialResult == nil {
+		return partialState, errs, false
+	}
 
 	// Merge any additional incomplete paths from walker
 	partialState.MergeIncompleteFields(partialResult.IncompletePaths, parseResult.TruncatedAt)
 
 	// Return nil on JSON decode errors
 	if errs.HasJSONDecodeError() {
-		return nil, partialState, errs
+		return partialState, errs, false
 	}
 
-	// Get the result
-	obj := objPtr.Elem().Interface().(T)
+	if !partialState.IsComplete {
+		if root, ok := tree.get(); ok {
+			partialState.PendingFields = pendingFields(reflectutil.UnwrapValue(objPtr.Elem()).Type(), root, nil, tag)
+		}
+	}
 
-	// Apply AfterValidate hook if complete
-	if hookErrs := applyAfterValidateIfComplete(&obj, partialState); hookErrs != nil {
-		return &obj, partialState, hookErrs
+	return partialState, errs, true
+}
+
+// jsonTree decodes repaired JSON once, on first use, for the passes that only
+// need its shape rather than typed values.
+type jsonTree struct {
+	data []byte
+	root any
+	ok   bool // data decoded
+	done bool // decoding attempted
+}
+
+// get returns the decoded JSON, or false if data is not valid JSON.
+func (t *jsonTree) get() (any, bool) {
+	if !t.done {
+		t.done = true
+		t.ok = json.Unmarshal(t.data, &t.root) == nil
 	}
+	return t.root, t.ok
+}
 
-	return &obj, partialState, errs
+// resetStaleValues prepares a previously populated value for decoding node, its
+// JSON decoded as any, in place. Fields absent from node (or explicitly null)
+// are zeroed so stale values don't survive the decode. Present fields keep
+// their allocations - slice capacity, map storage and pointer targets - so
+// json.Unmarshal can update them in place. Fields are looked up by their names
+// under tag ("" = json).
+func resetStaleValues(val reflect.Value, node any, tag string) {
+	if node == nil {
+		if val.CanSet() {
+			val.SetZero()
+		}
+		return
+	}
+
+	switch val.Kind() {
+	case reflect.Pointer:
+		if !val.IsNil() {
+			resetStaleValues(val.Elem(), node, tag)
+		}
+
+	case reflect.Struct:
+		if reflectutil.IsBasicType(val.Type()) {
+			return
+		}
+		if fields, ok := node.(map[string]any); ok {
+			resetStaleFields(val, fields, tag)
+		} // Otherwise let the decoder report the error
+
+	case reflect.Slice:
+		elements, ok := node.([]any)
+		if !ok {
+			return
+		}
+		// json.Unmarshal decodes into existing elements within capacity,
+		// so zero everything past the new length before it grows into it.
+		keep := min(val.Len(), len(elements))
+		full := val.Slice3(0, val.Cap(), val.Cap())
+		for i := keep; i < full.Len(); i++ {
+			full.Index(i).SetZero()
+		}
+		for i := range keep {
+			resetStaleValues(val.Index(i), elements[i], tag)
+		}
+
+	case reflect.Map:
+		keys, ok := node.(map[string]any)
+		if val.IsNil() || !ok {
+			return
+		}
+		if val.Type().Key().Kind() != reflect.String {
+			val.Clear()
+			return
+		}
+		for _, key := range val.MapKeys() {
+			if _, ok := keys[key.String()]; !ok {
+				val.SetMapIndex(key, reflect.Value{})
+			}
+		}
+	}
+}
+
+// resetStaleFields zeroes struct fields missing from fields and recurses into present ones.
+// Embedded structs share the parent's JSON object, matching the walker's traversal.
+func resetStaleFields(val reflect.Value, fields map[string]any, tag string) {
+	t := val.Type()
+	for i := range t.NumField() {
+		structField := t.Field(i)
+		if !structField.IsExported() && !structField.Anonymous {
+			continue
+		}
+
+		fieldVal := val.Field(i)
+		if structField.Anonymous && reflectutil.UnwrapPointer(structField.Type).Kind() == reflect.Struct {
+			fieldVal = reflectutil.UnwrapValue(fieldVal)
+			if fieldVal.Kind() == reflect.Struct {
+				resetStaleFields(fieldVal, fields, tag)
+			}
+			continue
+		}
+
+		jsonName, node, _ := lookupTaggedField(fields, structField, tag)
+		if jsonName == "-" || !fieldVal.CanSet() {
+			continue
+		}
+		resetStaleValues(fieldVal, node, tag)
+	}
+}
+
+// pendingFields lists the JSON paths of struct fields under typ that are absent
+// from node, its JSON decoded as any, descending into present structs and the
+// elements of present slices. Fields are named by tag ("" = json).
+func pendingFields(typ reflect.Type, node any, path []string, tag string) []string {
+	if node == nil {
+		return nil // An explicit null has arrived in full
+	}
+	typ = reflectutil.UnwrapPointer(typ)
+	switch typ.Kind() {
+	case reflect.Struct:
+		if reflectutil.IsBasicType(typ) {
+			return nil
+		}
+		if fields, ok := node.(map[string]any); ok {
+			return pendingStructFields(typ, fields, path, tag)
+		}
+
+	case reflect.Slice, reflect.Array:
+		elements, _ := node.([]any)
+		var pending []string
+		for i, element := range elements {
+			elemPath := append(slices.Clone(path), "["+strconv.Itoa(i)+"]")
+			pending = append(pending, pendingFields(typ.Elem(), element, elemPath, tag)...)
+		}
+		return pending
+	}
+	return nil
+}
+
+// pendingStructFields is pendingFields for the fields of a struct type.
+// Embedded structs share the parent's JSON object, as in resetStaleFields.
+func pendingStructFields(typ reflect.Type, fields map[string]any, path []string, tag string) []string {
+	var pending []string
+	for i := range typ.NumField() {
+		structField := typ.Field(i)
+		if structField.Anonymous && reflectutil.UnwrapPointer(structField.Type).Kind() == reflect.Struct {
+			pending = append(pending, pendingStructFields(reflectutil.UnwrapPointer(structField.Type), fields, path, tag)...)
+			continue
+		}
+		jsonName, node, present := lookupTaggedField(fields, structField, tag)
+		if !structField.IsExported() || jsonName == "-" {
+			continue
+		}
+
+		fieldPath := append(slices.Clone(path), jsonName)
+		if !present {
+			pending = append(pending, partialjson.JoinPath(fieldPath))
+			continue
+		}
+		pending = append(pending, pendingFields(structField.Type, node, fieldPath, tag)...)
+	}
+	return pending
+}
+
+// lookupTaggedField returns the name of structField under tag ("" = json) and
+// its value in fields, matched like the walker does (see walk.Walker.TagName),
+// and whether it is present.
+func lookupTaggedField[V any](fields map[string]V, structField reflect.StructField, tag string) (string, V, bool) {
+	if tag == "" {
+		jsonName := reflectutil.JSONFieldName(structField)
+		value, ok := walk.LookupField(fields, jsonName, structField.Name)
+		return jsonName, value, ok
+	}
+	name := reflectutil.TagFieldName(structField, tag)
+	value, ok := walk.LookupField(fields, name, name)
+	return name, value, ok
 }
@@ -0,0 +1,75 @@
+package godantic
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+)
+
+// presetRegistry holds the option lists expanded by Preset
+var presetRegistry = struct {
+	sync.RWMutex
+	presets map[string]preset
+}{presets: make(map[string]preset)}
+
+// preset is a registered option list and the field type it applies to
+type preset struct {
+	typ  reflect.Type
+	opts any // []func(FieldOptions[T]) FieldOptions[T]
+}
+
+// RegisterPreset registers a named list of field options, so a policy used
+// across many fields (an email capped at 100 characters, a positive amount) is
+// defined once and changed in one place. It replaces any preset already
+// registered for name; no options removes it.
+//
+// Presets expand when the Field methods are scanned, so register them at
+// startup, before creating validators or generating schemas. RegisterPreset is
+// safe for concurrent use.
+//
+// Example:
+//
+//	godantic.RegisterPreset("email100", godantic.Email(), godantic.MaxLen(100))
+//
+//	func (u *User) FieldEmail() godantic.FieldOptions[string] {
+//	    return godantic.Field(godantic.Required[string](), godantic.Preset[string]("email100"))
+//	}
+func RegisterPreset[T any](name string, opts ...func(FieldOptions[T]) FieldOptions[T]) {
+	presetRegistry.Lock()
+	defer presetRegistry.Unlock()
+	if len(opts) == 0 {
+		delete(presetRegistry.presets, name)
+		return
+	}
+	presetRegistry.presets[name] = preset{typ: reflect.TypeFor[T](), opts: slices.Clone(opts)}
+}
+
+// Preset applies the options registered under name with RegisterPreset. An
+// unknown name, or a preset registered for another field type, fails
+// validation and is reported by Validator.Err.
+func Preset[T any](name string) func(FieldOptions[T]) FieldOptions[T] {
+	return func(fo FieldOptions[T]) FieldOptions[T] {
+		presetRegistry.RLock()
+		registered, ok := presetRegistry.presets[name]
+		presetRegistry.RUnlock()
+
+		var presetErr error
+		opts, typed := registered.opts.([]func(FieldOptions[T]) FieldOptions[T])
+		switch {
+		case !ok:
+			presetErr = fmt.Errorf("unknown preset %q", name)
+		case !typed:
+			presetErr = fmt.Errorf("preset %q is for %s fields, not %s", name, registered.typ, reflect.TypeFor[T]())
+		}
+		if presetErr != nil {
+			fo.Errors_ = append(fo.Errors_, presetErr)
+			return fo.validateWith(func(T) error { return presetErr })
+		}
+
+		for _, opt := range opts {
+			fo = opt(fo)
+		}
+		return fo
+	}
+}
@@ -0,0 +1,92 @@
+package godantic_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════
+// Constraint Preset Tests
+// ═══════════════════════════════════════════════════════════════════════════
+
+type TPresetContact struct {
+	Email  string `json:"email"`
+	Backup string `json:"backup"`
+}
+
+func (c *TPresetContact) FieldEmail() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Required[string](), godantic.Preset[string]("test-email20"))
+}
+
+func (c *TPresetContact) FieldBackup() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Preset[string]("test-email20"))
+}
+
+func TestPreset(t *testing.T) {
+	godantic.RegisterPreset("test-email20", godantic.Email(), godantic.MaxLen(20))
+	t.Cleanup(func() { godantic.RegisterPreset[string]("test-email20") })
+
+	validator := godantic.NewValidator[TPresetContact]()
+	if err := validator.Err(); err != nil {
+		t.Fatalf("unexpected construction error: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		contact TPresetContact
+		wantLoc string // "" means valid
+		wantMsg string
+	}{
+		{"valid", TPresetContact{Email: "a@example.com", Backup: "b@example.com"}, "", ""},
+		{"email applies", TPresetContact{Email: "nope"}, "Email", "value does not match pattern"},
+		{"max length applies", TPresetContact{Email: "someone@a-long-domain.example.com"}, "Email", "length must be <= 20"},
+		{"shared by other fields", TPresetContact{Email: "a@example.com", Backup: "nope"}, "Backup", "value does not match pattern"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.Validate(&tt.contact)
+			if tt.wantLoc == "" {
+				if len(errs) != 0 {
+					t.Errorf("expected no errors, got %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || errs[0].Loc[0] != tt.wantLoc || !strings.HasPrefix(errs[0].Message, tt.wantMsg) {
+				t.Errorf("expected one %s error %q, got %v", tt.wantLoc, tt.wantMsg, errs)
+			}
+		})
+	}
+
+	t.Run("constraints are recorded for the schema", func(t *testing.T) {
+		fo := godantic.Field(godantic.Preset[string]("test-email20"))
+		if fo.Constraints_[godantic.ConstraintPattern] == nil || fo.Constraints_[godantic.ConstraintMaxLength] != 20 {
+			t.Errorf("expected pattern and maxLength constraints, got %v", fo.Constraints_)
+		}
+	})
+}
+
+func TestPreset_Errors(t *testing.T) {
+	godantic.RegisterPreset("test-positive", godantic.Min(1))
+	t.Cleanup(func() { godantic.RegisterPreset[int]("test-positive") })
+
+	tests := []struct {
+		name    string
+		fo      godantic.FieldOptions[string]
+		wantErr string
+	}{
+		{"unknown preset", godantic.Field(godantic.Preset[string]("test-missing")), `unknown preset "test-missing"`},
+		{"wrong field type", godantic.Field(godantic.Preset[string]("test-positive")), `preset "test-positive" is for int fields, not string`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.fo.Errors_) != 1 || tt.fo.Errors_[0].Error() != tt.wantErr {
+				t.Errorf("expected construction error %q, got %v", tt.wantErr, tt.fo.Errors_)
+			}
+			if len(tt.fo.Validators_) != 1 || tt.fo.Validators_[0]("x") == nil {
+				t.Error("expected the field to fail validation")
+			}
+		})
+	}
+}
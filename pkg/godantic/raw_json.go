@@ -0,0 +1,41 @@
+package godantic
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/deepankarm/godantic/pkg/internal/reflectutil"
+)
+
+var rawMessageType = reflect.TypeOf(json.RawMessage(nil))
+
+// rawJSONFields returns the Go names of the json.RawMessage fields of t,
+// including promoted ones. These hold opaque JSON, such as a plugin payload
+// decoded later, and have an open schema ({}).
+func rawJSONFields(t reflect.Type) []string {
+	var names []string
+	for _, field := range reflect.VisibleFields(t) {
+		if !field.IsExported() || reflectutil.JSONFieldName(field) == "-" {
+			continue
+		}
+		if reflectutil.UnwrapPointer(field.Type) == rawMessageType {
+			names = append(names, field.Name)
+		}
+	}
+	return names
+}
+
+// validRawJSON rejects a json.RawMessage that is not valid JSON. Unmarshal
+// keeps the bytes as sent, so this only fails for values built in Go.
+func validRawJSON(val any) error {
+	raw, ok := val.(json.RawMessage)
+	if !ok || len(raw) == 0 {
+		return nil
+	}
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return fmt.Errorf("invalid JSON: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package godantic_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════
+// json.RawMessage fields
+// ═══════════════════════════════════════════════════════════════════════════
+
+type TPluginCall struct {
+	Plugin  string           `json:"plugin"`
+	Payload json.RawMessage  `json:"payload"`
+	Options *json.RawMessage `json:"options"`
+}
+
+func (c *TPluginCall) FieldPayload() godantic.FieldOptions[json.RawMessage] {
+	return godantic.Field(godantic.Required[json.RawMessage]())
+}
+
+func TestRawMessageFields(t *testing.T) {
+	validator := godantic.NewValidator[TPluginCall]()
+	compiled := validator.Compile()
+
+	t.Run("preserves a raw object", func(t *testing.T) {
+		payload := `{"b": [1, 2],  "a": {"nested": true}}`
+		got, errs := validator.Unmarshal([]byte(`{"plugin": "p", "payload": ` + payload + `}`))
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if string(got.Payload) != payload {
+			t.Errorf("expected the bytes as sent, got %s", got.Payload)
+		}
+	})
+
+	t.Run("preserves a raw array", func(t *testing.T) {
+		data := []byte(`{"plugin": "p", "payload": [1, "two", null], "options": [ ]}`)
+		for name, unmarshal := range map[string]func([]byte) (*TPluginCall, godantic.ValidationErrors){
+			"validator": validator.Unmarshal,
+			"compiled":  compiled.Unmarshal,
+		} {
+			got, errs := unmarshal(data)
+			if len(errs) != 0 {
+				t.Fatalf("%s: unexpected errors: %v", name, errs)
+			}
+			if string(got.Payload) != `[1, "two", null]` {
+				t.Errorf("%s: expected the bytes as sent, got %s", name, got.Payload)
+			}
+			if got.Options == nil || string(*got.Options) != `[ ]` {
+				t.Errorf("%s: expected options to be preserved, got %v", name, got.Options)
+			}
+		}
+	})
+
+	t.Run("required raw field", func(t *testing.T) {
+		_, errs := validator.Unmarshal([]byte(`{"plugin": "p"}`))
+		if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeRequired {
+			t.Errorf("expected a required error for payload, got %v", errs)
+		}
+	})
+
+	t.Run("rejects invalid JSON", func(t *testing.T) {
+		invalid := json.RawMessage(`{"unterminated": `)
+		obj := &TPluginCall{Plugin: "p", Payload: json.RawMessage(`{}`), Options: &invalid}
+		if errs := validator.Validate(obj); len(errs) != 1 || errs[0].Loc[0] != "Options" {
+			t.Errorf("expected an error for options, got %v", errs)
+		}
+
+		if errs := validator.Validate(&TPluginCall{Payload: json.RawMessage(`nope`)}); len(errs) != 1 {
+			t.Errorf("expected an error for payload, got %v", errs)
+		}
+	})
+
+	t.Run("rejects invalid JSON in the body", func(t *testing.T) {
+		if _, errs := validator.Unmarshal([]byte(`{"plugin": "p", "payload": {"a": }`)); len(errs) == 0 {
+			t.Error("expected malformed JSON to be rejected")
+		}
+	})
+}
@@ -1,6 +1,7 @@
 package godantic_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/deepankarm/godantic/pkg/godantic"
@@ -142,3 +143,72 @@ func TestRecursiveMutualReference(t *testing.T) {
 		t.Error("Expected validation error for short name")
 	}
 }
+
+// NestedThreadRecursive nests through map values and an interface field
+type NestedThreadRecursive struct {
+	Text    string                            `json:"text"`
+	Replies map[string]*NestedThreadRecursive `json:"replies,omitempty"`
+	Quoted  any                               `json:"quoted,omitempty"`
+}
+
+func (n *NestedThreadRecursive) FieldText() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Required[string]())
+}
+
+// nestedThreadJSON returns a thread of replies nested depth levels deep
+func nestedThreadJSON(depth int) string {
+	return strings.Repeat(`{"text": "x", "replies": {"r": `, depth) + `{"text": "x"}` + strings.Repeat(`}}`, depth)
+}
+
+func TestWithMaxDepth(t *testing.T) {
+	validator := godantic.NewValidator[NestedThreadRecursive](godantic.WithMaxDepth(10))
+
+	t.Run("within the limit", func(t *testing.T) {
+		// 5 levels of replies end at Loc Replies.r.Replies.r... of length 10
+		if _, errs := validator.Unmarshal([]byte(nestedThreadJSON(5))); len(errs) != 0 {
+			t.Errorf("unexpected errors: %v", errs)
+		}
+	})
+
+	t.Run("unmarshal beyond the limit", func(t *testing.T) {
+		_, errs := validator.Unmarshal([]byte(nestedThreadJSON(500)))
+		if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeMaxDepth {
+			t.Fatalf("expected a max_depth error, got %v", errs)
+		}
+		if len(errs[0].Loc) != 12 || errs[0].Message != "nesting exceeds the maximum depth of 10" {
+			t.Errorf("expected the error at the first struct past the limit, got %v", errs[0])
+		}
+	})
+
+	t.Run("errors before the limit are kept", func(t *testing.T) {
+		_, errs := validator.Unmarshal([]byte(`{"replies": {"r": ` + nestedThreadJSON(20) + `}}`))
+		if len(errs) != 2 || errs[0].Type != godantic.ErrorTypeRequired || errs[1].Type != godantic.ErrorTypeMaxDepth {
+			t.Errorf("expected a required error then max_depth, got %v", errs)
+		}
+	})
+
+	t.Run("validate a self-referential value", func(t *testing.T) {
+		root := &NestedThreadRecursive{Text: "root"}
+		node := root
+		for range 100 {
+			next := &NestedThreadRecursive{Text: "reply"}
+			node.Replies = map[string]*NestedThreadRecursive{"r": next}
+			node.Quoted = root // A cycle through an interface field
+			node = next
+		}
+		errs := validator.Validate(root)
+		if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeMaxDepth {
+			t.Fatalf("expected a max_depth error, got %v", errs)
+		}
+	})
+
+	t.Run("compiled", func(t *testing.T) {
+		assertCompiledUnmarshalWith(t, validator, nestedThreadJSON(5), nestedThreadJSON(50))
+	})
+
+	t.Run("no limit by default", func(t *testing.T) {
+		if _, errs := godantic.NewValidator[NestedThreadRecursive]().Unmarshal([]byte(nestedThreadJSON(200))); len(errs) != 0 {
+			t.Errorf("unexpected errors: %v", errs)
+		}
+	})
+}
@@ -1,7 +1,13 @@
 package godantic
 
 import (
+	"context"
+	"fmt"
+	"maps"
+	"math"
 	"reflect"
+	"slices"
+	"strings"
 
 	"github.com/deepankarm/godantic/pkg/internal/reflectutil"
 )
@@ -83,6 +89,113 @@ func (fs *fieldScanner) scanFieldOptionsFromType(typ reflect.Type) map[string]*f
 	return fieldOptions
 }
 
+// collectOptionErrors returns field option construction errors for typ and the
+// struct types reachable from its fields, prefixed with the field path.
+func (fs *fieldScanner) collectOptionErrors(typ reflect.Type, tag, prefix string, visited map[reflect.Type]bool) []error {
+	typ = reflectutil.UnwrapPointer(typ)
+	for typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array || typ.Kind() == reflect.Map {
+		typ = reflectutil.UnwrapPointer(typ.Elem())
+	}
+	if typ.Kind() != reflect.Struct || visited[typ] {
+		return nil
+	}
+	visited[typ] = true
+
+	var errs []error
+	for _, err := range dependentRuleErrors(typ, tag) {
+		errs = append(errs, fmt.Errorf("%s%w", prefix, err))
+	}
+	errs = append(errs, extensionKeyErrors(typ, prefix)...)
+	options := fs.scanFieldOptionsFromType(typ)
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		path := prefix + field.Name
+		if holder, ok := options[field.Name]; ok {
+			for _, err := range holder.errs {
+				errs = append(errs, fmt.Errorf("%s: %w", path, err))
+			}
+			for _, err := range boundRangeErrors(holder.constraints, field.Type) {
+				errs = append(errs, fmt.Errorf("%s: %w", path, err))
+			}
+		}
+		errs = append(errs, fs.collectOptionErrors(field.Type, tag, path+".", visited)...)
+	}
+	return errs
+}
+
+// extensionKeyErrors reports SchemaExtensions keys of typ that are not "x-"
+// vendor extensions, which the schema leaves out
+func extensionKeyErrors(typ reflect.Type, prefix string) []error {
+	e, ok := reflect.New(typ).Interface().(interface{ SchemaExtensions() map[string]any })
+	if !ok {
+		return nil
+	}
+	var errs []error
+	for _, key := range slices.Sorted(maps.Keys(e.SchemaExtensions())) {
+		if !strings.HasPrefix(key, "x-") {
+			errs = append(errs, fmt.Errorf(`%sSchemaExtensions: extension key %q must start with "x-"`, prefix, key))
+		}
+	}
+	return errs
+}
+
+// boundConstraints are the numeric bounds checked against the field's type
+var boundConstraints = []string{ConstraintMinimum, ConstraintMaximum, ConstraintExclusiveMinimum, ConstraintExclusiveMaximum}
+
+// boundRangeErrors reports numeric bounds the field's type cannot hold. Field
+// options written for a wider type than the field (FieldOptions[int] on a uint8
+// field) compile, but a bound like Max(300) could then never fail.
+func boundRangeErrors(constraints map[string]any, fieldType reflect.Type) []error {
+	fieldType = reflectutil.UnwrapPointer(fieldType)
+	var errs []error
+	for _, key := range boundConstraints {
+		bound, ok := constraints[key]
+		if !ok {
+			continue
+		}
+		if !fitsNumericType(reflect.ValueOf(bound), fieldType) {
+			errs = append(errs, fmt.Errorf("%s %v out of range for %s", key, bound, fieldType))
+		}
+	}
+	return errs
+}
+
+// fitsNumericType reports whether a numeric value fits in typ. Non-numeric
+// values and types are not checked.
+func fitsNumericType(v reflect.Value, typ reflect.Type) bool {
+	target := reflect.Zero(typ)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n := v.Int()
+		switch typ.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return !target.OverflowInt(n)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return n >= 0 && !target.OverflowUint(uint64(n))
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n := v.Uint()
+		switch typ.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return n <= math.MaxInt64 && !target.OverflowInt(int64(n))
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return !target.OverflowUint(n)
+		}
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		switch typ.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			limit := math.Ldexp(1, typ.Bits()-1)
+			return f >= -limit && f < limit
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return f >= 0 && f < math.Ldexp(1, typ.Bits())
+		case reflect.Float32:
+			return !target.OverflowFloat(f)
+		}
+	}
+	return true
+}
+
 // extractFieldOptions extracts validation info from FieldOptions[T] using reflection
 func (fs *fieldScanner) extractFieldOptions(optsValue reflect.Value) *fieldOptionHolder {
 	holder := &fieldOptionHolder{
@@ -91,6 +204,13 @@ func (fs *fieldScanner) extractFieldOptions(optsValue reflect.Value) *fieldOptio
 		constraints: make(map[string]any),
 	}
 
+	// Extract construction errors
+	if errsField := optsValue.FieldByName("Errors_"); errsField.IsValid() {
+		for j := 0; j < errsField.Len(); j++ {
+			holder.errs = append(holder.errs, errsField.Index(j).Interface().(error))
+		}
+	}
+
 	// Extract constraints map
 	constraintsField := optsValue.FieldByName("Constraints_")
 	if constraintsField.IsValid() && !constraintsField.IsNil() {
@@ -103,6 +223,21 @@ func (fs *fieldScanner) extractFieldOptions(optsValue reflect.Value) *fieldOptio
 		}
 	}
 
+	if erased, ok := optsValue.Interface().(interface {
+		erasedSiblingValidators() []func(any, map[string]any) error
+	}); ok {
+		holder.siblingValidators = erased.erasedSiblingValidators()
+	}
+
+	// Extract validators. FieldOptions[T] wraps them without reflection; the
+	// reflect.Value.Call fallback covers values that don't expose that method.
+	if erased, ok := optsValue.Interface().(interface {
+		erasedValidators() ([]func(any) error, []func(context.Context, any) error)
+	}); ok {
+		holder.validators, holder.ctxValidators = erased.erasedValidators()
+		return holder
+	}
+
 	// Extract validators using reflection
 	validatorsField := optsValue.FieldByName("Validators_")
 	if validatorsField.IsValid() && validatorsField.Len() > 0 {
@@ -120,6 +255,21 @@ func (fs *fieldScanner) extractFieldOptions(optsValue reflect.Value) *fieldOptio
 		}
 	}
 
+	// Extract context-aware validators
+	ctxValidatorsField := optsValue.FieldByName("ContextValidators_")
+	if ctxValidatorsField.IsValid() && ctxValidatorsField.Len() > 0 {
+		for j := 0; j < ctxValidatorsField.Len(); j++ {
+			validatorFunc := ctxValidatorsField.Index(j)
+			holder.ctxValidators = append(holder.ctxValidators, func(ctx context.Context, val any) error {
+				results := validatorFunc.Call([]reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(val)})
+				if len(results) > 0 && !results[0].IsNil() {
+					return results[0].Interface().(error)
+				}
+				return nil
+			})
+		}
+	}
+
 	return holder
 }
 
@@ -141,3 +141,54 @@ func TestRegexValidation(t *testing.T) {
 		}
 	})
 }
+
+// Invalid patterns must not panic when the Field method runs
+type TBadPattern struct {
+	Code    string
+	Nested  TBadPatternNested
+	Entries []TBadPatternNested
+}
+
+type TBadPatternNested struct {
+	Tag string
+}
+
+func (b *TBadPattern) FieldCode() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Required[string](), godantic.Regex(`^[A-Z{2}$`))
+}
+
+func (n *TBadPatternNested) FieldTag() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Regex(`(unclosed`))
+}
+
+func TestRegexInvalidPattern(t *testing.T) {
+	validator := godantic.NewValidator[TBadPattern]()
+
+	t.Run("Err reports every invalid pattern", func(t *testing.T) {
+		err := validator.Err()
+		if err == nil {
+			t.Fatal("expected a construction error")
+		}
+		for _, want := range []string{`Code: invalid regex pattern "^[A-Z{2}$"`, `Nested.Tag: invalid regex pattern "(unclosed"`} {
+			if !strings.Contains(err.Error(), want) {
+				t.Errorf("expected %q in %v", want, err)
+			}
+		}
+	})
+
+	t.Run("validation fails instead of panicking", func(t *testing.T) {
+		errs := validator.Validate(&TBadPattern{Code: "AB"})
+		if len(errs) == 0 {
+			t.Fatal("expected validation errors")
+		}
+		if errs[0].Loc[0] != "Code" || !strings.Contains(errs[0].Message, "invalid regex pattern") {
+			t.Errorf("unexpected error: %v", errs[0])
+		}
+	})
+
+	t.Run("valid definitions report no error", func(t *testing.T) {
+		if err := godantic.NewValidator[UserProfile]().Err(); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,86 @@
+package schema_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+	"github.com/deepankarm/godantic/pkg/godantic/schema"
+)
+
+type Pixel struct {
+	RGB [3]int `json:"rgb"`
+}
+
+func (p *Pixel) FieldRGB() godantic.FieldOptions[[3]int] {
+	return godantic.Field(
+		godantic.Required[[3]int](),
+		godantic.Each[[3]int](godantic.Min(0), godantic.Max(255)),
+	)
+}
+
+func TestFixedSizeArraySchema(t *testing.T) {
+	s, err := schema.NewGenerator[Pixel]().Generate()
+	if err != nil {
+		t.Fatalf("failed to generate schema: %v", err)
+	}
+
+	prop, ok := s.Definitions["Pixel"].Properties.Get("rgb")
+	if !ok {
+		t.Fatal("rgb property not found")
+	}
+	if prop.Type != "array" {
+		t.Errorf("expected type array, got %q", prop.Type)
+	}
+	if prop.MinItems == nil || *prop.MinItems != 3 || prop.MaxItems == nil || *prop.MaxItems != 3 {
+		t.Errorf("expected minItems = maxItems = 3, got %v and %v", prop.MinItems, prop.MaxItems)
+	}
+	if prop.Items == nil || prop.Items.Type != "integer" {
+		t.Fatalf("expected integer items, got %+v", prop.Items)
+	}
+	if prop.Items.Minimum != "0" || prop.Items.Maximum != "255" {
+		t.Errorf("expected items bounded to [0, 255], got [%s, %s]", prop.Items.Minimum, prop.Items.Maximum)
+	}
+}
+
+type TaggedPost struct {
+	Tags []string `json:"tags"`
+}
+
+func (p *TaggedPost) FieldTags() godantic.FieldOptions[[]string] {
+	return godantic.Field(
+		godantic.MinItems[string](1),
+		godantic.MaxItems[string](5),
+		godantic.UniqueItems[string](),
+	)
+}
+
+func TestSliceConstraintsSchema(t *testing.T) {
+	gen := schema.NewGenerator[TaggedPost]()
+
+	t.Run("flattened", func(t *testing.T) {
+		s, err := gen.GenerateFlattened()
+		if err != nil {
+			t.Fatalf("failed to generate schema: %v", err)
+		}
+		tags := s["properties"].(map[string]any)["tags"].(map[string]any)
+		if tags["minItems"] != 1.0 || tags["maxItems"] != 5.0 || tags["uniqueItems"] != true {
+			t.Errorf("expected minItems 1, maxItems 5 and uniqueItems, got %v", tags)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		out, err := gen.GenerateJSON()
+		if err != nil {
+			t.Fatalf("failed to generate schema: %v", err)
+		}
+		var s map[string]any
+		if err := json.Unmarshal([]byte(out), &s); err != nil {
+			t.Fatalf("invalid schema JSON: %v", err)
+		}
+		tags := s["$defs"].(map[string]any)["TaggedPost"].(map[string]any)["properties"].(map[string]any)["tags"].(map[string]any)
+		if tags["minItems"] != 1.0 || tags["maxItems"] != 5.0 || tags["uniqueItems"] != true {
+			t.Errorf("expected minItems 1, maxItems 5 and uniqueItems, got %v", tags)
+		}
+	})
+}
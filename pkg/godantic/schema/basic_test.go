@@ -2,6 +2,7 @@ package schema_test
 
 import (
 	"encoding/json"
+	"reflect"
 	"slices"
 	"strings"
 	"testing"
@@ -256,6 +257,80 @@ func TestGenerateFlattened(t *testing.T) {
 	})
 }
 
+func TestGenerateFlattened_Options(t *testing.T) {
+	t.Run("default inlines the root", func(t *testing.T) {
+		flat, err := schema.NewGenerator[SchemaCompany]().GenerateFlattened(schema.DefaultFlattenOptions())
+		if err != nil {
+			t.Fatalf("failed to generate flattened schema: %v", err)
+		}
+		if _, hasRef := flat["$ref"]; hasRef || flat["type"] != "object" {
+			t.Errorf("expected an inlined root, got %v", flat)
+		}
+		if _, hasTitle := flat["title"]; hasTitle {
+			t.Errorf("expected no root title by default, got %v", flat["title"])
+		}
+	})
+
+	t.Run("inlined root with a custom title", func(t *testing.T) {
+		flat, err := schema.NewGenerator[SchemaCompany]().GenerateFlattened(schema.FlattenOptions{RootName: "Company"})
+		if err != nil {
+			t.Fatalf("failed to generate flattened schema: %v", err)
+		}
+		if flat["title"] != "Company" || flat["type"] != "object" {
+			t.Errorf("expected an inlined root titled Company, got %v", flat)
+		}
+		defs := flat["$defs"].(map[string]any)
+		if _, ok := defs["SchemaAddress"]; !ok || len(defs) != 1 {
+			t.Errorf("expected only the nested type in $defs, got %v", defs)
+		}
+	})
+
+	t.Run("named root definition", func(t *testing.T) {
+		s, err := schema.NewGenerator[SchemaCompany]().GenerateFlattened(schema.FlattenOptions{RootName: "Company", UseRef: true})
+		if err != nil {
+			t.Fatalf("failed to generate schema: %v", err)
+		}
+		if s["$ref"] != "#/$defs/Company" {
+			t.Errorf("expected root $ref to Company, got %v", s["$ref"])
+		}
+		defs := s["$defs"].(map[string]any)
+		company, ok := defs["Company"].(map[string]any)
+		if !ok {
+			t.Fatalf("expected Company in $defs, got %v", defs)
+		}
+		if _, old := defs["SchemaCompany"]; old {
+			t.Error("expected the Go type name to be replaced")
+		}
+		if company["title"] != "Company" {
+			t.Errorf("expected title Company, got %v", company["title"])
+		}
+		if _, ok := defs["SchemaAddress"]; !ok {
+			t.Error("expected nested types to stay in $defs")
+		}
+	})
+
+	t.Run("named root keeps self references", func(t *testing.T) {
+		s, err := schema.NewGenerator[TreeNode]().GenerateFlattened(schema.FlattenOptions{RootName: "Node", UseRef: true})
+		if err != nil {
+			t.Fatalf("failed to generate schema: %v", err)
+		}
+		data, _ := json.Marshal(s)
+		if strings.Contains(string(data), "#/$defs/TreeNode") {
+			t.Errorf("expected every $ref to the root to be renamed, got %s", data)
+		}
+		if !strings.Contains(string(data), `"$ref":"#/$defs/Node"`) {
+			t.Errorf("expected references to Node, got %s", data)
+		}
+	})
+
+	t.Run("root name must not collide", func(t *testing.T) {
+		_, err := schema.NewGenerator[SchemaCompany]().GenerateFlattened(schema.FlattenOptions{RootName: "SchemaAddress", UseRef: true})
+		if err == nil {
+			t.Error("expected an error when the root name is taken")
+		}
+	})
+}
+
 func TestGeneratorWithOptions(t *testing.T) {
 	sg := schema.NewGenerator[SchemaUser]().WithOptions(schema.SchemaOptions{
 		AutoGenerateTitles: false,
@@ -302,3 +377,66 @@ func TestGenerateJSON(t *testing.T) {
 		t.Errorf("GenerateJSON produced invalid JSON: %v", err)
 	}
 }
+
+func TestValidatorFields(t *testing.T) {
+	validator := godantic.NewValidator[SchemaUser]()
+	fields := validator.Fields()
+
+	var names, jsonNames []string
+	for _, f := range fields {
+		names = append(names, f.Name)
+		jsonNames = append(jsonNames, f.JSONName)
+		if !f.Required {
+			t.Errorf("expected %s to be required", f.Name)
+		}
+		if f.Default != nil {
+			t.Errorf("expected no default for %s, got %v", f.Name, f.Default)
+		}
+	}
+	if want := []string{"Name", "Email", "Age", "Username", "Active"}; !slices.Equal(names, want) {
+		t.Errorf("expected Go names %v, got %v", want, names)
+	}
+	if want := []string{"name", "email", "age", "username", "active"}; !slices.Equal(jsonNames, want) {
+		t.Errorf("expected JSON names %v, got %v", want, jsonNames)
+	}
+
+	email := fields[1]
+	if email.Type.Kind() != reflect.String {
+		t.Errorf("expected email to be a string, got %v", email.Type)
+	}
+	if pattern := email.Constraints[godantic.ConstraintPattern]; pattern != `^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$` {
+		t.Errorf("expected the email pattern, got %v", pattern)
+	}
+	if desc := email.Constraints[godantic.ConstraintDescription]; desc != "User's email address" {
+		t.Errorf("expected the email description, got %v", desc)
+	}
+	if age := fields[2]; age.Constraints[godantic.ConstraintMinimum] != 0 || age.Constraints[godantic.ConstraintMaximum] != 130 {
+		t.Errorf("expected age bounds 0..130, got %v", age.Constraints)
+	}
+
+	// The constraints are a copy
+	email.Constraints[godantic.ConstraintPattern] = "changed"
+	if got := validator.Fields()[1].Constraints[godantic.ConstraintPattern]; got == "changed" {
+		t.Error("expected Fields to return a copy of the constraints")
+	}
+}
+
+func TestValidatorFields_EmbeddedOrder(t *testing.T) {
+	type Embedded struct {
+		Raw  string `json:"raw"`
+		Name string `json:"name"` // Shadowed by Name on the outer struct
+	}
+	type Outer struct {
+		Name string `json:"name"`
+		Embedded
+		ID string `json:"id"`
+	}
+
+	var names []string
+	for _, f := range godantic.NewValidator[Outer]().Fields() {
+		names = append(names, f.JSONName)
+	}
+	if want := []string{"name", "raw", "id"}; !slices.Equal(names, want) {
+		t.Errorf("expected promoted fields in place of the struct %v, got %v", want, names)
+	}
+}
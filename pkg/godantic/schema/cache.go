@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"reflect"
+	"sync"
+)
+
+// schemaCache memoizes GenerateForTypeWithOptions results. Schemas only depend
+// on the type's structure and Field methods, which can't change at runtime.
+var schemaCache sync.Map // schemaCacheKey -> map[string]any
+
+type schemaCacheKey struct {
+	typ  reflect.Type
+	opts SchemaOptions
+}
+
+// ClearCache drops all cached schemas, so the next GenerateForType call for
+// each type reflects it again. Intended for tests and benchmarks.
+func ClearCache() {
+	schemaCache.Clear()
+}
+
+// cloneSchemaMap deep-copies a schema decoded from JSON
+func cloneSchemaMap(m map[string]any) map[string]any {
+	return cloneSchemaValue(m).(map[string]any)
+}
+
+func cloneSchemaValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		cloned := make(map[string]any, len(v))
+		for key, elem := range v {
+			cloned[key] = cloneSchemaValue(elem)
+		}
+		return cloned
+	case []any:
+		cloned := make([]any, len(v))
+		for i, elem := range v {
+			cloned[i] = cloneSchemaValue(elem)
+		}
+		return cloned
+	default:
+		return v
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 
 	"github.com/deepankarm/godantic/pkg/godantic"
 	"github.com/deepankarm/godantic/pkg/internal/reflectutil"
@@ -21,8 +23,11 @@ func applyConstraints(prop *jsonschema.Schema, constraints map[string]any) {
 	applyStringConstraints(prop, constraints)
 	applyArrayConstraints(prop, constraints)
 	applyObjectConstraints(prop, constraints)
+	applyNonEmptyConstraint(prop, constraints)
 	applyValueConstraints(prop, constraints)
+	applyNotConstraints(prop, constraints)
 	applyUnionConstraints(prop, constraints)
+	applyFileConstraints(prop, constraints)
 }
 
 // applyMetadataConstraints applies metadata constraints (description, title, etc.)
@@ -48,6 +53,22 @@ func applyMetadataConstraints(prop *jsonschema.Schema, constraints map[string]an
 	if deprecated, ok := constraints[godantic.ConstraintDeprecated].(bool); ok && deprecated {
 		prop.Deprecated = true
 	}
+	if extensions, ok := constraints[godantic.ConstraintExtensions].(map[string]any); ok {
+		applyExtensions(prop, extensions)
+	}
+}
+
+// applyExtensions writes "x-" vendor extensions into the schema verbatim
+func applyExtensions(prop *jsonschema.Schema, extensions map[string]any) {
+	for key, value := range extensions {
+		if !strings.HasPrefix(key, "x-") {
+			continue
+		}
+		if prop.Extras == nil {
+			prop.Extras = make(map[string]any)
+		}
+		prop.Extras[key] = value
+	}
 }
 
 // applyNumericConstraints applies numeric constraints (min, max, multipleOf, etc.)
@@ -90,7 +111,7 @@ func applyStringConstraints(prop *jsonschema.Schema, constraints map[string]any)
 	}
 }
 
-// applyArrayConstraints applies array constraints (minItems, maxItems, uniqueItems)
+// applyArrayConstraints applies array constraints (minItems, maxItems, uniqueItems, items)
 func applyArrayConstraints(prop *jsonschema.Schema, constraints map[string]any) {
 	if minItems, ok := constraints[godantic.ConstraintMinItems].(int); ok {
 		val := uint64(minItems)
@@ -103,9 +124,32 @@ func applyArrayConstraints(prop *jsonschema.Schema, constraints map[string]any)
 	if uniqueItems, ok := constraints[godantic.ConstraintUniqueItems].(bool); ok && uniqueItems {
 		prop.UniqueItems = true
 	}
+	if items, ok := constraints[godantic.ConstraintItems].(map[string]any); ok && prop.Items != nil {
+		applyConstraints(prop.Items, items)
+	}
+}
+
+// applyFileConstraints describes file upload limits (maxFileSize, contentTypes),
+// which JSON Schema has no keywords for
+func applyFileConstraints(prop *jsonschema.Schema, constraints map[string]any) {
+	var notes []string
+	if size, ok := constraints[godantic.ConstraintMaxFileSize].(int64); ok {
+		notes = append(notes, fmt.Sprintf("Maximum size: %d bytes.", size))
+	}
+	if types, ok := constraints[godantic.ConstraintContentTypes].([]string); ok && len(types) > 0 {
+		notes = append(notes, fmt.Sprintf("Allowed content types: %s.", strings.Join(types, ", ")))
+	}
+	if len(notes) == 0 {
+		return
+	}
+	if prop.Description != "" {
+		notes = append([]string{prop.Description}, notes...)
+	}
+	prop.Description = strings.Join(notes, " ")
 }
 
-// applyObjectConstraints applies object/map constraints (minProperties, maxProperties)
+// applyObjectConstraints applies object/map constraints (minProperties,
+// maxProperties, propertyNames)
 func applyObjectConstraints(prop *jsonschema.Schema, constraints map[string]any) {
 	if minProps, ok := constraints[godantic.ConstraintMinProperties].(int); ok {
 		val := uint64(minProps)
@@ -115,25 +159,38 @@ func applyObjectConstraints(prop *jsonschema.Schema, constraints map[string]any)
 		val := uint64(maxProps)
 		prop.MaxProperties = &val
 	}
+	if pattern, ok := constraints[godantic.ConstraintPropertyNames].(string); ok {
+		prop.PropertyNames = &jsonschema.Schema{Pattern: pattern}
+	}
+}
+
+// applyNonEmptyConstraint maps RequiredNonEmpty to the minimum length keyword
+// for the property's type, unless a minimum is already set
+func applyNonEmptyConstraint(prop *jsonschema.Schema, constraints map[string]any) {
+	if nonEmpty, ok := constraints[godantic.ConstraintNonEmpty].(bool); !ok || !nonEmpty {
+		return
+	}
+	one := uint64(1)
+	switch prop.Type {
+	case "string":
+		if prop.MinLength == nil {
+			prop.MinLength = &one
+		}
+	case "array":
+		if prop.MinItems == nil {
+			prop.MinItems = &one
+		}
+	case "object":
+		if prop.MinProperties == nil {
+			prop.MinProperties = &one
+		}
+	}
 }
 
 // applyValueConstraints applies value constraints (enum, const, default)
 func applyValueConstraints(prop *jsonschema.Schema, constraints map[string]any) {
 	if enum, ok := constraints[godantic.ConstraintEnum]; ok {
-		// Convert enum to []any (it may be []T from OneOf[T])
-		if enumSlice, ok := enum.([]any); ok {
-			prop.Enum = enumSlice
-		} else {
-			// Handle typed slices by converting to []any using reflection
-			v := reflect.ValueOf(enum)
-			if v.Kind() == reflect.Slice {
-				enumAny := make([]any, v.Len())
-				for i := 0; i < v.Len(); i++ {
-					enumAny[i] = v.Index(i).Interface()
-				}
-				prop.Enum = enumAny
-			}
-		}
+		prop.Enum = toAnySlice(enum)
 	}
 	if constVal, ok := constraints[godantic.ConstraintConst]; ok {
 		prop.Const = constVal
@@ -143,6 +200,41 @@ func applyValueConstraints(prop *jsonschema.Schema, constraints map[string]any)
 	}
 }
 
+// toAnySlice converts a constraint slice, which may be []T from OneOf[T], to []any
+func toAnySlice(values any) []any {
+	if anySlice, ok := values.([]any); ok {
+		return anySlice
+	}
+	v := reflect.ValueOf(values)
+	if v.Kind() != reflect.Slice {
+		return nil
+	}
+	result := make([]any, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		result[i] = v.Index(i).Interface()
+	}
+	return result
+}
+
+// applyNotConstraints expresses NotRegex and NotOneOf as "not", under anyOf
+// when both are set
+func applyNotConstraints(prop *jsonschema.Schema, constraints map[string]any) {
+	var excluded []*jsonschema.Schema
+	if pattern, ok := constraints[godantic.ConstraintNotPattern].(string); ok {
+		excluded = append(excluded, &jsonschema.Schema{Pattern: pattern})
+	}
+	if values, ok := constraints[godantic.ConstraintNotEnum]; ok {
+		excluded = append(excluded, &jsonschema.Schema{Enum: toAnySlice(values)})
+	}
+	switch len(excluded) {
+	case 0:
+	case 1:
+		prop.Not = excluded[0]
+	default:
+		prop.Not = &jsonschema.Schema{AnyOf: excluded}
+	}
+}
+
 // applyUnionConstraints applies union constraints (anyOf, oneOf with discriminator)
 func applyUnionConstraints(prop *jsonschema.Schema, constraints map[string]any) {
 	// Collect all anyOf schemas (both primitive and complex types)
@@ -182,28 +274,43 @@ func applyUnionConstraints(prop *jsonschema.Schema, constraints map[string]any)
 		mapping, _ := discriminator["mapping"].(map[string]any)
 
 		if propertyName != "" && mapping != nil {
-			// Create oneOf schemas for each variant
+			// A slice of unions describes its elements, not the array itself
+			target := prop
+			if prop.Type == "array" {
+				prop.Items = &jsonschema.Schema{}
+				target = prop.Items
+			}
+
+			// Create oneOf schemas for each variant, ordered by discriminator value
+			// so the generated schema is stable
+			values := make([]string, 0, len(mapping))
+			for value := range mapping {
+				values = append(values, value)
+			}
+			sort.Strings(values)
+
 			schemas := make([]*jsonschema.Schema, 0, len(mapping))
-			for _, variant := range mapping {
+			refs := make(map[string]any, len(mapping))
+			for _, value := range values {
+				variant := mapping[value]
 				// Use reflection to get the type of the variant
 				variantType := reflect.TypeOf(variant)
 				if variantType != nil {
-					// Create a temporary schema for this variant type
-					variantSchema := &jsonschema.Schema{
-						Ref: fmt.Sprintf("#/$defs/%s", variantType.Name()),
-					}
-					schemas = append(schemas, variantSchema)
+					ref := fmt.Sprintf("#/$defs/%s", reflectutil.UnwrapPointer(variantType).Name())
+					schemas = append(schemas, &jsonschema.Schema{Ref: ref})
+					refs[value] = ref
 				}
 			}
-			prop.OneOf = schemas
+			target.OneOf = schemas
 
 			// Add discriminator as an OpenAPI extension
 			// This is stored in Extras since it's OpenAPI-specific, not core JSON Schema
-			if prop.Extras == nil {
-				prop.Extras = make(map[string]any)
+			if target.Extras == nil {
+				target.Extras = make(map[string]any)
 			}
-			prop.Extras["discriminator"] = map[string]any{
+			target.Extras["discriminator"] = map[string]any{
 				"propertyName": propertyName,
+				"mapping":      refs,
 			}
 		}
 	}
@@ -249,6 +356,8 @@ func createSchemaForType(t reflect.Type) *jsonschema.Schema {
 // toJSONNumber converts numeric values to json.Number
 func toJSONNumber(v any) json.Number {
 	switch val := v.(type) {
+	case json.Number:
+		return val
 	case int:
 		return json.Number(fmt.Sprintf("%d", val))
 	case int64:
@@ -1,8 +1,10 @@
 package schema_test
 
 import (
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/deepankarm/godantic/pkg/godantic"
 	"github.com/deepankarm/godantic/pkg/godantic/schema"
@@ -102,3 +104,95 @@ func TestDefaultsInSchema(t *testing.T) {
 		_ = validator
 	})
 }
+
+type ReplicaConfig struct {
+	Region   string
+	Replicas int
+}
+
+func (r *ReplicaConfig) FieldRegion() godantic.FieldOptions[string] {
+	return godantic.Field(
+		godantic.StrictRequired[string](),
+		godantic.Default("us-east-1"),
+	)
+}
+
+func (r *ReplicaConfig) FieldReplicas() godantic.FieldOptions[int] {
+	return godantic.Field(
+		godantic.Required[int](),
+		godantic.Default(3),
+	)
+}
+
+func TestDefaultsImplyOptional(t *testing.T) {
+	t.Run("off by default keeps defaulted fields required", func(t *testing.T) {
+		s, err := schema.GenerateWithOptions[ServerConfig](schema.Options{})
+		if err != nil {
+			t.Fatalf("failed to generate schema: %v", err)
+		}
+		required := s.Definitions["ServerConfig"].Required
+		for _, name := range []string{"Host", "Port", "Debug", "Timeout"} {
+			if !slices.Contains(required, name) {
+				t.Errorf("expected %s to be required, got %v", name, required)
+			}
+		}
+	})
+
+	t.Run("defaulted fields are omitted from required", func(t *testing.T) {
+		s, err := schema.GenerateWithOptions[ServerConfig](schema.Options{DefaultsImplyOptional: true})
+		if err != nil {
+			t.Fatalf("failed to generate schema: %v", err)
+		}
+		if required := s.Definitions["ServerConfig"].Required; len(required) != 0 {
+			t.Errorf("expected no required fields, got %v", required)
+		}
+	})
+
+	t.Run("StrictRequired stays required", func(t *testing.T) {
+		sg := schema.NewGenerator[ReplicaConfig]().WithOptions(schema.SchemaOptions{
+			AutoGenerateTitles:    true,
+			DefaultsImplyOptional: true,
+		})
+		s, err := sg.Generate()
+		if err != nil {
+			t.Fatalf("failed to generate schema: %v", err)
+		}
+		required := s.Definitions["ReplicaConfig"].Required
+		if !slices.Contains(required, "Region") {
+			t.Errorf("expected Region to be required, got %v", required)
+		}
+		if slices.Contains(required, "Replicas") {
+			t.Errorf("expected Replicas to be optional, got %v", required)
+		}
+	})
+}
+
+type TokenRecord struct {
+	Token    string    `json:"token"`
+	IssuedAt time.Time `json:"issued_at"`
+}
+
+func (r *TokenRecord) FieldIssuedAt() godantic.FieldOptions[time.Time] {
+	return godantic.Field(
+		godantic.Description[time.Time]("Defaults to the time of the request"),
+		godantic.DefaultFunc(time.Now),
+	)
+}
+
+func TestDefaultFuncInSchema(t *testing.T) {
+	s, err := schema.GenerateWithOptions[TokenRecord](schema.Options{DefaultsImplyOptional: true})
+	if err != nil {
+		t.Fatalf("failed to generate schema: %v", err)
+	}
+	def := s.Definitions["TokenRecord"]
+	prop, ok := def.Properties.Get("issued_at")
+	if !ok {
+		t.Fatal("issued_at property not found")
+	}
+	if prop.Default != nil {
+		t.Errorf("expected no default for a computed default, got %v", prop.Default)
+	}
+	if slices.Contains(def.Required, "issued_at") || !slices.Contains(def.Required, "token") {
+		t.Errorf("expected only token to be required, got %v", def.Required)
+	}
+}
@@ -0,0 +1,58 @@
+package schema_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/godantic/schema"
+)
+
+type DescribedStep struct {
+	Title string `json:"title"`
+}
+
+func (DescribedStep) Describe() string { return "One step of a task." }
+
+type DescribedTask struct {
+	Name  string          `json:"name"`
+	Steps []DescribedStep `json:"steps"`
+}
+
+func (t *DescribedTask) Describe() string { return "A unit of work assigned to an agent." }
+
+func TestDescribeSchema(t *testing.T) {
+	s, err := schema.NewGenerator[DescribedTask]().Generate()
+	if err != nil {
+		t.Fatalf("failed to generate schema: %v", err)
+	}
+	if s.Description != "A unit of work assigned to an agent." {
+		t.Errorf("expected the root description, got %q", s.Description)
+	}
+	if got := s.Definitions["DescribedTask"].Description; got != "A unit of work assigned to an agent." {
+		t.Errorf("expected the $defs description, got %q", got)
+	}
+	if got := s.Definitions["DescribedStep"].Description; got != "One step of a task." {
+		t.Errorf("expected the nested struct description, got %q", got)
+	}
+
+	flat, err := schema.NewGenerator[DescribedTask]().GenerateFlattened()
+	if err != nil {
+		t.Fatalf("failed to generate schema: %v", err)
+	}
+	if flat["description"] != "A unit of work assigned to an agent." {
+		t.Errorf("expected the flattened description, got %v", flat["description"])
+	}
+
+	// A top-level array keeps its description on the element definition
+	list, err := schema.GenerateForType(reflect.TypeFor[[]DescribedStep]())
+	if err != nil {
+		t.Fatalf("failed to generate schema: %v", err)
+	}
+	if _, ok := list["description"]; ok {
+		t.Errorf("expected no description on the array, got %v", list["description"])
+	}
+	step := list["$defs"].(map[string]any)["DescribedStep"].(map[string]any)
+	if step["description"] != "One step of a task." {
+		t.Errorf("expected the element description, got %v", step["description"])
+	}
+}
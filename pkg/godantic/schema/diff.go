@@ -0,0 +1,448 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// ChangeKind identifies the kind of difference found between two schemas
+type ChangeKind string
+
+const (
+	ChangePropertyAdded        ChangeKind = "property_added"
+	ChangePropertyRemoved      ChangeKind = "property_removed"
+	ChangeRequiredAdded        ChangeKind = "required_added"
+	ChangeRequiredRemoved      ChangeKind = "required_removed"
+	ChangeTypeChanged          ChangeKind = "type_changed"
+	ChangeRefChanged           ChangeKind = "ref_changed"
+	ChangeEnumValueAdded       ChangeKind = "enum_value_added"
+	ChangeEnumValueRemoved     ChangeKind = "enum_value_removed"
+	ChangeVariantAdded         ChangeKind = "variant_added"
+	ChangeVariantRemoved       ChangeKind = "variant_removed"
+	ChangeConstraintTightened  ChangeKind = "constraint_tightened"
+	ChangeConstraintLoosened   ChangeKind = "constraint_loosened"
+	ChangeDefinitionAdded      ChangeKind = "definition_added"
+	ChangeDefinitionRemoved    ChangeKind = "definition_removed"
+	ChangeAdditionalProperties ChangeKind = "additional_properties_changed"
+)
+
+// SchemaChange describes a single difference between an old and a new schema.
+// A change is Breaking when some value valid under the old schema is rejected
+// by the new one (e.g. a field became required or a type was narrowed).
+type SchemaChange struct {
+	Path     string     // JSON pointer to the changed location, e.g. "/properties/email"
+	Kind     ChangeKind // What changed
+	Breaking bool       // Whether previously valid data may now be rejected
+	Message  string     // Human-readable description
+}
+
+// String formats the change for CLI/CI output
+func (c SchemaChange) String() string {
+	level := "non-breaking"
+	if c.Breaking {
+		level = "BREAKING"
+	}
+	path := c.Path
+	if path == "" {
+		path = "/"
+	}
+	return fmt.Sprintf("[%s] %s: %s", level, path, c.Message)
+}
+
+// HasBreakingChanges reports whether any change is breaking
+func HasBreakingChanges(changes []SchemaChange) bool {
+	for _, c := range changes {
+		if c.Breaking {
+			return true
+		}
+	}
+	return false
+}
+
+// Diff compares two JSON schemas (as produced by GenerateFlattened, GenerateForType
+// or a JSON round-trip of Generate) and returns the differences in a stable order.
+//
+// Compared: properties, required, type, $ref, enum, const, anyOf/oneOf variants,
+// numeric/length/item bounds, pattern, additionalProperties, items and $defs.
+// Definitions are diffed by name; a $ref pointing at a different definition is a
+// breaking change, while a $ref to the same definition defers to the $defs diff.
+//
+// Example (CI check):
+//
+//	changes := schema.Diff(oldSchema, newSchema)
+//	if schema.HasBreakingChanges(changes) {
+//	    log.Fatal(changes)
+//	}
+func Diff(oldSchema, newSchema map[string]any) []SchemaChange {
+	d := &differ{}
+	d.diffSchema("", oldSchema, newSchema)
+	for _, key := range []string{"$defs", "definitions"} {
+		d.diffDefinitions("/"+key, asMap(oldSchema[key]), asMap(newSchema[key]))
+	}
+	return d.changes
+}
+
+// differ accumulates changes while walking two schemas in parallel
+type differ struct {
+	changes []SchemaChange
+}
+
+func (d *differ) add(path string, kind ChangeKind, breaking bool, format string, args ...any) {
+	d.changes = append(d.changes, SchemaChange{
+		Path:     path,
+		Kind:     kind,
+		Breaking: breaking,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// diffDefinitions diffs named definitions ($defs / components.schemas)
+func (d *differ) diffDefinitions(path string, oldDefs, newDefs map[string]any) {
+	for _, name := range unionKeys(oldDefs, newDefs) {
+		defPath := path + "/" + escapePointer(name)
+		oldDef, inOld := oldDefs[name]
+		newDef, inNew := newDefs[name]
+		switch {
+		case !inNew:
+			// Only breaking if still referenced, which the $ref diff reports
+			d.add(defPath, ChangeDefinitionRemoved, false, "definition %q removed", name)
+		case !inOld:
+			d.add(defPath, ChangeDefinitionAdded, false, "definition %q added", name)
+		default:
+			d.diffSchema(defPath, asMap(oldDef), asMap(newDef))
+		}
+	}
+}
+
+// diffSchema compares two schema nodes at path
+func (d *differ) diffSchema(path string, oldS, newS map[string]any) {
+	if oldS == nil || newS == nil {
+		return
+	}
+
+	oldRef, _ := oldS["$ref"].(string)
+	newRef, _ := newS["$ref"].(string)
+	if oldRef != "" || newRef != "" {
+		if oldRef != newRef {
+			d.add(path, ChangeRefChanged, true, "reference changed from %q to %q", oldRef, newRef)
+		}
+		return
+	}
+
+	d.diffType(path, oldS, newS)
+	d.diffEnum(path, oldS, newS)
+	d.diffConst(path, oldS, newS)
+	d.diffBounds(path, oldS, newS)
+	d.diffPattern(path, oldS, newS)
+	d.diffVariants(path, "anyOf", oldS, newS)
+	d.diffVariants(path, "oneOf", oldS, newS)
+	d.diffProperties(path, oldS, newS)
+	d.diffAdditionalProperties(path, oldS, newS)
+
+	if oldItems, newItems := asMap(oldS["items"]), asMap(newS["items"]); oldItems != nil && newItems != nil {
+		d.diffSchema(path+"/items", oldItems, newItems)
+	}
+}
+
+// diffProperties compares properties and the required list
+func (d *differ) diffProperties(path string, oldS, newS map[string]any) {
+	oldProps, newProps := asMap(oldS["properties"]), asMap(newS["properties"])
+	oldReq, newReq := stringSet(oldS["required"]), stringSet(newS["required"])
+
+	for _, name := range unionKeys(oldProps, newProps) {
+		propPath := path + "/properties/" + escapePointer(name)
+		oldProp, inOld := oldProps[name]
+		newProp, inNew := newProps[name]
+		switch {
+		case !inNew:
+			d.add(propPath, ChangePropertyRemoved, true, "property %q removed", name)
+		case !inOld:
+			if newReq[name] {
+				d.add(propPath, ChangePropertyAdded, true, "required property %q added", name)
+			} else {
+				d.add(propPath, ChangePropertyAdded, false, "optional property %q added", name)
+			}
+		default:
+			switch {
+			case newReq[name] && !oldReq[name]:
+				d.add(propPath, ChangeRequiredAdded, true, "property %q became required", name)
+			case oldReq[name] && !newReq[name]:
+				d.add(propPath, ChangeRequiredRemoved, false, "property %q is no longer required", name)
+			}
+			d.diffSchema(propPath, asMap(oldProp), asMap(newProp))
+		}
+	}
+}
+
+// diffType compares the "type" keyword. Widening (e.g. integer -> number,
+// string -> [string, null]) is non-breaking; anything else is breaking.
+func (d *differ) diffType(path string, oldS, newS map[string]any) {
+	oldTypes, newTypes := typeList(oldS["type"]), typeList(newS["type"])
+	if slices.Equal(oldTypes, newTypes) {
+		return
+	}
+	if len(oldTypes) == 0 {
+		d.add(path, ChangeTypeChanged, true, "type narrowed from any to %v", newTypes)
+		return
+	}
+	if len(newTypes) == 0 {
+		d.add(path, ChangeTypeChanged, false, "type widened from %v to any", oldTypes)
+		return
+	}
+	for _, t := range oldTypes {
+		if !slices.Contains(newTypes, t) && !(t == "integer" && slices.Contains(newTypes, "number")) {
+			d.add(path, ChangeTypeChanged, true, "type changed from %v to %v", oldTypes, newTypes)
+			return
+		}
+	}
+	d.add(path, ChangeTypeChanged, false, "type widened from %v to %v", oldTypes, newTypes)
+}
+
+// diffEnum compares allowed enum values
+func (d *differ) diffEnum(path string, oldS, newS map[string]any) {
+	oldEnum, hasOld := oldS["enum"]
+	newEnum, hasNew := newS["enum"]
+	if !hasOld && !hasNew {
+		return
+	}
+	if !hasNew {
+		d.add(path, ChangeConstraintLoosened, false, "enum restriction removed")
+		return
+	}
+	if !hasOld {
+		d.add(path, ChangeConstraintTightened, true, "enum restriction added")
+		return
+	}
+
+	oldVals, newVals := canonicalSet(oldEnum), canonicalSet(newEnum)
+	for _, v := range sortedSet(oldVals) {
+		if !newVals[v] {
+			d.add(path, ChangeEnumValueRemoved, true, "enum value %s removed", v)
+		}
+	}
+	for _, v := range sortedSet(newVals) {
+		if !oldVals[v] {
+			d.add(path, ChangeEnumValueAdded, false, "enum value %s added", v)
+		}
+	}
+}
+
+// diffConst compares the "const" keyword
+func (d *differ) diffConst(path string, oldS, newS map[string]any) {
+	oldConst, hasOld := oldS["const"]
+	newConst, hasNew := newS["const"]
+	switch {
+	case hasOld && !hasNew:
+		d.add(path, ChangeConstraintLoosened, false, "const removed")
+	case !hasOld && hasNew:
+		d.add(path, ChangeConstraintTightened, true, "const %s added", canonicalJSON(newConst))
+	case hasOld && hasNew && canonicalJSON(oldConst) != canonicalJSON(newConst):
+		d.add(path, ChangeConstraintTightened, true, "const changed from %s to %s", canonicalJSON(oldConst), canonicalJSON(newConst))
+	}
+}
+
+// lowerBounds are keywords where a larger value is stricter; upperBounds the opposite
+var (
+	lowerBounds = []string{"minimum", "exclusiveMinimum", "minLength", "minItems", "minProperties"}
+	upperBounds = []string{"maximum", "exclusiveMaximum", "maxLength", "maxItems", "maxProperties"}
+)
+
+// diffBounds compares numeric, length and item count bounds
+func (d *differ) diffBounds(path string, oldS, newS map[string]any) {
+	for _, key := range lowerBounds {
+		d.diffBound(path, key, oldS, newS, 1)
+	}
+	for _, key := range upperBounds {
+		d.diffBound(path, key, oldS, newS, -1)
+	}
+	if oldS["uniqueItems"] != true && newS["uniqueItems"] == true {
+		d.add(path, ChangeConstraintTightened, true, "uniqueItems added")
+	} else if oldS["uniqueItems"] == true && newS["uniqueItems"] != true {
+		d.add(path, ChangeConstraintLoosened, false, "uniqueItems removed")
+	}
+}
+
+// diffBound compares a single bound. stricter is 1 if a larger value is stricter, -1 otherwise.
+func (d *differ) diffBound(path, key string, oldS, newS map[string]any, stricter int) {
+	oldVal, hasOld := toFloat(oldS[key])
+	newVal, hasNew := toFloat(newS[key])
+	switch {
+	case !hasOld && !hasNew:
+		return
+	case !hasOld:
+		d.add(path, ChangeConstraintTightened, true, "%s %v added", key, newVal)
+	case !hasNew:
+		d.add(path, ChangeConstraintLoosened, false, "%s %v removed", key, oldVal)
+	case oldVal == newVal:
+		return
+	case (newVal > oldVal) == (stricter > 0):
+		d.add(path, ChangeConstraintTightened, true, "%s changed from %v to %v", key, oldVal, newVal)
+	default:
+		d.add(path, ChangeConstraintLoosened, false, "%s changed from %v to %v", key, oldVal, newVal)
+	}
+}
+
+// diffPattern compares string patterns and formats. Any new or changed
+// pattern/format is treated as breaking since regex containment can't be decided.
+func (d *differ) diffPattern(path string, oldS, newS map[string]any) {
+	for _, key := range []string{"pattern", "format"} {
+		oldVal, _ := oldS[key].(string)
+		newVal, _ := newS[key].(string)
+		switch {
+		case oldVal == newVal:
+		case newVal == "":
+			d.add(path, ChangeConstraintLoosened, false, "%s %q removed", key, oldVal)
+		case oldVal == "":
+			d.add(path, ChangeConstraintTightened, true, "%s %q added", key, newVal)
+		default:
+			d.add(path, ChangeConstraintTightened, true, "%s changed from %q to %q", key, oldVal, newVal)
+		}
+	}
+}
+
+// diffVariants compares anyOf/oneOf variant lists. Variants are matched by
+// their JSON encoding; removals are breaking, additions are not.
+func (d *differ) diffVariants(path, key string, oldS, newS map[string]any) {
+	oldVariants, newVariants := canonicalSet(oldS[key]), canonicalSet(newS[key])
+	if len(oldVariants) == 0 && len(newVariants) == 0 {
+		return
+	}
+	for _, v := range sortedSet(oldVariants) {
+		if !newVariants[v] {
+			d.add(path+"/"+key, ChangeVariantRemoved, true, "%s variant %s removed", key, v)
+		}
+	}
+	for _, v := range sortedSet(newVariants) {
+		if !oldVariants[v] {
+			d.add(path+"/"+key, ChangeVariantAdded, false, "%s variant %s added", key, v)
+		}
+	}
+}
+
+// diffAdditionalProperties compares additionalProperties (boolean form) and
+// recurses when both sides describe map values with a schema.
+func (d *differ) diffAdditionalProperties(path string, oldS, newS map[string]any) {
+	oldAP, newAP := oldS["additionalProperties"], newS["additionalProperties"]
+	oldMap, newMap := asMap(oldAP), asMap(newAP)
+	if oldMap != nil && newMap != nil {
+		d.diffSchema(path+"/additionalProperties", oldMap, newMap)
+		return
+	}
+
+	// Absent and true both allow anything
+	oldAllows := oldAP == nil || oldAP == true
+	newAllows := newAP == nil || newAP == true
+	switch {
+	case oldAllows && !newAllows:
+		d.add(path, ChangeAdditionalProperties, true, "additional properties no longer allowed")
+	case !oldAllows && newAllows:
+		d.add(path, ChangeAdditionalProperties, false, "additional properties now allowed")
+	}
+}
+
+// asMap returns v as a schema map, or nil
+func asMap(v any) map[string]any {
+	m, _ := v.(map[string]any)
+	return m
+}
+
+// unionKeys returns the sorted union of keys in a and b
+func unionKeys(a, b map[string]any) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	for k := range a {
+		seen[k] = true
+	}
+	for k := range b {
+		seen[k] = true
+	}
+	return sortedSet(seen)
+}
+
+// sortedSet returns the members of a set in sorted order
+func sortedSet(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// stringSet converts a []any or []string (e.g. "required") to a set
+func stringSet(v any) map[string]bool {
+	set := make(map[string]bool)
+	switch vals := v.(type) {
+	case []string:
+		for _, s := range vals {
+			set[s] = true
+		}
+	case []any:
+		for _, s := range vals {
+			if str, ok := s.(string); ok {
+				set[str] = true
+			}
+		}
+	}
+	return set
+}
+
+// typeList normalizes a "type" keyword (string or array) to a sorted list
+func typeList(v any) []string {
+	if s, ok := v.(string); ok {
+		return []string{s}
+	}
+	types := sortedSet(stringSet(v))
+	if len(types) == 0 {
+		return nil
+	}
+	return types
+}
+
+// canonicalSet encodes each element of a list as JSON for order-insensitive comparison
+func canonicalSet(v any) map[string]bool {
+	set := make(map[string]bool)
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice {
+		return set
+	}
+	for i := 0; i < rv.Len(); i++ {
+		set[canonicalJSON(rv.Index(i).Interface())] = true
+	}
+	return set
+}
+
+// canonicalJSON encodes v as JSON; map keys are sorted by encoding/json
+func canonicalJSON(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(data)
+}
+
+// toFloat converts a JSON number representation to float64
+func toFloat(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// escapePointer escapes a JSON pointer reference token (RFC 6901)
+func escapePointer(s string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(s)
+}
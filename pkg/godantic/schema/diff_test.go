@@ -0,0 +1,170 @@
+package schema_test
+
+import (
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+	"github.com/deepankarm/godantic/pkg/godantic/schema"
+)
+
+// Two versions of the same DTO used to exercise Diff on generated schemas.
+
+type OrderV1 struct {
+	ID     string  `json:"id"`
+	Status string  `json:"status"`
+	Note   *string `json:"note"`
+	Legacy *string `json:"legacy"`
+	Qty    int     `json:"qty"`
+	Coupon *string `json:"coupon"`
+}
+
+func (OrderV1) FieldStatus() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.OneOf("pending", "shipped", "cancelled"))
+}
+
+func (OrderV1) FieldQty() godantic.FieldOptions[int] {
+	return godantic.Field(godantic.Min(1), godantic.Max(100))
+}
+
+type OrderV2 struct {
+	ID     string  `json:"id"`
+	Status string  `json:"status"`
+	Note   *string `json:"note"`
+	Qty    int     `json:"qty"`
+	Coupon string  `json:"coupon"`
+	Gift   *bool   `json:"gift"`
+}
+
+func (OrderV2) FieldStatus() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.OneOf("pending", "shipped", "delivered"))
+}
+
+func (OrderV2) FieldQty() godantic.FieldOptions[int] {
+	return godantic.Field(godantic.Min(1), godantic.Max(50))
+}
+
+func generateFlattened[T any](t *testing.T) map[string]any {
+	t.Helper()
+	s, err := schema.NewGenerator[T]().GenerateFlattened()
+	if err != nil {
+		t.Fatalf("failed to generate schema: %v", err)
+	}
+	return s
+}
+
+func findChange(changes []schema.SchemaChange, path string, kind schema.ChangeKind) (schema.SchemaChange, bool) {
+	for _, c := range changes {
+		if c.Path == path && c.Kind == kind {
+			return c, true
+		}
+	}
+	return schema.SchemaChange{}, false
+}
+
+func TestDiff_GeneratedSchemas(t *testing.T) {
+	changes := schema.Diff(generateFlattened[OrderV1](t), generateFlattened[OrderV2](t))
+
+	tests := []struct {
+		path     string
+		kind     schema.ChangeKind
+		breaking bool
+	}{
+		{"/properties/legacy", schema.ChangePropertyRemoved, true},
+		{"/properties/gift", schema.ChangePropertyAdded, false},
+		{"/properties/coupon", schema.ChangeRequiredAdded, true},
+		{"/properties/status", schema.ChangeEnumValueRemoved, true},
+		{"/properties/status", schema.ChangeEnumValueAdded, false},
+		{"/properties/qty", schema.ChangeConstraintTightened, true},
+	}
+	for _, tt := range tests {
+		c, ok := findChange(changes, tt.path, tt.kind)
+		if !ok {
+			t.Errorf("expected %s at %s, got %v", tt.kind, tt.path, changes)
+			continue
+		}
+		if c.Breaking != tt.breaking {
+			t.Errorf("%s at %s: expected breaking=%v, got %v", tt.kind, tt.path, tt.breaking, c.Breaking)
+		}
+	}
+
+	if _, ok := findChange(changes, "/properties/id", schema.ChangeTypeChanged); ok {
+		t.Error("unchanged property should not be reported")
+	}
+	if !schema.HasBreakingChanges(changes) {
+		t.Error("expected breaking changes")
+	}
+}
+
+func TestDiff_IdenticalSchemas(t *testing.T) {
+	changes := schema.Diff(generateFlattened[OrderV1](t), generateFlattened[OrderV1](t))
+	if len(changes) != 0 {
+		t.Errorf("expected no changes, got %v", changes)
+	}
+}
+
+func TestDiff_TypeChanges(t *testing.T) {
+	tests := []struct {
+		name     string
+		oldType  any
+		newType  any
+		breaking bool
+	}{
+		{"integer widened to number", "integer", "number", false},
+		{"number narrowed to integer", "number", "integer", true},
+		{"made nullable", "string", []any{"string", "null"}, false},
+		{"nullable removed", []any{"string", "null"}, "string", true},
+		{"incompatible", "string", "integer", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := schema.Diff(
+				map[string]any{"type": tt.oldType},
+				map[string]any{"type": tt.newType},
+			)
+			if len(changes) != 1 || changes[0].Kind != schema.ChangeTypeChanged {
+				t.Fatalf("expected one type change, got %v", changes)
+			}
+			if changes[0].Breaking != tt.breaking {
+				t.Errorf("expected breaking=%v, got %v", tt.breaking, changes[0].Breaking)
+			}
+		})
+	}
+}
+
+func TestDiff_Definitions(t *testing.T) {
+	oldSchema := map[string]any{
+		"$ref": "#/$defs/User",
+		"$defs": map[string]any{
+			"User": map[string]any{
+				"type":       "object",
+				"properties": map[string]any{"name": map[string]any{"type": "string", "maxLength": 10.0}},
+			},
+			"Unused": map[string]any{"type": "object"},
+		},
+	}
+	newSchema := map[string]any{
+		"$ref": "#/$defs/User",
+		"$defs": map[string]any{
+			"User": map[string]any{
+				"type":       "object",
+				"properties": map[string]any{"name": map[string]any{"type": "string", "maxLength": 20.0}},
+			},
+		},
+	}
+
+	changes := schema.Diff(oldSchema, newSchema)
+	if c, ok := findChange(changes, "/$defs/User/properties/name", schema.ChangeConstraintLoosened); !ok || c.Breaking {
+		t.Errorf("expected non-breaking maxLength change, got %v", changes)
+	}
+	if _, ok := findChange(changes, "/$defs/Unused", schema.ChangeDefinitionRemoved); !ok {
+		t.Errorf("expected definition removal, got %v", changes)
+	}
+	if schema.HasBreakingChanges(changes) {
+		t.Errorf("expected no breaking changes, got %v", changes)
+	}
+
+	newSchema["$ref"] = "#/$defs/Account"
+	if c, ok := findChange(schema.Diff(oldSchema, newSchema), "", schema.ChangeRefChanged); !ok || !c.Breaking {
+		t.Error("expected breaking ref change at root")
+	}
+}
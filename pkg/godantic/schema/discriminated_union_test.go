@@ -84,6 +84,69 @@ func TestInterfaceDiscriminatedUnion(t *testing.T) {
 	}
 }
 
+// Shelter has scalar and slice discriminated union fields
+type Shelter struct {
+	Featured Animal   `json:"featured"`
+	Animals  []Animal `json:"animals"`
+}
+
+func (s *Shelter) FieldFeatured() godantic.FieldOptions[Animal] {
+	return godantic.Field(
+		godantic.DiscriminatedUnion[Animal]("type", map[string]any{"cat": Cat{}, "dog": &Dog{}}),
+	)
+}
+
+func (s *Shelter) FieldAnimals() godantic.FieldOptions[[]Animal] {
+	return godantic.Field(
+		godantic.DiscriminatedUnion[[]Animal]("type", map[string]any{"cat": Cat{}, "dog": &Dog{}}),
+	)
+}
+
+// TestDiscriminatedUnionMapping tests that union fields get a discriminator
+// mapping, and that slice unions describe their items
+func TestDiscriminatedUnionMapping(t *testing.T) {
+	schemaMap, err := schema.GenerateForType(reflect.TypeOf(Shelter{}))
+	if err != nil {
+		t.Fatalf("GenerateForType failed: %v", err)
+	}
+	defs := schemaMap["$defs"].(map[string]any)
+	for _, name := range []string{"Shelter", "Cat", "Dog"} {
+		if _, ok := defs[name]; !ok {
+			t.Errorf("expected %s in $defs, got %v", name, defs)
+		}
+	}
+	props := defs["Shelter"].(map[string]any)["properties"].(map[string]any)
+
+	wantOneOf := []any{map[string]any{"$ref": "#/$defs/Cat"}, map[string]any{"$ref": "#/$defs/Dog"}}
+	wantDiscriminator := map[string]any{
+		"propertyName": "type",
+		"mapping":      map[string]any{"cat": "#/$defs/Cat", "dog": "#/$defs/Dog"},
+	}
+
+	featured := props["featured"].(map[string]any)
+	if !reflect.DeepEqual(featured["oneOf"], wantOneOf) {
+		t.Errorf("featured: expected oneOf %v, got %v", wantOneOf, featured["oneOf"])
+	}
+	if !reflect.DeepEqual(featured["discriminator"], wantDiscriminator) {
+		t.Errorf("featured: expected discriminator %v, got %v", wantDiscriminator, featured["discriminator"])
+	}
+
+	animals := props["animals"].(map[string]any)
+	if animals["type"] != "array" {
+		t.Errorf("animals: expected an array, got %v", animals)
+	}
+	if _, ok := animals["oneOf"]; ok {
+		t.Errorf("animals: expected oneOf on items, not the array, got %v", animals)
+	}
+	items := animals["items"].(map[string]any)
+	if !reflect.DeepEqual(items["oneOf"], wantOneOf) {
+		t.Errorf("animals: expected items.oneOf %v, got %v", wantOneOf, items["oneOf"])
+	}
+	if !reflect.DeepEqual(items["discriminator"], wantDiscriminator) {
+		t.Errorf("animals: expected items.discriminator %v, got %v", wantDiscriminator, items["discriminator"])
+	}
+}
+
 // Test 2: Nested interface discriminated union (the bug we fixed)
 
 type NestedPayload interface {
@@ -260,3 +323,95 @@ func TestInterfaceFieldsWithGenerateForType(t *testing.T) {
 		t.Error("Expected TextContent schema in $defs")
 	}
 }
+
+// Root-level discriminated union: the whole Animal interface as a oneOf
+
+type Bird struct {
+	Type     string `json:"type"`
+	CanFly   bool   `json:"can_fly"`
+	Wingspan int    `json:"wingspan"`
+}
+
+func (Bird) IsAnimal() {}
+
+func (Bird) FieldWingspan() godantic.FieldOptions[int] {
+	return godantic.Field(godantic.Min(1))
+}
+
+func TestGenerateDiscriminatedUnionSchema(t *testing.T) {
+	variants := map[string]any{
+		"cat":  Cat{},
+		"dog":  Dog{},
+		"bird": &Bird{},
+	}
+	s, err := schema.GenerateDiscriminatedUnionSchema("type", variants)
+	if err != nil {
+		t.Fatalf("failed to generate schema: %v", err)
+	}
+
+	// oneOf lists each variant by $ref, sorted by discriminator value
+	wantRefs := []any{
+		map[string]any{"$ref": "#/$defs/Bird"},
+		map[string]any{"$ref": "#/$defs/Cat"},
+		map[string]any{"$ref": "#/$defs/Dog"},
+	}
+	if !reflect.DeepEqual(s["oneOf"], wantRefs) {
+		t.Errorf("unexpected oneOf: %v", s["oneOf"])
+	}
+
+	wantDiscriminator := map[string]any{
+		"propertyName": "type",
+		"mapping": map[string]any{
+			"bird": "#/$defs/Bird",
+			"cat":  "#/$defs/Cat",
+			"dog":  "#/$defs/Dog",
+		},
+	}
+	if !reflect.DeepEqual(s["discriminator"], wantDiscriminator) {
+		t.Errorf("unexpected discriminator: %v", s["discriminator"])
+	}
+
+	defs := s["$defs"].(map[string]any)
+	for _, name := range []string{"Bird", "Cat", "Dog"} {
+		if _, ok := defs[name]; !ok {
+			t.Errorf("expected %s in $defs", name)
+		}
+	}
+
+	// Variant definitions keep their godantic constraints
+	wingspan := defs["Bird"].(map[string]any)["properties"].(map[string]any)["wingspan"].(map[string]any)
+	if wingspan["minimum"] != 1.0 {
+		t.Errorf("expected wingspan minimum 1, got %v", wingspan["minimum"])
+	}
+
+	if _, err := json.Marshal(s); err != nil {
+		t.Errorf("schema should be JSON serializable: %v", err)
+	}
+}
+
+func TestGenerateDiscriminatedUnionSchema_Errors(t *testing.T) {
+	if _, err := schema.GenerateDiscriminatedUnionSchema("", map[string]any{"cat": Cat{}}); err == nil {
+		t.Error("expected error for empty property name")
+	}
+	if _, err := schema.GenerateDiscriminatedUnionSchema("type", nil); err == nil {
+		t.Error("expected error for no variants")
+	}
+	if _, err := schema.GenerateDiscriminatedUnionSchema("type", map[string]any{"n": 5}); err == nil {
+		t.Error("expected error for non-struct variant")
+	}
+}
+
+type AnimalKind string
+
+func TestGenerateDiscriminatedUnionSchemaTyped(t *testing.T) {
+	s, err := schema.GenerateDiscriminatedUnionSchemaTyped("type", map[AnimalKind]any{
+		AnimalKind("cat"): Cat{},
+	})
+	if err != nil {
+		t.Fatalf("failed to generate schema: %v", err)
+	}
+	mapping := s["discriminator"].(map[string]any)["mapping"].(map[string]any)
+	if mapping["cat"] != "#/$defs/Cat" {
+		t.Errorf("unexpected mapping: %v", mapping)
+	}
+}
@@ -0,0 +1,27 @@
+package schema_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/deepankarm/godantic/pkg/godantic/schema"
+)
+
+type DurationConfig struct {
+	Timeout time.Duration  `json:"timeout"`
+	Retry   *time.Duration `json:"retry"`
+}
+
+func TestDurationSchema(t *testing.T) {
+	flat, err := schema.NewGenerator[DurationConfig]().GenerateFlattened()
+	if err != nil {
+		t.Fatalf("failed to generate schema: %v", err)
+	}
+	props := flat["properties"].(map[string]any)
+	for _, name := range []string{"timeout", "retry"} {
+		prop := props[name].(map[string]any)
+		if prop["type"] != "string" || prop["format"] != "duration" {
+			t.Errorf("expected %s as a duration string, got %v", name, prop)
+		}
+	}
+}
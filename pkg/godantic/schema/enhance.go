@@ -1,6 +1,7 @@
 package schema
 
 import (
+	"maps"
 	"reflect"
 	"slices"
 
@@ -20,6 +21,14 @@ func (g *Generator[T]) enhance(schema *jsonschema.Schema) {
 // It handles all schema enhancement including union variants and field options
 func enhanceSchema(schema *jsonschema.Schema, reflector *jsonschema.Reflector, rootType reflect.Type, opts SchemaOptions) {
 	rootType = reflectutil.UnwrapPointer(rootType)
+	// The root schema itself is the object only for a top-level struct
+	if desc := describe(rootType); desc != "" && schema.Ref != "" {
+		schema.Description = desc
+	}
+	// A top-level array is described by its element's definition
+	for rootType.Kind() == reflect.Slice || rootType.Kind() == reflect.Array {
+		rootType = reflectutil.UnwrapPointer(rootType.Elem())
+	}
 
 	if rootType.Kind() != reflect.Struct {
 		return
@@ -36,12 +45,136 @@ func enhanceSchema(schema *jsonschema.Schema, reflector *jsonschema.Reflector, r
 	if schema.Definitions != nil {
 		for defName, defSchema := range schema.Definitions {
 			if structType, ok := structTypes[defName]; ok {
-				enhanceDefinition(defSchema, structType, opts.AutoGenerateTitles)
+				enhanceDefinition(defSchema, structType, opts)
+				if desc := describe(structType); desc != "" {
+					defSchema.Description = desc
+				}
+				applyExtensions(defSchema, extensions(structType))
+				applyDependentRules(defSchema, structType, opts.TagName)
+				if opts.TagName != "" && opts.TagName != "json" {
+					renameProperties(defSchema, structType, opts.TagName)
+				}
 			}
 		}
 	}
 }
 
+// describer is implemented by structs describing themselves in the schema
+type describer interface {
+	Describe() string
+}
+
+// describe returns the object description of t from its Describe method, as
+// a value or pointer receiver, if it has one
+func describe(t reflect.Type) string {
+	if t.Kind() != reflect.Struct {
+		return ""
+	}
+	if d, ok := reflect.New(t).Interface().(describer); ok {
+		return d.Describe()
+	}
+	return ""
+}
+
+// extender is implemented by structs adding "x-" vendor extensions to their
+// schema, the struct-level counterpart of godantic.Extension
+type extender interface {
+	SchemaExtensions() map[string]any
+}
+
+// extensions returns the vendor extensions of t from its SchemaExtensions
+// method, as a value or pointer receiver, if it has one
+func extensions(t reflect.Type) map[string]any {
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	if e, ok := reflect.New(t).Interface().(extender); ok {
+		return e.SchemaExtensions()
+	}
+	return nil
+}
+
+// applyDependentRules expresses the DependentRequired rules of t as if/then
+// subschemas: a single rule on the definition itself, several under allOf.
+// Fields are named under tag, since the definition's properties are renamed
+// after this runs.
+func applyDependentRules(defSchema *jsonschema.Schema, t reflect.Type, tag string) {
+	rules := godantic.ScanTypeDependentRules(t)
+	if len(rules) == 0 {
+		return
+	}
+	if tag == "" {
+		tag = "json"
+	}
+	names := make(map[string]string)
+	for _, field := range reflectutil.TaggedFields(t, tag) {
+		names[field.JSONName] = field.TagName
+	}
+	name := func(jsonName string) string {
+		if tagName, ok := names[jsonName]; ok {
+			return tagName
+		}
+		return jsonName
+	}
+
+	conditions := make([]*jsonschema.Schema, 0, len(rules))
+	for _, rule := range rules {
+		when := name(rule.WhenField)
+		properties := jsonschema.NewProperties()
+		properties.Set(when, &jsonschema.Schema{Const: rule.Equals})
+		then := &jsonschema.Schema{}
+		for _, field := range rule.RequireFields {
+			then.Required = append(then.Required, name(field))
+		}
+		conditions = append(conditions, &jsonschema.Schema{
+			If:   &jsonschema.Schema{Properties: properties, Required: []string{when}},
+			Then: then,
+		})
+	}
+
+	if len(conditions) == 1 && defSchema.If == nil {
+		defSchema.If, defSchema.Then = conditions[0].If, conditions[0].Then
+		return
+	}
+	defSchema.AllOf = append(defSchema.AllOf, conditions...)
+}
+
+// renameProperties renames the properties of a definition from their json
+// names to their names under tag, keeping their order. Properties the tag
+// ignores ("-") are removed.
+func renameProperties(defSchema *jsonschema.Schema, t reflect.Type, tag string) {
+	if defSchema.Properties == nil {
+		return
+	}
+	names := make(map[string]string)
+	for _, field := range reflectutil.TaggedFields(t, tag) {
+		names[field.JSONName] = field.TagName
+	}
+
+	renamed := jsonschema.NewProperties()
+	for pair := defSchema.Properties.Oldest(); pair != nil; pair = pair.Next() {
+		name, ok := names[pair.Key]
+		if !ok {
+			name = pair.Key
+		}
+		if name != "-" {
+			renamed.Set(name, pair.Value)
+		}
+	}
+	defSchema.Properties = renamed
+
+	required := defSchema.Required[:0]
+	for _, name := range defSchema.Required {
+		if tagName, ok := names[name]; ok {
+			name = tagName
+		}
+		if name != "-" {
+			required = append(required, name)
+		}
+	}
+	defSchema.Required = required
+}
+
 // collectAndReflectUnionVariants iteratively collects and reflects all discriminated union variant types
 // This is needed because variant types may themselves contain nested discriminated unions
 func collectAndReflectUnionVariants(schema *jsonschema.Schema, reflector *jsonschema.Reflector, structTypes map[string]reflect.Type) {
@@ -93,24 +226,36 @@ func discoverNewVariantTypes(schema *jsonschema.Schema, structTypes map[string]r
 	return newFound
 }
 
-// findVariantTypeByName searches for a variant type by name in all discriminated union constraints
+// findVariantTypeByName searches for a variant type by name in all discriminated
+// union and UnionOf constraints
 func findVariantTypeByName(defName string, structTypes map[string]reflect.Type) reflect.Type {
 	for _, structType := range structTypes {
 		fieldOptions := godantic.ScanTypeFieldOptions(structType)
 		for _, opts := range fieldOptions {
+			var variants []any
 			if discriminator, ok := opts.Constraints[godantic.ConstraintDiscriminator].(map[string]any); ok {
 				if mapping, ok := discriminator["mapping"].(map[string]any); ok {
 					for _, variant := range mapping {
-						variantType := reflect.TypeOf(variant)
-						if variantType != nil {
-							variantType = reflectutil.UnwrapPointer(variantType)
-							if variantType.Name() == defName {
-								return variantType
-							}
-						}
+						variants = append(variants, variant)
 					}
 				}
 			}
+			if types, ok := opts.Constraints["anyOfTypes"].([]any); ok {
+				variants = append(variants, types...)
+			}
+			for _, variant := range variants {
+				variantType := reflect.TypeOf(variant)
+				if variantType == nil {
+					continue
+				}
+				variantType = reflectutil.UnwrapPointer(variantType)
+				if variantType.Kind() == reflect.Slice || variantType.Kind() == reflect.Array {
+					variantType = reflectutil.UnwrapPointer(variantType.Elem())
+				}
+				if variantType.Kind() == reflect.Struct && variantType.Name() == defName {
+					return variantType
+				}
+			}
 		}
 	}
 	return nil
@@ -132,7 +277,7 @@ func isEmptyInterfaceSchema(s *jsonschema.Schema) bool {
 
 // enhanceDefinition enhances a schema definition with field options from a type.
 // Single pass over properties - applies constraints, required, and titles.
-func enhanceDefinition(defSchema *jsonschema.Schema, t reflect.Type, autoGenerateTitles bool) {
+func enhanceDefinition(defSchema *jsonschema.Schema, t reflect.Type, schemaOpts SchemaOptions) {
 	if defSchema.Properties == nil {
 		return
 	}
@@ -150,8 +295,18 @@ func enhanceDefinition(defSchema *jsonschema.Schema, t reflect.Type, autoGenerat
 		}
 	}
 
+	// Fields a DependentRequired rule governs are only conditionally required
+	conditional := make(map[string]bool)
+	for _, rule := range godantic.ScanTypeDependentRules(t) {
+		for _, name := range rule.RequireFields {
+			conditional[name] = true
+		}
+	}
+
 	// Track which properties have field options
 	enhanced := make(map[string]bool)
+	// Pointer fields to wrap as nullable (SchemaOptions.NullablePointers)
+	nullablePointers := make(map[string]bool)
 
 	// Auto-require non-pointer fields (matching Pydantic behavior)
 	for i := 0; i < t.NumField(); i++ {
@@ -171,8 +326,9 @@ func enhanceDefinition(defSchema *jsonschema.Schema, t reflect.Type, autoGenerat
 			continue
 		}
 
-		// Check if field type is a pointer
+		// Check if field type is a pointer; an Optional is optional in the same way
 		_, isPointer := reflectutil.UnwrapPointerInfo(field.Type)
+		isPointer = isPointer || reflectutil.IsOptional(field.Type)
 
 		// Get field options if available
 		opts, hasOpts := fieldOptions[field.Name]
@@ -185,14 +341,33 @@ func enhanceDefinition(defSchema *jsonschema.Schema, t reflect.Type, autoGenerat
 			}
 		}
 
+		// Check for Default and StrictRequired constraints
+		hasDefault, isStrictRequired := false, false
+		if hasOpts {
+			_, hasDefault = opts.Constraints[godantic.ConstraintDefault]
+			if _, ok := opts.Constraints[godantic.ConstraintDefaultFunc]; ok {
+				hasDefault = true
+			}
+			isStrictRequired, _ = opts.Constraints[godantic.ConstraintStrictRequired].(bool)
+		}
+
 		// Determine if field should be required:
-		// 1. If explicitly marked Required() -> required
-		// 2. If pointer type -> NOT required (unless explicit Required())
-		// 3. If has Nullable constraint -> NOT required (unless explicit Required())
-		// 4. Otherwise (non-pointer, non-nullable) -> required
+		// 1. If marked StrictRequired() -> required
+		// 2. If has Default and DefaultsImplyOptional is set -> NOT required
+		// 3. If explicitly marked Required() -> required
+		// 4. If named by a DependentRequired rule -> NOT required (the rule's if/then requires it)
+		// 5. If pointer or Optional type -> NOT required (unless explicit Required())
+		// 6. If has Nullable constraint -> NOT required (unless explicit Required())
+		// 7. Otherwise (non-pointer, non-nullable) -> required
 		shouldBeRequired := false
-		if hasOpts && opts.Required {
+		if isStrictRequired {
+			shouldBeRequired = true // StrictRequired() wins even over a default
+		} else if hasDefault && schemaOpts.DefaultsImplyOptional {
+			shouldBeRequired = false // The default fills in a missing value
+		} else if hasOpts && opts.Required {
 			shouldBeRequired = true // Explicit Required() always wins
+		} else if conditional[jsonName] {
+			shouldBeRequired = false // Required by the rule's if/then instead
 		} else if !isPointer && !isNullable {
 			shouldBeRequired = true // Non-pointer, non-nullable -> auto-required
 		}
@@ -200,6 +375,10 @@ func enhanceDefinition(defSchema *jsonschema.Schema, t reflect.Type, autoGenerat
 		if shouldBeRequired && !slices.Contains(defSchema.Required, jsonName) {
 			defSchema.Required = append(defSchema.Required, jsonName)
 		}
+
+		if isPointer && !isNullable && schemaOpts.NullablePointers {
+			nullablePointers[jsonName] = true
+		}
 	}
 
 	// Apply field options to properties with Field{Name}() methods
@@ -214,6 +393,14 @@ func enhanceDefinition(defSchema *jsonschema.Schema, t reflect.Type, autoGenerat
 			jsonName = fieldName
 		}
 
+		// A hand-written schema replaces the generated one as is
+		if override, ok := opts.Constraints[godantic.ConstraintSchema].(map[string]any); ok {
+			defSchema.Properties.Set(jsonName, &jsonschema.Schema{Extras: maps.Clone(override)})
+			delete(nullablePointers, jsonName)
+			enhanced[jsonName] = true
+			continue
+		}
+
 		// Replace empty interface schemas
 		if isEmptyInterfaceSchema(prop) {
 			prop = &jsonschema.Schema{}
@@ -238,7 +425,7 @@ func enhanceDefinition(defSchema *jsonschema.Schema, t reflect.Type, autoGenerat
 	}
 
 	// Handle remaining properties without field options (auto-titles)
-	if autoGenerateTitles {
+	if schemaOpts.AutoGenerateTitles {
 		for pair := defSchema.Properties.Oldest(); pair != nil; pair = pair.Next() {
 			if enhanced[pair.Key] {
 				continue
@@ -251,6 +438,13 @@ func enhanceDefinition(defSchema *jsonschema.Schema, t reflect.Type, autoGenerat
 			}
 		}
 	}
+
+	// Wrap pointer fields last so the wrapper carries their title
+	for pair := defSchema.Properties.Oldest(); pair != nil; pair = pair.Next() {
+		if nullablePointers[pair.Key] {
+			defSchema.Properties.Set(pair.Key, wrapNullable(pair.Value))
+		}
+	}
 }
 
 // toTitleCase converts a field name to a human-readable title
@@ -1,6 +1,7 @@
 package schema_test
 
 import (
+	"encoding/json"
 	"strings"
 	"testing"
 
@@ -75,3 +76,179 @@ func TestEnumConstraintsInSchema(t *testing.T) {
 		}
 	})
 }
+
+// Enums over non-string comparable types
+type Priority int
+
+const (
+	PriorityLow  Priority = 1
+	PriorityHigh Priority = 3
+)
+
+type Channel string
+
+type Ticket struct {
+	Level    int      `json:"level"`
+	Ratio    float64  `json:"ratio"`
+	Flag     bool     `json:"flag"`
+	Priority Priority `json:"priority"`
+	Channel  Channel  `json:"channel"`
+}
+
+func (Ticket) FieldLevel() godantic.FieldOptions[int] {
+	return godantic.Field(godantic.OneOf(1, 2, 3))
+}
+
+func (Ticket) FieldRatio() godantic.FieldOptions[float64] {
+	return godantic.Field(godantic.OneOf(0.5, 1.0, 1.5))
+}
+
+func (Ticket) FieldFlag() godantic.FieldOptions[bool] {
+	return godantic.Field(godantic.OneOf(true))
+}
+
+func (Ticket) FieldPriority() godantic.FieldOptions[Priority] {
+	return godantic.Field(godantic.OneOf(PriorityLow, PriorityHigh))
+}
+
+func (Ticket) FieldChannel() godantic.FieldOptions[Channel] {
+	return godantic.Field(godantic.OneOf[Channel]("email", "sms"))
+}
+
+func TestEnumConstraints_NonStringTypes(t *testing.T) {
+	s, err := schema.NewGenerator[Ticket]().GenerateFlattened()
+	if err != nil {
+		t.Fatalf("failed to generate schema: %v", err)
+	}
+	props := s["properties"].(map[string]any)
+
+	// Enum values keep their JSON type; only strings are quoted
+	tests := []struct {
+		field    string
+		wantType string
+		wantEnum string
+	}{
+		{"level", "integer", `[1,2,3]`},
+		{"ratio", "number", `[0.5,1,1.5]`},
+		{"flag", "boolean", `[true]`},
+		{"priority", "integer", `[1,3]`},
+		{"channel", "string", `["email","sms"]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			prop := props[tt.field].(map[string]any)
+			if prop["type"] != tt.wantType {
+				t.Errorf("expected type %s, got %v", tt.wantType, prop["type"])
+			}
+			raw, err := json.Marshal(prop["enum"])
+			if err != nil {
+				t.Fatalf("failed to marshal enum: %v", err)
+			}
+			if string(raw) != tt.wantEnum {
+				t.Errorf("expected enum %s, got %s", tt.wantEnum, raw)
+			}
+		})
+	}
+}
+
+// Optional enums behind a pointer
+type IssueState string
+
+type Issue struct {
+	Title string      `json:"title"`
+	State *IssueState `json:"state"`
+}
+
+func (Issue) FieldState() godantic.FieldOptions[IssueState] {
+	return godantic.Field(godantic.OneOf[IssueState]("open", "closed"))
+}
+
+func TestEnumConstraints_PointerEnum(t *testing.T) {
+	const wantEnum = `["open","closed"]`
+
+	t.Run("default", func(t *testing.T) {
+		s, err := schema.NewGenerator[Issue]().GenerateFlattened()
+		if err != nil {
+			t.Fatalf("failed to generate schema: %v", err)
+		}
+		state := s["properties"].(map[string]any)["state"].(map[string]any)
+		if raw, _ := json.Marshal(state["enum"]); string(raw) != wantEnum {
+			t.Errorf("expected enum %s, got %s", wantEnum, raw)
+		}
+		if raw, _ := json.Marshal(s["required"]); string(raw) != `["title"]` {
+			t.Errorf("expected only title to be required, got %s", raw)
+		}
+	})
+
+	t.Run("nullable pointers", func(t *testing.T) {
+		s, err := schema.NewGenerator[Issue]().
+			WithOptions(schema.SchemaOptions{AutoGenerateTitles: true, NullablePointers: true}).
+			GenerateFlattened()
+		if err != nil {
+			t.Fatalf("failed to generate schema: %v", err)
+		}
+		state := s["properties"].(map[string]any)["state"].(map[string]any)
+		raw, _ := json.Marshal(state)
+		want := `{"anyOf":[{"enum":["open","closed"],"type":"string"},{"type":"null"}],"title":"State"}`
+		if string(raw) != want {
+			t.Errorf("expected %s, got %s", want, raw)
+		}
+	})
+
+	t.Run("openai strict", func(t *testing.T) {
+		s, err := schema.NewGenerator[Issue]().GenerateFlattened()
+		if err != nil {
+			t.Fatalf("failed to generate schema: %v", err)
+		}
+		strict, err := schema.TransformForOpenAI(s, Issue{})
+		if err != nil {
+			t.Fatalf("TransformForOpenAI failed: %v", err)
+		}
+		var got map[string]any
+		if err := json.Unmarshal(strict, &got); err != nil {
+			t.Fatalf("invalid JSON: %v", err)
+		}
+		if got["additionalProperties"] != false {
+			t.Errorf("expected a closed object, got %v", got["additionalProperties"])
+		}
+		if raw, _ := json.Marshal(got["required"]); !strings.Contains(string(raw), `"state"`) {
+			t.Errorf("expected state to be required in strict mode, got %s", raw)
+		}
+
+		// Required but nullable, so the model can still leave it unset
+		props := got["properties"].(map[string]any)
+		raw, _ := json.Marshal(props["state"])
+		want := `{"anyOf":[{"enum":["open","closed"],"type":"string"},{"type":"null"}],"title":"State"}`
+		if string(raw) != want {
+			t.Errorf("expected %s, got %s", want, raw)
+		}
+		if _, wrapped := props["title"].(map[string]any)["anyOf"]; wrapped {
+			t.Error("expected required fields to stay as they are")
+		}
+	})
+}
+
+type Shipment struct {
+	Status OrderStatus `json:"status"`
+}
+
+func (s *Shipment) FieldStatus() godantic.FieldOptions[OrderStatus] {
+	return godantic.Field(
+		godantic.OneOf(OrderPending, OrderShipped),
+		godantic.EnumFromInt(map[int]OrderStatus{0: OrderPending, 1: OrderShipped}),
+	)
+}
+
+func TestEnumFromIntSchema(t *testing.T) {
+	s, err := schema.NewGenerator[Shipment]().Generate()
+	if err != nil {
+		t.Fatalf("failed to generate schema: %v", err)
+	}
+	prop, ok := s.Definitions["Shipment"].Properties.Get("status")
+	if !ok {
+		t.Fatal("status property not found")
+	}
+	if prop.Type != "string" || len(prop.Enum) != 2 || prop.Enum[0] != OrderPending || prop.Enum[1] != OrderShipped {
+		t.Errorf("expected the string enum [pending shipped], got type %q enum %v", prop.Type, prop.Enum)
+	}
+}
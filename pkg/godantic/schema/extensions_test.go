@@ -0,0 +1,82 @@
+package schema_test
+
+import (
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+	"github.com/deepankarm/godantic/pkg/godantic/schema"
+)
+
+type ExtendedProfile struct {
+	Bio    string         `json:"bio"`
+	Avatar ExtendedAvatar `json:"avatar"`
+}
+
+func (p *ExtendedProfile) FieldBio() godantic.FieldOptions[string] {
+	return godantic.Field(
+		godantic.MaxLen(500),
+		godantic.Extension[string]("x-ui-widget", "textarea"),
+		godantic.Extension[string]("x-ui-rows", 4),
+	)
+}
+
+func (p *ExtendedProfile) SchemaExtensions() map[string]any {
+	return map[string]any{"x-go-type": "example.com/users.Profile"}
+}
+
+type ExtendedAvatar struct {
+	URL string `json:"url"`
+}
+
+func (ExtendedAvatar) SchemaExtensions() map[string]any {
+	return map[string]any{"x-ui-widget": "image", "ignored": true}
+}
+
+func TestSchemaExtensions(t *testing.T) {
+	s, err := schema.NewGenerator[ExtendedProfile]().GenerateFlattened()
+	if err != nil {
+		t.Fatalf("failed to generate schema: %v", err)
+	}
+
+	bio := s["properties"].(map[string]any)["bio"].(map[string]any)
+	if bio["x-ui-widget"] != "textarea" {
+		t.Errorf("expected x-ui-widget on bio, got %v", bio)
+	}
+	if bio["x-ui-rows"] != float64(4) {
+		t.Errorf("expected x-ui-rows on bio, got %v", bio)
+	}
+	if bio["maxLength"] != float64(500) {
+		t.Errorf("expected the other constraints to stay, got %v", bio)
+	}
+
+	if s["x-go-type"] != "example.com/users.Profile" {
+		t.Errorf("expected the struct-level extension, got %v", s["x-go-type"])
+	}
+	avatar := s["$defs"].(map[string]any)["ExtendedAvatar"].(map[string]any)
+	if avatar["x-ui-widget"] != "image" {
+		t.Errorf("expected the nested struct's extension, got %v", avatar)
+	}
+	if _, ok := avatar["ignored"]; ok {
+		t.Errorf("expected keys without x- to be left out, got %v", avatar)
+	}
+}
+
+type ExtendedBooking struct {
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+}
+
+func (b *ExtendedBooking) FieldEndDate() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.FieldAfter[string]("start_date"))
+}
+
+func TestFieldAfterExtension(t *testing.T) {
+	s, err := schema.NewGenerator[ExtendedBooking]().GenerateFlattened()
+	if err != nil {
+		t.Fatalf("failed to generate schema: %v", err)
+	}
+	end := s["properties"].(map[string]any)["end_date"].(map[string]any)
+	if end["x-after"] != "start_date" {
+		t.Errorf("expected x-after on end_date, got %v", end)
+	}
+}
@@ -0,0 +1,54 @@
+package schema_test
+
+import (
+	"mime/multipart"
+	"reflect"
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+	"github.com/deepankarm/godantic/pkg/godantic/schema"
+)
+
+type ProfileUpload struct {
+	Avatar *multipart.FileHeader `json:"avatar"`
+}
+
+func (p *ProfileUpload) FieldAvatar() godantic.FieldOptions[*multipart.FileHeader] {
+	return godantic.Field(
+		godantic.Description[*multipart.FileHeader]("Profile picture."),
+		godantic.MaxFileSize(1<<20),
+		godantic.AllowedContentTypes("image/png", "image/jpeg"),
+	)
+}
+
+func TestFileUploadSchema(t *testing.T) {
+	s, err := schema.NewGenerator[ProfileUpload]().Generate()
+	if err != nil {
+		t.Fatalf("failed to generate schema: %v", err)
+	}
+
+	prop, ok := s.Definitions["ProfileUpload"].Properties.Get("avatar")
+	if !ok {
+		t.Fatal("avatar property not found")
+	}
+	if prop.Type != "string" || prop.Format != "binary" {
+		t.Errorf("expected a binary string, got type %q format %q", prop.Type, prop.Format)
+	}
+	want := "Profile picture. Maximum size: 1048576 bytes. Allowed content types: image/png, image/jpeg."
+	if prop.Description != want {
+		t.Errorf("expected description %q, got %q", want, prop.Description)
+	}
+	if _, ok := s.Definitions["FileHeader"]; ok {
+		t.Error("FileHeader should not get a definition")
+	}
+
+	// GenerateForType maps files the same way
+	schemaMap, err := schema.GenerateForType(reflect.TypeFor[ProfileUpload]())
+	if err != nil {
+		t.Fatalf("failed to generate schema: %v", err)
+	}
+	avatar := schemaMap["$defs"].(map[string]any)["ProfileUpload"].(map[string]any)["properties"].(map[string]any)["avatar"].(map[string]any)
+	if avatar["format"] != "binary" {
+		t.Errorf("expected format binary, got %v", avatar)
+	}
+}
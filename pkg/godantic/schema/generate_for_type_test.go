@@ -3,6 +3,7 @@ package schema_test
 import (
 	"encoding/json"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/deepankarm/godantic/pkg/godantic"
@@ -201,3 +202,155 @@ func TestGenerateForType_WithoutDiscriminatedUnion(t *testing.T) {
 		t.Error("Expected Address schema in $defs")
 	}
 }
+
+// TestGenerateForType_TopLevelSlice tests that element definitions of a
+// top-level array get godantic constraints and union variants
+func TestGenerateForType_TopLevelSlice(t *testing.T) {
+	schemaMap, err := schema.GenerateForType(reflect.TypeOf([]TestZoo{}))
+	if err != nil {
+		t.Fatalf("GenerateForType failed: %v", err)
+	}
+
+	if schemaMap["type"] != "array" {
+		t.Errorf("expected an array schema, got %v", schemaMap["type"])
+	}
+	items, _ := schemaMap["items"].(map[string]any)
+	if items["$ref"] != "#/$defs/TestZoo" {
+		t.Errorf("expected items to reference TestZoo, got %v", items)
+	}
+
+	defs := schemaMap["$defs"].(map[string]any)
+	for _, name := range []string{"TestZoo", "TestCat", "TestDog"} {
+		if _, ok := defs[name]; !ok {
+			t.Errorf("expected %s in $defs, got %v", name, defs)
+		}
+	}
+	animal := defs["TestZoo"].(map[string]any)["properties"].(map[string]any)["animal"].(map[string]any)
+	if _, ok := animal["discriminator"]; !ok {
+		t.Errorf("expected the element's discriminator to be applied, got %v", animal)
+	}
+}
+
+func TestGenerateForType_Cache(t *testing.T) {
+	schema.ClearCache()
+	typ := reflect.TypeOf(TestZoo{})
+
+	first, err := schema.GenerateForType(typ)
+	if err != nil {
+		t.Fatalf("GenerateForType failed: %v", err)
+	}
+	second, err := schema.GenerateForType(typ)
+	if err != nil {
+		t.Fatalf("GenerateForType failed: %v", err)
+	}
+	if !reflect.DeepEqual(first, second) {
+		t.Fatal("expected cached schema to match the generated one")
+	}
+
+	// Callers get their own copy, including nested maps
+	first["$defs"].(map[string]any)["TestZoo"] = "mutated"
+	delete(first, "$ref")
+	third, _ := schema.GenerateForType(typ)
+	if !reflect.DeepEqual(second, third) {
+		t.Error("mutating a returned schema changed the cache")
+	}
+
+	// Options are part of the key
+	noTitles, _ := schema.GenerateForTypeWithOptions(typ, schema.SchemaOptions{AutoGenerateTitles: false})
+	if reflect.DeepEqual(noTitles, third) {
+		t.Error("expected options to produce a separately cached schema")
+	}
+
+	schema.ClearCache()
+	fresh, _ := schema.GenerateForType(typ)
+	if !reflect.DeepEqual(fresh, third) {
+		t.Error("expected an identical schema after ClearCache")
+	}
+}
+
+type TaggedLine struct {
+	SKU string `api:"item_sku" json:"sku"`
+	Qty *int   `api:"item_qty" json:"qty"`
+}
+
+type TaggedOrder struct {
+	ID     string       `api:"order_id" json:"id"`
+	Note   string       `json:"note"`
+	Secret string       `api:"-" json:"secret"`
+	Lines  []TaggedLine `api:"order_lines" json:"lines"`
+}
+
+func (o *TaggedOrder) FieldID() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.MinLen(1))
+}
+
+func TestGenerateForType_TagName(t *testing.T) {
+	opts := schema.DefaultSchemaOptions()
+	opts.TagName = "api"
+	schemaMap, err := schema.GenerateForTypeWithOptions(reflect.TypeOf(TaggedOrder{}), opts)
+	if err != nil {
+		t.Fatalf("GenerateForType failed: %v", err)
+	}
+
+	defs := schemaMap["$defs"].(map[string]any)
+	order := defs["TaggedOrder"].(map[string]any)
+	props := order["properties"].(map[string]any)
+	for _, name := range []string{"order_id", "note", "order_lines"} {
+		if _, ok := props[name]; !ok {
+			t.Errorf("expected property %q, got %v", name, props)
+		}
+	}
+	for _, name := range []string{"id", "secret", "lines"} {
+		if _, ok := props[name]; ok {
+			t.Errorf("expected no property %q, got %v", name, props)
+		}
+	}
+	if id := props["order_id"].(map[string]any); id["minLength"] != float64(1) || id["title"] != "ID" {
+		t.Errorf("expected constraints and title on order_id, got %v", id)
+	}
+	if !reflect.DeepEqual(order["required"], []any{"order_id", "note", "order_lines"}) {
+		t.Errorf("expected renamed required fields, got %v", order["required"])
+	}
+
+	line := defs["TaggedLine"].(map[string]any)
+	if !reflect.DeepEqual(line["required"], []any{"item_sku"}) {
+		t.Errorf("expected nested required item_sku, got %v", line["required"])
+	}
+	if _, ok := line["properties"].(map[string]any)["item_qty"]; !ok {
+		t.Errorf("expected nested property item_qty, got %v", line["properties"])
+	}
+}
+
+// TestGenerateForType_ValidateAgainstSchema checks that JSON validated against a
+// generated schema gets the errors the Go validator reports
+func TestGenerateForType_ValidateAgainstSchema(t *testing.T) {
+	type Answer struct {
+		Summary string   `json:"summary"`
+		Score   int      `json:"score"`
+		Tags    []string `json:"tags"`
+		Zoo     TestZoo  `json:"zoo"`
+	}
+	schemaMap, err := schema.GenerateForType(reflect.TypeOf(Answer{}))
+	if err != nil {
+		t.Fatalf("GenerateForType failed: %v", err)
+	}
+
+	valid := `{"summary": "ok", "score": 3, "tags": [], "zoo": {"animal": {"type": "cat", "name": "Tom"}}}`
+	if errs := godantic.ValidateAgainstSchema(schemaMap, []byte(valid)); len(errs) != 0 {
+		t.Errorf("expected no errors, got %v", errs)
+	}
+
+	errs := godantic.ValidateAgainstSchema(schemaMap, []byte(`{"summary": "ok", "score": 1.5, "tags": [], "zoo": {"animal": {"type": "cow"}}, "extra": 1}`))
+	got := make(map[string]godantic.ErrorType)
+	for _, e := range errs {
+		got[strings.Join(e.Loc, ".")] = e.Type
+	}
+	want := map[string]godantic.ErrorType{
+		"score":           godantic.ErrorTypeMismatch,
+		"extra":           godantic.ErrorTypeConstraint,
+		"zoo.animal.type": godantic.ErrorTypeDiscriminatorInvalid,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
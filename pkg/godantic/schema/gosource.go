@@ -0,0 +1,654 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/invopop/jsonschema"
+)
+
+// Parse reads a JSON Schema document. Keywords jsonschema.Schema has no field
+// for, such as the OpenAPI "discriminator", are kept in Extras as the
+// generator writes them.
+func Parse(data []byte) (*jsonschema.Schema, error) {
+	var s jsonschema.Schema
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, err
+	}
+	var raw any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	restoreExtras(&s, raw)
+	return &s, nil
+}
+
+// schemaKeywords are the JSON names of the fields of jsonschema.Schema
+var schemaKeywords = func() map[string]bool {
+	keywords := make(map[string]bool)
+	typ := reflect.TypeFor[jsonschema.Schema]()
+	for i := range typ.NumField() {
+		if name, _, _ := strings.Cut(typ.Field(i).Tag.Get("json"), ","); name != "" && name != "-" {
+			keywords[name] = true
+		}
+	}
+	return keywords
+}()
+
+// restoreExtras copies the unknown keywords of raw, and of the subschemas it
+// holds, into the Extras of s and its matching subschemas
+func restoreExtras(s *jsonschema.Schema, raw any) {
+	obj := asMap(raw)
+	if s == nil || obj == nil {
+		return
+	}
+	for key, value := range obj {
+		if schemaKeywords[key] {
+			continue
+		}
+		if s.Extras == nil {
+			s.Extras = make(map[string]any)
+		}
+		s.Extras[key] = value
+	}
+
+	for name, def := range s.Definitions {
+		restoreExtras(def, asMap(obj["$defs"])[name])
+	}
+	if s.Properties != nil {
+		for pair := s.Properties.Oldest(); pair != nil; pair = pair.Next() {
+			restoreExtras(pair.Value, asMap(obj["properties"])[pair.Key])
+		}
+	}
+	for pattern, sub := range s.PatternProperties {
+		restoreExtras(sub, asMap(obj["patternProperties"])[pattern])
+	}
+	restoreExtras(s.Items, obj["items"])
+	restoreExtras(s.AdditionalProperties, obj["additionalProperties"])
+	restoreExtras(s.Not, obj["not"])
+	for keyword, subs := range map[string][]*jsonschema.Schema{
+		"prefixItems": s.PrefixItems, "anyOf": s.AnyOf, "oneOf": s.OneOf, "allOf": s.AllOf,
+	} {
+		rawSubs, _ := obj[keyword].([]any)
+		for i, sub := range subs {
+			if i < len(rawSubs) {
+				restoreExtras(sub, rawSubs[i])
+			}
+		}
+	}
+}
+
+// GoSource renders a schema as Go source for package pkg, the inverse of
+// schema generation: every object definition in $defs becomes a struct with
+// Field methods carrying its constraints, the root first and the rest sorted
+// by name, and any other definition becomes a named type. It covers the
+// keywords the generator emits, so the schema generated from the output keeps
+// every keyword reachable from the root, with refs to unions inlined and the
+// root title naming the root struct instead:
+//
+//   - required fields are plain values with Required; optional ones are
+//     godantic.Optional, or pointers for structs, and anyOf with null becomes
+//     a pointer with Nullable
+//   - string, integer, number and boolean become string, int, float64 and
+//     bool, "date-time" strings time.Time, and items and additionalProperties
+//     slices and maps; inline objects become structs named after the field
+//   - minimum, maximum and their exclusive forms, multipleOf, minLength,
+//     maxLength, pattern, format, enum, const, default, minItems, maxItems,
+//     uniqueItems, minProperties and maxProperties become the matching
+//     options, and those of basic array items Each
+//   - oneOf with a discriminator becomes DiscriminatedUnion, written on the
+//     slice when it describes the items, and other anyOf and oneOf of types
+//     and refs Union
+//   - title, description, deprecated, readOnly and writeOnly become the
+//     matching options, and the description of an object a Describe method
+//
+// Validation keywords on pointer fields other than format have no option to
+// carry them and are left out.
+//
+// Example:
+//
+//	s, err := schema.Parse(data)
+//	src, err := schema.GoSource(s, "models")
+func GoSource(s *jsonschema.Schema, pkg string) (string, error) {
+	w := &goSourceWriter{defs: s.Definitions, names: make(map[string]bool)}
+
+	if s.Ref != "" {
+		rootName := strings.TrimPrefix(s.Ref, "#/$defs/")
+		if _, ok := s.Definitions[rootName]; !ok || !strings.HasPrefix(s.Ref, "#/$defs/") {
+			return "", fmt.Errorf("root $ref %s does not point into $defs", s.Ref)
+		}
+		w.declare(goIdentifier(rootName), s.Definitions[rootName])
+	} else if s.Type != "" || (s.Properties != nil && s.Properties.Len() > 0) {
+		rootName := s.Title
+		if rootName == "" {
+			rootName = "Root"
+		}
+		w.declare(goIdentifier(rootName), s)
+	}
+
+	names := make([]string, 0, len(s.Definitions))
+	for name := range s.Definitions {
+		if "#/$defs/"+name != s.Ref {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		w.declare(goIdentifier(name), s.Definitions[name])
+	}
+
+	// Inline objects are queued while their parents are written
+	for i := 0; i < len(w.queue); i++ {
+		w.writeDeclaration(w.queue[i].name, w.queue[i].schema)
+	}
+
+	var src strings.Builder
+	src.WriteString("// Code generated by godantic. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&src, "package %s\n", pkg)
+	var imports []string
+	if w.usesTime {
+		imports = append(imports, `"time"`)
+	}
+	if w.usesGodantic {
+		imports = append(imports, `"github.com/deepankarm/godantic/pkg/godantic"`)
+	}
+	if len(imports) > 0 {
+		fmt.Fprintf(&src, "\nimport (\n%s\n)\n", strings.Join(imports, "\n\n"))
+	}
+	src.WriteString(w.body.String())
+
+	formatted, err := format.Source([]byte(src.String()))
+	if err != nil {
+		return "", fmt.Errorf("formatting generated source: %w", err)
+	}
+	return string(formatted), nil
+}
+
+// goSourceWriter accumulates the declarations of GoSource
+type goSourceWriter struct {
+	defs         map[string]*jsonschema.Schema
+	names        map[string]bool
+	queue        []goDeclaration
+	body         strings.Builder
+	usesTime     bool
+	usesGodantic bool
+}
+
+// goDeclaration is a named type to write
+type goDeclaration struct {
+	name   string
+	schema *jsonschema.Schema
+}
+
+// declare queues a declaration, returning its name made unique
+func (w *goSourceWriter) declare(name string, s *jsonschema.Schema) string {
+	unique := name
+	for i := 2; w.names[unique]; i++ {
+		unique = name + strconv.Itoa(i)
+	}
+	w.names[unique] = true
+	w.queue = append(w.queue, goDeclaration{name: unique, schema: s})
+	return unique
+}
+
+// writeDeclaration writes s as a struct with Field methods when it is an
+// object with properties and as a named type otherwise
+func (w *goSourceWriter) writeDeclaration(name string, s *jsonschema.Schema) {
+	w.body.WriteString("\n")
+	if s.Description != "" {
+		for _, line := range strings.Split(s.Description, "\n") {
+			fmt.Fprintf(&w.body, "// %s\n", line)
+		}
+	}
+	if !hasProperties(s) {
+		fmt.Fprintf(&w.body, "type %s %s\n", name, w.goType(s, name))
+		return
+	}
+
+	var methods strings.Builder
+	receiver := strings.ToLower(name[:1])
+	fmt.Fprintf(&w.body, "type %s struct {\n", name)
+	for pair := s.Properties.Oldest(); pair != nil; pair = pair.Next() {
+		field := w.field(name, pair.Key, pair.Value, slices.Contains(s.Required, pair.Key))
+		fmt.Fprintf(&w.body, "%s %s `json:%q`\n", field.name, field.typ, field.tag)
+		if len(field.options) == 0 {
+			continue
+		}
+		w.usesGodantic = true
+		fmt.Fprintf(&methods, "\nfunc (%s *%s) Field%s() godantic.FieldOptions[%s] {\n", receiver, name, field.name, field.optionType)
+		fmt.Fprintf(&methods, "return godantic.Field(\n%s,\n)\n}\n", strings.Join(field.options, ",\n"))
+	}
+	w.body.WriteString("}\n")
+	if s.Description != "" {
+		fmt.Fprintf(&w.body, "\nfunc (%s *%s) Describe() string {\nreturn %s\n}\n", receiver, name, strconv.Quote(s.Description))
+	}
+	w.body.WriteString(methods.String())
+}
+
+// goField is a struct field written by GoSource
+type goField struct {
+	name       string
+	typ        string
+	tag        string
+	optionType string // T of the field's FieldOptions
+	options    []string
+}
+
+// field maps the property key of the struct parent to a field
+func (w *goSourceWriter) field(parent, key string, prop *jsonschema.Schema, required bool) goField {
+	f := goField{name: goFieldName(key)}
+	if prop == nil {
+		prop = &jsonschema.Schema{}
+	}
+	value, nullable := splitNullable(prop)
+	base := w.goType(value, parent+f.name)
+
+	switch {
+	case nullable && base != "any":
+		f.typ, f.tag = "*"+base, key
+		if !required {
+			f.tag += ",omitempty"
+		}
+	case nullable || required:
+		f.typ, f.tag = base, key
+	case w.isStruct(value):
+		f.typ, f.tag = "*"+base, key+",omitempty"
+	default:
+		// Options of an Optional field are written for the wrapped type
+		f.typ, f.tag = "godantic.Optional["+base+"]", key+",omitzero"
+		w.usesGodantic = true
+	}
+	f.optionType = f.typ
+	if strings.HasPrefix(f.typ, "godantic.Optional[") {
+		f.optionType = base
+	}
+	t := f.optionType
+
+	if required {
+		f.options = append(f.options, fmt.Sprintf("godantic.Required[%s]()", t))
+	}
+	if nullable {
+		f.options = append(f.options, fmt.Sprintf("godantic.Nullable[%s]()", t))
+	}
+	if title := firstNonZero(prop.Title, value.Title); title != "" && title != toTitleCase(f.name) && title != toTitleCase(key) {
+		f.options = append(f.options, fmt.Sprintf("godantic.Title[%s](%s)", t, strconv.Quote(title)))
+	}
+	if description := firstNonZero(prop.Description, value.Description); description != "" {
+		f.options = append(f.options, fmt.Sprintf("godantic.Description[%s](%s)", t, strconv.Quote(description)))
+	}
+	for _, flag := range []struct {
+		set  bool
+		name string
+	}{
+		{prop.Deprecated || value.Deprecated, "Deprecated"},
+		{prop.ReadOnly || value.ReadOnly, "ReadOnly"},
+		{prop.WriteOnly || value.WriteOnly, "WriteOnly"},
+	} {
+		if flag.set {
+			f.options = append(f.options, fmt.Sprintf("godantic.%s[%s]()", flag.name, t))
+		}
+	}
+	if !strings.HasPrefix(t, "*") {
+		f.options = append(f.options, w.valueOptions(value, t)...)
+	} else if value.Format != "" && !(value.Format == "date-time" && t == "*time.Time") {
+		f.options = append(f.options, fmt.Sprintf("godantic.Format[%s](%s)", t, strconv.Quote(value.Format)))
+	}
+	return f
+}
+
+// valueOptions returns the validation options of a field of type t with schema s
+func (w *goSourceWriter) valueOptions(s *jsonschema.Schema, t string) []string {
+	var opts []string
+	add := func(format string, args ...any) {
+		opts = append(opts, fmt.Sprintf(format, args...))
+	}
+	number := func(name string, value json.Number) {
+		if lit, ok := goLiteral(value, t); ok && value != "" {
+			add("godantic.%s[%s](%s)", name, t, lit)
+		}
+	}
+
+	switch {
+	case t == "string":
+		if s.MinLength != nil {
+			add("godantic.MinLen(%d)", *s.MinLength)
+		}
+		if s.MaxLength != nil {
+			add("godantic.MaxLen(%d)", *s.MaxLength)
+		}
+		if s.Pattern != "" {
+			add("godantic.Regex(%s)", goRegexLiteral(s.Pattern))
+		}
+	case t == "int" || t == "float64":
+		number("Min", s.Minimum)
+		number("Max", s.Maximum)
+		number("ExclusiveMin", s.ExclusiveMinimum)
+		number("ExclusiveMax", s.ExclusiveMaximum)
+		number("MultipleOf", s.MultipleOf)
+	case strings.HasPrefix(t, "[]"):
+		elem := strings.TrimPrefix(t, "[]")
+		if s.MinItems != nil {
+			add("godantic.MinItems[%s](%d)", elem, *s.MinItems)
+		}
+		if s.MaxItems != nil {
+			add("godantic.MaxItems[%s](%d)", elem, *s.MaxItems)
+		}
+		if s.UniqueItems && isGoScalar(elem) {
+			add("godantic.UniqueItems[%s]()", elem)
+		}
+		if s.Items != nil && isGoScalar(elem) {
+			if itemOpts := w.valueOptions(s.Items, elem); len(itemOpts) > 0 {
+				add("godantic.Each[%s](\n%s,\n)", t, strings.Join(itemOpts, ",\n"))
+			}
+		}
+		// A discriminated union of the items is written on the slice
+		if items := w.resolve(s.Items); items != nil && asMap(items.Extras["discriminator"]) != nil {
+			if union := w.unionOption(items, t); union != "" {
+				opts = append(opts, union)
+			}
+		}
+	case strings.HasPrefix(t, "map[string]"):
+		elem := strings.TrimPrefix(t, "map[string]")
+		if s.MinProperties != nil {
+			add("godantic.MinPropertiesOf[%s](%d)", elem, *s.MinProperties)
+		}
+		if s.MaxProperties != nil {
+			add("godantic.MaxPropertiesOf[%s](%d)", elem, *s.MaxProperties)
+		}
+		if s.PropertyNames != nil && s.PropertyNames.Pattern != "" {
+			add("godantic.MapKeyPattern[%s](%s)", elem, goRegexLiteral(s.PropertyNames.Pattern))
+		}
+	default:
+		// Unions are written inline or as a named type of any
+		if union := w.unionOption(w.resolve(s), t); union != "" {
+			opts = append(opts, union)
+		}
+	}
+
+	if s.Format != "" && !(s.Format == "date-time" && t == "time.Time") {
+		add("godantic.Format[%s](%s)", t, strconv.Quote(s.Format))
+	}
+	if len(s.Enum) > 0 {
+		if lits, ok := goLiterals(s.Enum, t); ok {
+			add("godantic.OneOf[%s](%s)", t, strings.Join(lits, ", "))
+		}
+	}
+	if s.Const != nil {
+		if lit, ok := goLiteral(s.Const, t); ok {
+			add("godantic.Const[%s](%s)", t, lit)
+		}
+	}
+	if s.Default != nil {
+		if lit, ok := goLiteral(s.Default, t); ok {
+			add("godantic.Default[%s](%s)", t, lit)
+		}
+	}
+	return opts
+}
+
+// unionOption returns DiscriminatedUnion or Union of type t for a oneOf or
+// anyOf, or "" when a member can't be named
+func (w *goSourceWriter) unionOption(s *jsonschema.Schema, t string) string {
+	if s == nil {
+		return ""
+	}
+	if discriminator := asMap(s.Extras["discriminator"]); discriminator != nil {
+		propertyName, _ := discriminator["propertyName"].(string)
+		mapping := reflect.ValueOf(discriminator["mapping"])
+		if propertyName != "" && mapping.Kind() == reflect.Map && mapping.Len() > 0 {
+			var variants []string
+			for _, key := range mapping.MapKeys() {
+				ref, _ := mapping.MapIndex(key).Interface().(string)
+				if ref == "" {
+					return ""
+				}
+				variants = append(variants, fmt.Sprintf("%q: %s{}", key.String(), goIdentifier(refName(ref))))
+			}
+			slices.Sort(variants)
+			return fmt.Sprintf("godantic.DiscriminatedUnion[%s](%q, map[string]any{\n%s,\n})", t, propertyName, strings.Join(variants, ",\n"))
+		}
+	}
+
+	members := firstNonZero(s.AnyOf, s.OneOf)
+	if len(members) == 0 {
+		return ""
+	}
+	args := make([]string, len(members))
+	for i, member := range members {
+		switch {
+		case member.Ref != "":
+			args[i] = goIdentifier(refName(member.Ref)) + "{}"
+		case member.Type != "" && member.Type != "object" && member.Type != "array":
+			args[i] = strconv.Quote(member.Type)
+		default:
+			return ""
+		}
+	}
+	return fmt.Sprintf("godantic.Union[%s](%s)", t, strings.Join(args, ", "))
+}
+
+// goType returns the Go type of s, declaring inline objects as structs named hint
+func (w *goSourceWriter) goType(s *jsonschema.Schema, hint string) string {
+	switch {
+	case s == nil || s == jsonschema.TrueSchema:
+		return "any"
+	case s.Ref != "":
+		return goIdentifier(refName(s.Ref))
+	case len(s.AnyOf) > 0 || len(s.OneOf) > 0 || len(s.AllOf) > 0:
+		return "any"
+	}
+
+	typ := s.Type
+	if typ == "" {
+		switch value := firstNonZero(s.Const, firstOf(s.Enum)).(type) {
+		case string:
+			typ = "string"
+		case bool:
+			typ = "boolean"
+		case nil:
+		default:
+			if f, ok := toFloat(value); ok && f == math.Trunc(f) {
+				typ = "integer"
+			} else if ok {
+				typ = "number"
+			}
+		}
+	}
+
+	switch typ {
+	case "string":
+		if s.Format == "date-time" {
+			w.usesTime = true
+			return "time.Time"
+		}
+		return "string"
+	case "integer":
+		return "int"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "array":
+		return "[]" + w.goType(s.Items, hint+"Item")
+	case "object":
+		if hasProperties(s) {
+			return w.declare(hint, s)
+		}
+		if s.AdditionalProperties != nil && s.AdditionalProperties != jsonschema.FalseSchema {
+			return "map[string]" + w.goType(s.AdditionalProperties, hint+"Value")
+		}
+		return "map[string]any"
+	}
+	return "any"
+}
+
+// isStruct reports whether s becomes a struct: an object with properties,
+// inline or in $defs
+func (w *goSourceWriter) isStruct(s *jsonschema.Schema) bool {
+	s = w.resolve(s)
+	return s != nil && hasProperties(s)
+}
+
+// resolve returns the definition a $ref in s points at, or s itself
+func (w *goSourceWriter) resolve(s *jsonschema.Schema) *jsonschema.Schema {
+	if s == nil || s.Ref == "" {
+		return s
+	}
+	return w.defs[refName(s.Ref)]
+}
+
+// splitNullable unwraps an anyOf of a schema and null, as written by Nullable
+func splitNullable(s *jsonschema.Schema) (*jsonschema.Schema, bool) {
+	if len(s.AnyOf) != 2 {
+		return s, false
+	}
+	for i, member := range s.AnyOf {
+		if member != nil && member.Type == "null" && s.AnyOf[1-i] != nil {
+			return s.AnyOf[1-i], true
+		}
+	}
+	return s, false
+}
+
+func hasProperties(s *jsonschema.Schema) bool {
+	return s.Properties != nil && s.Properties.Len() > 0
+}
+
+// refName returns the definition name a $ref points at
+func refName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+// firstNonZero returns a unless it is the zero value, else b
+func firstNonZero[T any](a, b T) T {
+	if reflect.ValueOf(&a).Elem().IsZero() {
+		return b
+	}
+	return a
+}
+
+func firstOf(values []any) any {
+	if len(values) == 0 {
+		return nil
+	}
+	return values[0]
+}
+
+// isGoScalar reports whether t is a basic type GoSource writes
+func isGoScalar(t string) bool {
+	switch t {
+	case "string", "int", "float64", "bool":
+		return true
+	}
+	return false
+}
+
+// goLiteral returns value as a literal of the Go type t, for basic types only
+func goLiteral(value any, t string) (string, bool) {
+	switch t {
+	case "string":
+		s, ok := value.(string)
+		return strconv.Quote(s), ok
+	case "bool":
+		b, ok := value.(bool)
+		return strconv.FormatBool(b), ok
+	case "int", "float64":
+		f, ok := toFloat(value)
+		if !ok || (t == "int" && f != math.Trunc(f)) {
+			return "", false
+		}
+		return strconv.FormatFloat(f, 'f', -1, 64), true
+	}
+	return "", false
+}
+
+// goLiterals returns values as literals of the Go type t
+func goLiterals(values any, t string) ([]string, bool) {
+	var lits []string
+	for _, value := range toAnySlice(values) {
+		lit, ok := goLiteral(value, t)
+		if !ok {
+			return nil, false
+		}
+		lits = append(lits, lit)
+	}
+	return lits, true
+}
+
+// goRegexLiteral quotes a pattern as a raw string when it can
+func goRegexLiteral(pattern string) string {
+	if strings.Contains(pattern, "`") {
+		return strconv.Quote(pattern)
+	}
+	return "`" + pattern + "`"
+}
+
+// goIdentifier turns a $defs name into an exported identifier, like tsIdentifier
+func goIdentifier(name string) string {
+	ident := strings.ReplaceAll(tsIdentifier(name), "$", "_")
+	r := []rune(ident)
+	if r[0] == '_' && len(r) > 1 {
+		r = r[1:]
+	}
+	r[0] = unicode.ToUpper(r[0])
+	if !unicode.IsUpper(r[0]) {
+		return "X" + string(r)
+	}
+	return string(r)
+}
+
+// goInitialisms are the name parts GoSource writes in capitals, as Go style does
+var goInitialisms = map[string]bool{
+	"api": true, "html": true, "http": true, "https": true, "id": true, "ip": true,
+	"json": true, "sql": true, "uri": true, "url": true, "uuid": true, "xml": true,
+}
+
+// goFieldName turns a property name such as "created_at" or "userId" into an
+// exported field name such as CreatedAt or UserID
+func goFieldName(key string) string {
+	var parts []string
+	var part []rune
+	flush := func() {
+		if len(part) > 0 {
+			parts = append(parts, string(part))
+			part = nil
+		}
+	}
+	for i, r := range key {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			flush()
+		case unicode.IsUpper(r) && i > 0 && len(part) > 0 && !unicode.IsUpper(part[len(part)-1]):
+			flush()
+			part = append(part, r)
+		default:
+			part = append(part, r)
+		}
+	}
+	flush()
+
+	var name strings.Builder
+	for _, p := range parts {
+		if goInitialisms[strings.ToLower(p)] {
+			name.WriteString(strings.ToUpper(p))
+			continue
+		}
+		r := []rune(p)
+		r[0] = unicode.ToUpper(r[0])
+		name.WriteString(string(r))
+	}
+	if name.Len() == 0 {
+		return "Field"
+	}
+	if s := name.String(); !unicode.IsLetter([]rune(s)[0]) {
+		return "X" + s
+	}
+	return name.String()
+}
@@ -0,0 +1,278 @@
+package schema_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+	"github.com/deepankarm/godantic/pkg/godantic/schema"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════
+// Go source from JSON Schema
+// ═══════════════════════════════════════════════════════════════════════════
+
+type GSOrder struct {
+	ID        string                    `json:"id"`
+	Status    string                    `json:"status"`
+	Quantity  int                       `json:"quantity"`
+	Price     float64                   `json:"price"`
+	Note      *string                   `json:"note,omitempty"`
+	Tags      []string                  `json:"tags"`
+	Customer  GSCustomer                `json:"customer"`
+	Shipping  *GSCustomer               `json:"shipping,omitempty"`
+	Coupon    godantic.Optional[string] `json:"coupon,omitzero"`
+	CreatedAt time.Time                 `json:"created_at"`
+	Payment   any                       `json:"payment"`
+}
+
+func (o *GSOrder) FieldID() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Required[string](), godantic.Regex(`^ord_\d+$`), godantic.Description[string]("Order identifier"))
+}
+
+func (o *GSOrder) FieldStatus() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.OneOf("pending", "paid"), godantic.Default("pending"))
+}
+
+func (o *GSOrder) FieldQuantity() godantic.FieldOptions[int] {
+	return godantic.Field(godantic.Min(1), godantic.Max(100))
+}
+
+func (o *GSOrder) FieldPrice() godantic.FieldOptions[float64] {
+	return godantic.Field(godantic.ExclusiveMin(0.0))
+}
+
+func (o *GSOrder) FieldNote() godantic.FieldOptions[*string] {
+	return godantic.Field(godantic.Nullable[*string]())
+}
+
+func (o *GSOrder) FieldTags() godantic.FieldOptions[[]string] {
+	return godantic.Field(godantic.MaxItems[string](5), godantic.UniqueItems[string]())
+}
+
+func (o *GSOrder) FieldCoupon() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.MaxLen(12))
+}
+
+func (o *GSOrder) FieldPayment() godantic.FieldOptions[any] {
+	return godantic.Field(godantic.DiscriminatedUnion[any]("method", map[string]any{
+		"card": GSCard{},
+		"bank": GSBank{},
+	}))
+}
+
+type GSCustomer struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+func (c *GSCustomer) FieldEmail() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Format[string]("email"))
+}
+
+type GSCard struct {
+	Method string `json:"method"`
+	Last4  string `json:"last4"`
+}
+
+type GSBank struct {
+	Method string `json:"method"`
+	IBAN   string `json:"iban"`
+}
+
+func TestGoSource(t *testing.T) {
+	s, err := schema.NewGenerator[GSOrder]().Generate()
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	got, err := schema.GoSource(s, "orders")
+	if err != nil {
+		t.Fatalf("GoSource failed: %v", err)
+	}
+	assertGolden(t, "order.go.golden", got)
+}
+
+func TestGoSourceFromFile(t *testing.T) {
+	data, err := os.ReadFile("testdata/shelter.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := schema.Parse(data)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	got, err := schema.GoSource(s, "shelter")
+	if err != nil {
+		t.Fatalf("GoSource failed: %v", err)
+	}
+	assertGolden(t, "shelter.go.golden", got)
+}
+
+// roundTripMain prints the schema generated from the Shelter struct GoSource wrote
+const roundTripMain = `package main
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/deepankarm/godantic/pkg/godantic/schema"
+)
+
+func main() {
+	s, err := schema.NewGenerator[Shelter]().Generate()
+	if err != nil {
+		panic(err)
+	}
+	if err := json.NewEncoder(os.Stdout).Encode(s); err != nil {
+		panic(err)
+	}
+}
+`
+
+// TestGoSourceRoundTrip compiles the Go source of shelter.json and checks that
+// the schema generated from it keeps every keyword of the input
+func TestGoSourceRoundTrip(t *testing.T) {
+	if testing.Short() {
+		t.Skip("compiles the generated source")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go tool not found")
+	}
+
+	data, err := os.ReadFile("testdata/shelter.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := schema.Parse(data)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	src, err := schema.GoSource(s, "main")
+	if err != nil {
+		t.Fatalf("GoSource failed: %v", err)
+	}
+
+	// Inside the module, so the generated package can import godantic
+	dir, err := os.MkdirTemp("testdata", "roundtrip")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	if err := os.WriteFile(filepath.Join(dir, "shelter.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "main.go"), []byte(roundTripMain), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.Command(goTool, "run", "./"+filepath.ToSlash(dir))
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("running the generated source failed: %v\n%s\n%s", err, stderr.String(), src)
+	}
+
+	var want, got map[string]any
+	if err := json.Unmarshal(data, &want); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(out, &got); err != nil {
+		t.Fatalf("decoding the regenerated schema: %v\n%s", err, out)
+	}
+	// The root title names the struct rather than being written back
+	delete(want, "title")
+	// Refs are compared by what they point at: the regenerated schema has its
+	// root in $defs and the slice union inline
+	assertKeeps(t, "#", inlineRefs(want, want), inlineRefs(got, got))
+}
+
+// inlineRefs replaces each $ref of node with the definition of root it points
+// at, keeping the keywords next to it, and drops $defs
+func inlineRefs(node any, root map[string]any) any {
+	switch n := node.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(n))
+		if ref, ok := n["$ref"].(string); ok {
+			defs, _ := root["$defs"].(map[string]any)
+			def, _ := defs[strings.TrimPrefix(ref, "#/$defs/")].(map[string]any)
+			for k, v := range inlineRefs(def, root).(map[string]any) {
+				out[k] = v
+			}
+		}
+		for k, v := range n {
+			if k != "$ref" && k != "$defs" {
+				out[k] = inlineRefs(v, root)
+			}
+		}
+		return out
+	case []any:
+		out := make([]any, len(n))
+		for i, v := range n {
+			out[i] = inlineRefs(v, root)
+		}
+		return out
+	}
+	return node
+}
+
+// assertKeeps checks that got has every keyword of want with the same value;
+// keywords want doesn't have, such as generated titles, are allowed
+func assertKeeps(t *testing.T, path string, want, got any) {
+	t.Helper()
+	switch w := want.(type) {
+	case map[string]any:
+		g, ok := got.(map[string]any)
+		if !ok {
+			t.Errorf("%s: expected an object, got %v", path, got)
+			return
+		}
+		for k, v := range w {
+			if _, ok := g[k]; !ok {
+				t.Errorf("%s: %s was lost", path, k)
+				continue
+			}
+			assertKeeps(t, path+"/"+k, v, g[k])
+		}
+	case []any:
+		g, ok := got.([]any)
+		if !ok || len(g) != len(w) {
+			t.Errorf("%s: expected %v, got %v", path, want, got)
+			return
+		}
+		for i := range w {
+			assertKeeps(t, path+"/"+strconv.Itoa(i), w[i], g[i])
+		}
+	default:
+		if !reflect.DeepEqual(want, got) {
+			t.Errorf("%s: expected %v, got %v", path, want, got)
+		}
+	}
+}
+
+func TestParseKeepsExtras(t *testing.T) {
+	s, err := schema.Parse([]byte(`{
+		"properties": {
+			"pet": {"oneOf": [{"$ref": "#/$defs/Cat"}], "discriminator": {"propertyName": "kind"}, "x-order": 1}
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	pet, _ := s.Properties.Get("pet")
+	if pet == nil || pet.Extras["discriminator"] == nil || pet.Extras["x-order"] == nil {
+		t.Errorf("expected discriminator and x-order in Extras, got %+v", pet)
+	}
+	if _, ok := pet.Extras["oneOf"]; ok {
+		t.Error("known keywords must not be copied to Extras")
+	}
+}
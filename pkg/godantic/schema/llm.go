@@ -10,7 +10,8 @@ import "encoding/json"
 //
 // Applies the following transformations:
 //   - Wraps root-level anyOf/oneOf/allOf in a "response" property (root must be type: object)
-//   - Sets all properties as required (strict mode mandate)
+//   - Sets all properties as required (strict mode mandate), making optional enum
+//     properties nullable so the model can still leave them unset
 //   - Sets additionalProperties: false on all objects
 //   - Strips sibling properties (description, title) from $ref nodes ($ref + siblings is invalid)
 //   - Strips null defaults
@@ -82,7 +83,7 @@ func transformForOpenAIWithOptions(schema map[string]any, opts openAITransformOp
 }
 
 // ensureStrictSchema recursively enforces OpenAI strict mode constraints:
-//   - all properties listed in required
+//   - all properties listed in required, optional enums made nullable
 //   - additionalProperties: false on all objects
 //   - $ref sibling properties stripped (OpenAI rejects $ref with description/title)
 //   - null defaults stripped
@@ -100,9 +101,13 @@ func ensureStrictSchema(node any) {
 
 		if v["type"] == "object" {
 			if props, ok := v["properties"].(map[string]any); ok {
+				required := requiredSet(v["required"])
 				propNames := make([]string, 0, len(props))
-				for name := range props {
+				for name, propSchema := range props {
 					propNames = append(propNames, name)
+					if prop, ok := propSchema.(map[string]any); ok && !required[name] {
+						props[name] = nullableEnum(prop)
+					}
 				}
 				v["required"] = propNames
 				v["additionalProperties"] = false
@@ -154,6 +159,41 @@ func ensureStrictSchema(node any) {
 	}
 }
 
+// requiredSet returns the names in a schema's required list
+func requiredSet(required any) map[string]bool {
+	set := make(map[string]bool)
+	switch names := required.(type) {
+	case []string:
+		for _, name := range names {
+			set[name] = true
+		}
+	case []any:
+		for _, name := range names {
+			if s, ok := name.(string); ok {
+				set[s] = true
+			}
+		}
+	}
+	return set
+}
+
+// nullableEnum wraps an optional enum property in anyOf with null. Strict mode
+// requires every property, and unlike a plain string an enum has no empty
+// value the model could use for "not set".
+func nullableEnum(prop map[string]any) map[string]any {
+	if _, hasEnum := prop["enum"]; !hasEnum {
+		return prop
+	}
+	wrapped := map[string]any{
+		"anyOf": []any{prop, map[string]any{"type": "null"}},
+	}
+	if title, ok := prop["title"]; ok {
+		wrapped["title"] = title
+		delete(prop, "title")
+	}
+	return wrapped
+}
+
 func hasSiblingKeys(node map[string]any) bool {
 	for key := range node {
 		if key != "$ref" {
@@ -0,0 +1,90 @@
+package schema_test
+
+import (
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+	"github.com/deepankarm/godantic/pkg/godantic/schema"
+)
+
+type MapEntry struct {
+	Label string `json:"label"`
+}
+
+type MapHolder struct {
+	Counts  map[string]int      `json:"counts"`
+	Labels  map[string]string   `json:"labels"`
+	Entries map[string]MapEntry `json:"entries"`
+}
+
+func (m *MapHolder) FieldCounts() godantic.FieldOptions[map[string]int] {
+	return godantic.Field(
+		godantic.MinPropertiesOf[int](1),
+		godantic.MaxPropertiesOf[int](10),
+	)
+}
+
+func (m *MapHolder) FieldLabels() godantic.FieldOptions[map[string]string] {
+	return godantic.Field(godantic.MapKeyPattern[string](`^[a-z]{2}-[A-Z]{2}$`))
+}
+
+func (m *MapHolder) FieldEntries() godantic.FieldOptions[map[string]MapEntry] {
+	return godantic.Field(godantic.MaxPropertiesOf[MapEntry](5))
+}
+
+func TestMapSchemas(t *testing.T) {
+	flat, err := schema.NewGenerator[MapHolder]().GenerateFlattened()
+	if err != nil {
+		t.Fatalf("failed to generate schema: %v", err)
+	}
+	props := flat["properties"].(map[string]any)
+	prop := func(name string) map[string]any {
+		p := props[name].(map[string]any)
+		if p["type"] != "object" {
+			t.Errorf("expected %s to be an object, got %v", name, p)
+		}
+		return p
+	}
+
+	t.Run("map[string]int", func(t *testing.T) {
+		counts := prop("counts")
+		if values, _ := counts["additionalProperties"].(map[string]any); values["type"] != "integer" {
+			t.Errorf("expected integer values, got %v", counts["additionalProperties"])
+		}
+		if counts["minProperties"] != float64(1) || counts["maxProperties"] != float64(10) {
+			t.Errorf("expected minProperties 1 and maxProperties 10, got %v", counts)
+		}
+	})
+
+	t.Run("map[string]string", func(t *testing.T) {
+		labels := prop("labels")
+		if values, _ := labels["additionalProperties"].(map[string]any); values["type"] != "string" {
+			t.Errorf("expected string values, got %v", labels["additionalProperties"])
+		}
+		names, _ := labels["propertyNames"].(map[string]any)
+		if names["pattern"] != `^[a-z]{2}-[A-Z]{2}$` {
+			t.Errorf("expected propertyNames.pattern, got %v", labels["propertyNames"])
+		}
+
+		data := []byte(`{"counts": {"a": 1}, "entries": {}, "labels": {"en-US": "Hello", "english": "Hello"}}`)
+		errs := godantic.ValidateAgainstSchema(flat, data)
+		if len(errs) != 1 || errs[0].Path() != "labels.english" {
+			t.Errorf("expected the schema to reject the key, got %v", errs)
+		}
+	})
+
+	t.Run("map[string]struct", func(t *testing.T) {
+		entries := prop("entries")
+		values, _ := entries["additionalProperties"].(map[string]any)
+		if values["$ref"] != "#/$defs/MapEntry" {
+			t.Errorf("expected values to reference MapEntry, got %v", entries["additionalProperties"])
+		}
+		if entries["maxProperties"] != float64(5) {
+			t.Errorf("expected maxProperties 5, got %v", entries["maxProperties"])
+		}
+		defs := flat["$defs"].(map[string]any)
+		if _, ok := defs["MapEntry"]; !ok {
+			t.Errorf("expected MapEntry in $defs, got %v", defs)
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package schema_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+	"github.com/deepankarm/godantic/pkg/godantic/schema"
+)
+
+type ReservedNames struct {
+	Username string `json:"username"`
+	Slug     string `json:"slug"`
+	Handle   string `json:"handle"`
+}
+
+func (r *ReservedNames) FieldUsername() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.NotOneOf("admin", "root"))
+}
+
+func (r *ReservedNames) FieldSlug() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.NotRegex(`^api/`))
+}
+
+func (r *ReservedNames) FieldHandle() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.NotOneOf("me"), godantic.NotRegex(`^_`))
+}
+
+func TestNotSchema(t *testing.T) {
+	flat, err := schema.NewGenerator[ReservedNames]().GenerateFlattened()
+	if err != nil {
+		t.Fatalf("failed to generate schema: %v", err)
+	}
+	props := flat["properties"].(map[string]any)
+
+	tests := []struct {
+		field string
+		want  map[string]any
+	}{
+		{"username", map[string]any{"enum": []any{"admin", "root"}}},
+		{"slug", map[string]any{"pattern": "^api/"}},
+		{"handle", map[string]any{"anyOf": []any{
+			map[string]any{"pattern": "^_"},
+			map[string]any{"enum": []any{"me"}},
+		}}},
+	}
+	for _, tt := range tests {
+		prop := props[tt.field].(map[string]any)
+		if prop["type"] != "string" {
+			t.Errorf("%s: expected the type to stay, got %v", tt.field, prop)
+		}
+		if !reflect.DeepEqual(prop["not"], tt.want) {
+			t.Errorf("%s: not = %v, want %v", tt.field, prop["not"], tt.want)
+		}
+	}
+
+	if errs := godantic.ValidateAgainstSchema(flat, []byte(`{"username": "root", "slug": "docs", "handle": "_x"}`)); len(errs) != 2 {
+		t.Errorf("expected the schema to reject username and handle, got %v", errs)
+	}
+}
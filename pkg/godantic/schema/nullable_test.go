@@ -242,3 +242,80 @@ func TestNullableSchemaJSON(t *testing.T) {
 		})
 	}
 }
+
+// PointerTask has pointer fields without explicit Nullable options
+type PointerTask struct {
+	Title    string         `json:"title"`
+	Assignee *string        `json:"assignee"`
+	Estimate *int           `json:"estimate"`
+	Location *PointerNested `json:"location"`
+}
+
+type PointerNested struct {
+	City string `json:"city"`
+}
+
+func (p *PointerTask) FieldAssignee() godantic.FieldOptions[*string] {
+	return godantic.Field(godantic.Description[*string]("Person assigned to the task"))
+}
+
+func TestNullablePointers(t *testing.T) {
+	t.Run("off by default", func(t *testing.T) {
+		s, err := schema.NewGenerator[PointerTask]().Generate()
+		if err != nil {
+			t.Fatalf("failed to generate schema: %v", err)
+		}
+		def := s.Definitions["PointerTask"]
+		for _, name := range []string{"assignee", "estimate", "location"} {
+			if prop, _ := def.Properties.Get(name); prop.AnyOf != nil {
+				t.Errorf("expected %s to stay non-nullable, got anyOf %v", name, prop.AnyOf)
+			}
+		}
+	})
+
+	flat, err := schema.NewGenerator[PointerTask]().
+		WithOptions(schema.SchemaOptions{AutoGenerateTitles: true, NullablePointers: true}).
+		GenerateFlattened()
+	if err != nil {
+		t.Fatalf("failed to generate schema: %v", err)
+	}
+	props := flat["properties"].(map[string]any)
+
+	tests := []struct {
+		fieldName string
+		wantInner map[string]any
+	}{
+		{"assignee", map[string]any{"type": "string", "description": "Person assigned to the task"}},
+		{"estimate", map[string]any{"type": "integer"}},
+		{"location", map[string]any{"$ref": "#/$defs/PointerNested"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fieldName, func(t *testing.T) {
+			field := props[tt.fieldName].(map[string]any)
+			anyOf, ok := field["anyOf"].([]any)
+			if !ok || len(anyOf) != 2 {
+				t.Fatalf("expected anyOf with 2 options, got %v", field)
+			}
+			inner := anyOf[0].(map[string]any)
+			for key, want := range tt.wantInner {
+				if inner[key] != want {
+					t.Errorf("expected inner %s=%v, got %v", key, want, inner[key])
+				}
+			}
+			if anyOf[1].(map[string]any)["type"] != "null" {
+				t.Errorf("expected second option type 'null', got %v", anyOf[1])
+			}
+			if field["title"] == nil || inner["title"] != nil {
+				t.Errorf("expected the title on the wrapper only, got %v", field)
+			}
+		})
+	}
+
+	if props["title"].(map[string]any)["anyOf"] != nil {
+		t.Error("expected non-pointer field to stay non-nullable")
+	}
+	required, _ := flat["required"].([]any)
+	if len(required) != 1 || required[0] != "title" {
+		t.Errorf("expected only title to be required, got %v", required)
+	}
+}
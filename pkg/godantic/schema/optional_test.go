@@ -0,0 +1,43 @@
+package schema_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+	"github.com/deepankarm/godantic/pkg/godantic/schema"
+)
+
+type OptionalProfilePatch struct {
+	Age      godantic.Optional[int]    `json:"age,omitzero"`
+	Nickname godantic.Optional[string] `json:"nickname,omitzero"`
+	ID       string                    `json:"id"`
+}
+
+func (p *OptionalProfilePatch) FieldAge() godantic.FieldOptions[int] {
+	return godantic.Field(godantic.Min(0), godantic.Max(150))
+}
+
+func TestOptionalSchema(t *testing.T) {
+	flat, err := schema.NewGenerator[OptionalProfilePatch]().GenerateFlattened()
+	if err != nil {
+		t.Fatalf("failed to generate schema: %v", err)
+	}
+	props := flat["properties"].(map[string]any)
+
+	age := props["age"].(map[string]any)
+	if age["type"] != "integer" || age["minimum"] != float64(0) || age["maximum"] != float64(150) {
+		t.Errorf("expected age as a constrained integer, got %v", age)
+	}
+	if nickname := props["nickname"].(map[string]any); nickname["type"] != "string" {
+		t.Errorf("expected nickname as a string, got %v", nickname)
+	}
+
+	required, _ := flat["required"].([]any)
+	if !slices.Contains(required, any("id")) {
+		t.Errorf("expected id to be required, got %v", required)
+	}
+	if slices.Contains(required, any("age")) || slices.Contains(required, any("nickname")) {
+		t.Errorf("expected Optional fields not to be required, got %v", required)
+	}
+}
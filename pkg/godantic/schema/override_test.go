@@ -0,0 +1,62 @@
+package schema_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+	"github.com/deepankarm/godantic/pkg/godantic/schema"
+)
+
+type OverrideForecast struct {
+	City  string  `json:"city"`
+	Units *string `json:"units"`
+}
+
+func (f *OverrideForecast) FieldCity() godantic.FieldOptions[string] {
+	return godantic.Field(
+		godantic.Required[string](),
+		godantic.MinLen(3),
+		godantic.Description[string]("Generated description"),
+		godantic.SchemaOverride[string](map[string]any{
+			"type":       "string",
+			"x-llm-hint": "a city name, e.g. Paris",
+		}),
+	)
+}
+
+func (f *OverrideForecast) FieldUnits() godantic.FieldOptions[*string] {
+	return godantic.Field(godantic.SchemaOverride[*string](map[string]any{"enum": []string{"metric", "imperial"}}))
+}
+
+func TestSchemaOverride(t *testing.T) {
+	opts := schema.DefaultSchemaOptions()
+	opts.NullablePointers = true
+	s, err := schema.NewGenerator[OverrideForecast]().WithOptions(opts).GenerateFlattened()
+	if err != nil {
+		t.Fatalf("failed to generate schema: %v", err)
+	}
+	props := s["properties"].(map[string]any)
+
+	raw, _ := json.Marshal(props["city"])
+	if want := `{"type":"string","x-llm-hint":"a city name, e.g. Paris"}`; string(raw) != want {
+		t.Errorf("expected the override verbatim, got %s", raw)
+	}
+	raw, _ = json.Marshal(props["units"])
+	if want := `{"enum":["metric","imperial"]}`; string(raw) != want {
+		t.Errorf("expected the override instead of a nullable wrapper, got %s", raw)
+	}
+	raw, _ = json.Marshal(s["required"])
+	if string(raw) != `["city"]` {
+		t.Errorf("expected city to stay required, got %s", raw)
+	}
+
+	// The Go constraints still validate the field
+	validator := godantic.NewValidator[OverrideForecast]()
+	if errs := validator.Validate(&OverrideForecast{City: "Paris"}); len(errs) != 0 {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+	if errs := validator.Validate(&OverrideForecast{City: "NY"}); len(errs) != 1 {
+		t.Errorf("expected MinLen to fail, got %v", errs)
+	}
+}
@@ -0,0 +1,36 @@
+package schema_test
+
+import (
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+	"github.com/deepankarm/godantic/pkg/godantic/schema"
+)
+
+type PresetAccount struct {
+	Email string `json:"email"`
+}
+
+func (a *PresetAccount) FieldEmail() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Preset[string]("schema-email100"))
+}
+
+func TestPresetInSchema(t *testing.T) {
+	godantic.RegisterPreset("schema-email100", godantic.Email(), godantic.MaxLen(100))
+	t.Cleanup(func() { godantic.RegisterPreset[string]("schema-email100") })
+
+	s, err := schema.NewGenerator[PresetAccount]().Generate()
+	if err != nil {
+		t.Fatalf("failed to generate schema: %v", err)
+	}
+	prop, ok := s.Definitions["PresetAccount"].Properties.Get("email")
+	if !ok {
+		t.Fatal("email property not found")
+	}
+	if prop.Pattern == "" {
+		t.Error("expected the email pattern")
+	}
+	if prop.MaxLength == nil || *prop.MaxLength != 100 {
+		t.Errorf("expected maxLength 100, got %v", prop.MaxLength)
+	}
+}
@@ -0,0 +1,31 @@
+package schema_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/godantic/schema"
+)
+
+type RawPluginConfig struct {
+	Plugin  string           `json:"plugin"`
+	Payload json.RawMessage  `json:"payload"`
+	Extra   *json.RawMessage `json:"extra"`
+}
+
+func TestRawMessageSchema(t *testing.T) {
+	flat, err := schema.NewGenerator[RawPluginConfig]().GenerateFlattened()
+	if err != nil {
+		t.Fatalf("failed to generate schema: %v", err)
+	}
+	props := flat["properties"].(map[string]any)
+	for _, name := range []string{"payload", "extra"} {
+		prop := props[name].(map[string]any)
+		if _, typed := prop["type"]; typed {
+			t.Errorf("expected %s to accept any JSON, got %v", name, prop)
+		}
+		if _, hasItems := prop["items"]; hasItems {
+			t.Errorf("expected %s not to be described as a byte array, got %v", name, prop)
+		}
+	}
+}
@@ -1,11 +1,13 @@
 package schema_test
 
 import (
+	"reflect"
 	"slices"
 	"testing"
 
 	"github.com/deepankarm/godantic/pkg/godantic"
 	"github.com/deepankarm/godantic/pkg/godantic/schema"
+	"github.com/invopop/jsonschema"
 )
 
 // TestAutoRequiredNonPointerFields tests that non-pointer struct fields are automatically
@@ -182,3 +184,171 @@ func TestNestedStructRequired(t *testing.T) {
 		t.Errorf("non-pointer field 'value' in InnerStruct should be in required array, but required=%v", innerSchema.Required)
 	}
 }
+
+// IgnoredFieldsStruct has Field methods for fields encoding/json never serializes
+type IgnoredFieldsStruct struct {
+	Name     string `json:"name"`
+	Computed string `json:"-"`
+	internal int
+}
+
+func (IgnoredFieldsStruct) FieldComputed() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Required[string](), godantic.Default("x"))
+}
+
+func (IgnoredFieldsStruct) FieldInternal() godantic.FieldOptions[int] {
+	return godantic.Field(godantic.Required[int](), godantic.Min(1))
+}
+
+func TestIgnoredFieldsExcludedFromSchema(t *testing.T) {
+	s, err := schema.NewGenerator[IgnoredFieldsStruct]().Generate()
+	if err != nil {
+		t.Fatalf("failed to generate schema: %v", err)
+	}
+
+	actualSchema := s.Definitions["IgnoredFieldsStruct"]
+	if actualSchema == nil {
+		t.Fatal("IgnoredFieldsStruct definition not found")
+	}
+	for _, name := range []string{"Computed", "-", "internal"} {
+		if _, ok := actualSchema.Properties.Get(name); ok {
+			t.Errorf("ignored field %q should not be a property", name)
+		}
+	}
+	if !slices.Equal(actualSchema.Required, []string{"name"}) {
+		t.Errorf("expected required=[name], got %v", actualSchema.Required)
+	}
+}
+
+// NonEmptyForm uses RequiredNonEmpty on a string, a slice, a map and a pointer
+type NonEmptyForm struct {
+	Name     string            `json:"name"`
+	Tags     []string          `json:"tags"`
+	Attrs    map[string]string `json:"attrs"`
+	Nickname *string           `json:"nickname"`
+}
+
+func (f *NonEmptyForm) FieldName() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.RequiredNonEmpty[string]())
+}
+
+func (f *NonEmptyForm) FieldTags() godantic.FieldOptions[[]string] {
+	return godantic.Field(godantic.RequiredNonEmpty[[]string]())
+}
+
+func (f *NonEmptyForm) FieldAttrs() godantic.FieldOptions[map[string]string] {
+	return godantic.Field(godantic.RequiredNonEmpty[map[string]string]())
+}
+
+func (f *NonEmptyForm) FieldNickname() godantic.FieldOptions[*string] {
+	return godantic.Field(godantic.RequiredNonEmpty[*string]())
+}
+
+func TestRequiredNonEmptySchema(t *testing.T) {
+	s, err := schema.NewGenerator[NonEmptyForm]().Generate()
+	if err != nil {
+		t.Fatalf("failed to generate schema: %v", err)
+	}
+	def := s.Definitions["NonEmptyForm"]
+
+	for _, name := range []string{"name", "tags", "attrs", "nickname"} {
+		if !slices.Contains(def.Required, name) {
+			t.Errorf("expected %s to be required, got %v", name, def.Required)
+		}
+	}
+
+	prop := func(name string) *jsonschema.Schema {
+		p, _ := def.Properties.Get(name)
+		return p
+	}
+	if p := prop("name"); p.MinLength == nil || *p.MinLength != 1 {
+		t.Errorf("expected minLength 1 on name, got %v", p.MinLength)
+	}
+	if p := prop("nickname"); p.MinLength == nil || *p.MinLength != 1 {
+		t.Errorf("expected minLength 1 on nickname, got %v", p.MinLength)
+	}
+	if p := prop("tags"); p.MinItems == nil || *p.MinItems != 1 {
+		t.Errorf("expected minItems 1 on tags, got %v", p.MinItems)
+	}
+	if p := prop("attrs"); p.MinProperties == nil || *p.MinProperties != 1 {
+		t.Errorf("expected minProperties 1 on attrs, got %v", p.MinProperties)
+	}
+}
+
+type DependentPlan struct {
+	Type    string `json:"type"`
+	Seats   int    `json:"seats"`
+	Billing string `json:"billing"`
+	Coupon  string `json:"coupon"`
+}
+
+func (p *DependentPlan) Dependencies() []godantic.DependentRule {
+	return []godantic.DependentRule{
+		godantic.DependentRequired("type", "premium", "seats", "billing"),
+	}
+}
+
+type DependentPlans struct {
+	Type   string `json:"type"`
+	Seats  int    `json:"seats"`
+	Coupon string `json:"coupon"`
+}
+
+func (p *DependentPlans) Dependencies() []godantic.DependentRule {
+	return []godantic.DependentRule{
+		godantic.DependentRequired("type", "premium", "seats"),
+		godantic.DependentRequired("type", "promo", "coupon"),
+	}
+}
+
+func TestDependentRequiredSchema(t *testing.T) {
+	t.Run("single rule is if/then on the object", func(t *testing.T) {
+		s, err := schema.NewGenerator[DependentPlan]().Generate()
+		if err != nil {
+			t.Fatalf("failed to generate schema: %v", err)
+		}
+		def := s.Definitions["DependentPlan"]
+
+		if want := []string{"type", "coupon"}; !slices.Equal(def.Required, want) {
+			t.Errorf("expected unconditional required %v, got %v", want, def.Required)
+		}
+		if def.If == nil || def.Then == nil {
+			t.Fatal("expected if/then on the definition")
+		}
+		cond, ok := def.If.Properties.Get("type")
+		if !ok || cond.Const != "premium" || !slices.Equal(def.If.Required, []string{"type"}) {
+			t.Errorf("expected if type == premium, got %+v", def.If)
+		}
+		if want := []string{"seats", "billing"}; !slices.Equal(def.Then.Required, want) {
+			t.Errorf("expected then required %v, got %v", want, def.Then.Required)
+		}
+	})
+
+	t.Run("several rules go under allOf", func(t *testing.T) {
+		s, err := schema.NewGenerator[DependentPlans]().Generate()
+		if err != nil {
+			t.Fatalf("failed to generate schema: %v", err)
+		}
+		def := s.Definitions["DependentPlans"]
+		if def.If != nil || len(def.AllOf) != 2 {
+			t.Fatalf("expected two allOf conditions, got if=%v allOf=%v", def.If, def.AllOf)
+		}
+		if !slices.Equal(def.AllOf[1].Then.Required, []string{"coupon"}) {
+			t.Errorf("expected the second rule to require coupon, got %v", def.AllOf[1].Then.Required)
+		}
+	})
+
+	t.Run("schema validation enforces the rule", func(t *testing.T) {
+		schemaMap, err := schema.GenerateForType(reflect.TypeOf(DependentPlan{}))
+		if err != nil {
+			t.Fatalf("failed to generate schema: %v", err)
+		}
+		errs := godantic.ValidateAgainstSchema(schemaMap, []byte(`{"type": "premium", "seats": 2, "coupon": ""}`))
+		if len(errs) != 1 || !slices.Equal(errs[0].Loc, []string{"billing"}) || errs[0].Type != godantic.ErrorTypeRequired {
+			t.Errorf("expected billing to be required, got %v", errs)
+		}
+		if errs := godantic.ValidateAgainstSchema(schemaMap, []byte(`{"type": "free", "coupon": ""}`)); len(errs) != 0 {
+			t.Errorf("expected no errors, got %v", errs)
+		}
+	})
+}
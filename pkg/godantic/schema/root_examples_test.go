@@ -0,0 +1,75 @@
+package schema_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+	"github.com/deepankarm/godantic/pkg/godantic/schema"
+)
+
+type ExampleAnswer struct {
+	Text       string  `json:"text"`
+	Confidence float64 `json:"confidence"`
+}
+
+func (a *ExampleAnswer) FieldText() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Required[string](), godantic.MinLen(1))
+}
+
+func (a *ExampleAnswer) FieldConfidence() godantic.FieldOptions[float64] {
+	return godantic.Field(godantic.Min(0.0), godantic.Max(1.0))
+}
+
+func TestRootExamples(t *testing.T) {
+	valid := ExampleAnswer{Text: "Paris", Confidence: 0.9}
+
+	t.Run("valid example is embedded", func(t *testing.T) {
+		s, err := schema.NewGenerator[ExampleAnswer]().WithExamples(valid).Generate()
+		if err != nil {
+			t.Fatalf("failed to generate schema: %v", err)
+		}
+		if len(s.Examples) != 1 || s.Examples[0] != valid {
+			t.Errorf("expected the root examples, got %v", s.Examples)
+		}
+		if got := s.Definitions["ExampleAnswer"].Examples; len(got) != 1 {
+			t.Errorf("expected the examples on the root definition, got %v", got)
+		}
+
+		flat, err := schema.NewGenerator[ExampleAnswer]().WithExamples(valid).GenerateFlattened()
+		if err != nil {
+			t.Fatalf("failed to generate schema: %v", err)
+		}
+		examples, _ := flat["examples"].([]any)
+		if len(examples) != 1 || examples[0].(map[string]any)["text"] != "Paris" {
+			t.Errorf("expected the flattened examples, got %v", flat["examples"])
+		}
+	})
+
+	t.Run("invalid example errors", func(t *testing.T) {
+		invalid := ExampleAnswer{Text: "Paris", Confidence: 1.5}
+		_, err := schema.NewGenerator[ExampleAnswer]().WithExamples(valid, invalid).Generate()
+		if err == nil || !strings.Contains(err.Error(), "example 1 does not match the schema") {
+			t.Fatalf("expected the second example to be rejected, got %v", err)
+		}
+		if !strings.Contains(err.Error(), "confidence") {
+			t.Errorf("expected the error to name the field, got %v", err)
+		}
+	})
+
+	t.Run("options", func(t *testing.T) {
+		s, err := schema.GenerateWithOptions[ExampleAnswer](schema.Options{
+			Examples: []any{map[string]any{"text": "Yes", "confidence": 1}},
+		})
+		if err != nil || len(s.Examples) != 1 {
+			t.Fatalf("expected one example, got %v (err %v)", s, err)
+		}
+
+		_, err = schema.GenerateWithOptions[ExampleAnswer](schema.Options{
+			Examples: []any{map[string]any{"text": "", "confidence": 0.5}},
+		})
+		if err == nil {
+			t.Error("expected an example with an empty text to be rejected")
+		}
+	})
+}
@@ -4,16 +4,35 @@ import (
 	"encoding/json"
 	"fmt"
 	"maps"
+	"mime/multipart"
 	"reflect"
+	"slices"
 	"strings"
 
 	"github.com/deepankarm/godantic/pkg/godantic"
+	"github.com/deepankarm/godantic/pkg/internal/reflectutil"
 	"github.com/invopop/jsonschema"
 )
 
 // SchemaOptions configures schema generation behavior
 type SchemaOptions struct {
 	AutoGenerateTitles bool // Generate titles for all fields (Pydantic-style, default: true)
+
+	// DefaultsImplyOptional leaves fields with a Default out of "required", since
+	// the validator fills them in when absent. Fields marked StrictRequired stay
+	// required. Off by default to keep emitted schemas unchanged.
+	DefaultsImplyOptional bool
+
+	// NullablePointers wraps every pointer field in anyOf with null, as if it
+	// were marked Nullable. Pointer fields are already left out of "required";
+	// this also lets them be sent as null, which structured-output APIs need
+	// to return "no value". Off by default to keep emitted schemas unchanged.
+	NullablePointers bool
+
+	// TagName names properties by this struct tag instead of `json`, matching a
+	// validator built with godantic.WithTagName. Fields without the tag keep
+	// their json name. Empty uses the json names.
+	TagName string
 }
 
 // DefaultSchemaOptions returns default options matching Pydantic behavior
@@ -28,6 +47,7 @@ type Generator[T any] struct {
 	validator *godantic.Validator[T]
 	reflector *jsonschema.Reflector
 	options   SchemaOptions
+	examples  []any // Root examples, checked against the schema by Generate
 }
 
 // NewGenerator creates a new schema generator with default options
@@ -37,6 +57,7 @@ func NewGenerator[T any]() *Generator[T] {
 		reflector: &jsonschema.Reflector{
 			AllowAdditionalProperties:  false,
 			RequiredFromJSONSchemaTags: true,
+			Mapper:                     mapType,
 		},
 		options: DefaultSchemaOptions(),
 	}
@@ -54,18 +75,94 @@ func (g *Generator[T]) WithAutoTitles(enabled bool) *Generator[T] {
 	return g
 }
 
+// WithExamples adds complete example values to the root "examples" keyword,
+// e.g. as few-shot examples for LLM structured output. Unlike a field's
+// Example, they describe the whole object. Generate fails if an example
+// doesn't validate against the generated schema.
+//
+// Example:
+//
+//	gen := schema.NewGenerator[Answer]().WithExamples(
+//	    Answer{Text: "Paris", Confidence: 0.9},
+//	)
+func (g *Generator[T]) WithExamples(examples ...T) *Generator[T] {
+	for _, example := range examples {
+		g.examples = append(g.examples, example)
+	}
+	return g
+}
+
 // Generate generates JSON Schema for the type
 func (g *Generator[T]) Generate() (*jsonschema.Schema, error) {
 	var zero T
 	schema := g.reflector.Reflect(zero)
 	g.enhance(schema)
+	if err := attachExamples(schema, g.examples); err != nil {
+		return nil, err
+	}
 	return schema, nil
 }
 
+// attachExamples checks examples against schema and sets them as its
+// "examples". With a root $ref they are also set on the root definition, so
+// GenerateFlattened keeps them.
+func attachExamples(schema *jsonschema.Schema, examples []any) error {
+	if len(examples) == 0 {
+		return nil
+	}
+	schemaJSON, err := json.Marshal(schema)
+	if err != nil {
+		return fmt.Errorf("failed to marshal schema: %w", err)
+	}
+	var schemaMap map[string]any
+	if err := json.Unmarshal(schemaJSON, &schemaMap); err != nil {
+		return fmt.Errorf("failed to unmarshal schema: %w", err)
+	}
+	for i, example := range examples {
+		data, err := json.Marshal(example)
+		if err != nil {
+			return fmt.Errorf("example %d: %w", i, err)
+		}
+		if errs := godantic.ValidateAgainstSchema(schemaMap, data); len(errs) > 0 {
+			return fmt.Errorf("example %d does not match the schema: %w", i, errs)
+		}
+	}
+
+	schema.Examples = examples
+	if def, ok := schema.Definitions[strings.TrimPrefix(schema.Ref, "#/$defs/")]; ok && schema.Ref != "" {
+		def.Examples = examples
+	}
+	return nil
+}
+
+// FlattenOptions configures GenerateFlattened
+type FlattenOptions struct {
+	// RootName renames the root type: it becomes the root title and, with
+	// UseRef, its $defs key ("" keeps the Go type name and no title).
+	RootName string
+
+	// UseRef keeps the root a named definition referenced by a root $ref, for
+	// client generators that expect every object type in $defs, instead of
+	// putting it at the top level.
+	UseRef bool
+}
+
+// DefaultFlattenOptions returns the options GenerateFlattened uses when none
+// are given: the root inlined at the top level, without a title
+func DefaultFlattenOptions() FlattenOptions {
+	return FlattenOptions{}
+}
+
 // GenerateFlattened generates a flattened JSON Schema suitable for LLM APIs
 // (OpenAI, Gemini, Claude, etc.) that require the root object definition
-// at the top level instead of a $ref
-func (g *Generator[T]) GenerateFlattened() (map[string]any, error) {
+// at the top level instead of a $ref. Pass FlattenOptions to name the root
+// or keep it as a $defs entry.
+func (g *Generator[T]) GenerateFlattened(opts ...FlattenOptions) (map[string]any, error) {
+	flattenOpts := DefaultFlattenOptions()
+	if len(opts) > 0 {
+		flattenOpts = opts[0]
+	}
+
 	schema, err := g.Generate()
 	if err != nil {
 		return nil, err
@@ -81,7 +178,17 @@ func (g *Generator[T]) GenerateFlattened() (map[string]any, error) {
 		return nil, fmt.Errorf("failed to unmarshal schema: %w", err)
 	}
 
-	return flattenSchemaMap(schemaMap)
+	if flattenOpts.UseRef {
+		return namedRootSchemaMap(schemaMap, flattenOpts.RootName)
+	}
+	result, err := flattenSchemaMap(schemaMap)
+	if err != nil {
+		return nil, err
+	}
+	if flattenOpts.RootName != "" {
+		result["title"] = flattenOpts.RootName
+	}
+	return result, nil
 }
 
 // GenerateJSON generates JSON Schema as JSON string
@@ -104,11 +211,21 @@ type Options struct {
 	Title       string
 	Description string
 	Version     string
+
+	// DefaultsImplyOptional omits fields with a Default from "required"
+	// (see SchemaOptions.DefaultsImplyOptional)
+	DefaultsImplyOptional bool
+
+	// Examples are complete example objects for the root "examples"
+	// keyword (see Generator.WithExamples)
+	Examples []any
 }
 
 // GenerateWithOptions generates schema with custom options
 func GenerateWithOptions[T any](opts Options) (*jsonschema.Schema, error) {
 	g := NewGenerator[T]()
+	g.options.DefaultsImplyOptional = opts.DefaultsImplyOptional
+	g.examples = opts.Examples
 	schema, err := g.Generate()
 	if err != nil {
 		return nil, err
@@ -132,8 +249,48 @@ func GenerateForType(t reflect.Type) (map[string]any, error) {
 	return GenerateForTypeWithOptions(t, DefaultSchemaOptions())
 }
 
-// GenerateForTypeWithOptions generates a JSON schema for any reflect.Type with custom options
+// GenerateForTypeWithOptions generates a JSON schema for any reflect.Type with custom options.
+// Results are cached per type and options (see ClearCache); each call returns
+// its own copy, so callers may modify it.
 func GenerateForTypeWithOptions(t reflect.Type, opts SchemaOptions) (map[string]any, error) {
+	key := schemaCacheKey{typ: t, opts: opts}
+	if cached, ok := schemaCache.Load(key); ok {
+		return cloneSchemaMap(cached.(map[string]any)), nil
+	}
+
+	schemaMap, err := generateForType(t, opts)
+	if err != nil {
+		return nil, err
+	}
+	schemaCache.Store(key, schemaMap)
+	return cloneSchemaMap(schemaMap), nil
+}
+
+// fileHeaderType is the type multipart file fields are bound to
+var fileHeaderType = reflect.TypeFor[multipart.FileHeader]()
+
+// mapType overrides the reflected schema of types with a fixed JSON form:
+// uploaded files are binary strings rather than FileHeader objects,
+// durations are strings such as "1h30m", and godantic.Optional[T] is
+// described as T
+func mapType(t reflect.Type) *jsonschema.Schema {
+	if t == fileHeaderType {
+		return &jsonschema.Schema{Type: "string", Format: "binary"}
+	}
+	if reflectutil.IsDuration(t) {
+		return &jsonschema.Schema{Type: "string", Format: "duration"}
+	}
+	if reflectutil.IsOptional(t) {
+		reflector := &jsonschema.Reflector{DoNotReference: true, Mapper: mapType}
+		inner := reflector.ReflectFromType(reflectutil.OptionalElem(t))
+		inner.Version, inner.ID = "", ""
+		return inner
+	}
+	return nil
+}
+
+// generateForType reflects and enhances the schema for t, bypassing the cache
+func generateForType(t reflect.Type, opts SchemaOptions) (map[string]any, error) {
 	var instance any
 	if t.Kind() == reflect.Pointer {
 		instance = reflect.New(t.Elem()).Interface()
@@ -145,6 +302,7 @@ func GenerateForTypeWithOptions(t reflect.Type, opts SchemaOptions) (map[string]
 	reflector := &jsonschema.Reflector{
 		AllowAdditionalProperties:  false,
 		RequiredFromJSONSchemaTags: true,
+		Mapper:                     mapType,
 	}
 
 	schema := reflector.Reflect(instance)
@@ -228,6 +386,83 @@ func GenerateUnionSchema(types ...any) (map[string]any, error) {
 	return result, nil
 }
 
+// GenerateDiscriminatedUnionSchema generates a JSON schema for a discriminated
+// union interface: a oneOf of the variant types plus an OpenAPI discriminator
+// with propertyName and mapping. The variants map has the same shape as the one
+// passed to godantic.WithDiscriminator, so decoding and schema stay in sync.
+//
+// Usage:
+//
+//	variants := map[string]any{"cat": Cat{}, "dog": Dog{}}
+//	validator := godantic.NewValidator[Animal](godantic.WithDiscriminator("species", variants))
+//	schema, err := schema.GenerateDiscriminatedUnionSchema("species", variants)
+//	// Returns: {"oneOf": [{"$ref": "#/$defs/Cat"}, ...],
+//	//           "discriminator": {"propertyName": "species", "mapping": {"cat": "#/$defs/Cat", ...}},
+//	//           "$defs": {...}}
+func GenerateDiscriminatedUnionSchema(propertyName string, variants map[string]any) (map[string]any, error) {
+	if propertyName == "" {
+		return nil, fmt.Errorf("discriminator property name is required")
+	}
+	if len(variants) == 0 {
+		return nil, fmt.Errorf("discriminated union %q has no variants", propertyName)
+	}
+
+	oneOf := make([]any, 0, len(variants))
+	mapping := make(map[string]any, len(variants))
+	mergedDefs := make(map[string]any)
+
+	// Sorted by discriminator value for stable output
+	for _, value := range sortedKeys(variants) {
+		rt := reflect.TypeOf(variants[value])
+		if rt == nil {
+			return nil, fmt.Errorf("nil type provided for discriminator value %q", value)
+		}
+		if rt.Kind() == reflect.Pointer {
+			rt = rt.Elem()
+		}
+		if rt.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("variant %q must be a struct, got %s", value, rt)
+		}
+
+		schema, err := GenerateForType(rt)
+		if err != nil {
+			return nil, err
+		}
+		if defs, ok := schema["$defs"].(map[string]any); ok {
+			maps.Copy(mergedDefs, defs)
+		}
+
+		ref, ok := schema["$ref"].(string)
+		if !ok {
+			ref = "#/$defs/" + rt.Name()
+		}
+		// Variants can share a type; list each $ref once
+		if !slices.ContainsFunc(oneOf, func(v any) bool { return v.(map[string]any)["$ref"] == ref }) {
+			oneOf = append(oneOf, map[string]any{"$ref": ref})
+		}
+		mapping[value] = ref
+	}
+
+	return map[string]any{
+		"oneOf": oneOf,
+		"discriminator": map[string]any{
+			"propertyName": propertyName,
+			"mapping":      mapping,
+		},
+		"$defs": mergedDefs,
+	}, nil
+}
+
+// GenerateDiscriminatedUnionSchemaTyped is GenerateDiscriminatedUnionSchema for
+// typed discriminator keys, matching godantic.WithDiscriminatorTyped.
+func GenerateDiscriminatedUnionSchemaTyped[K ~string](propertyName string, variants map[K]any) (map[string]any, error) {
+	stringVariants := make(map[string]any, len(variants))
+	for key, val := range variants {
+		stringVariants[string(key)] = val
+	}
+	return GenerateDiscriminatedUnionSchema(propertyName, stringVariants)
+}
+
 // generateFlattenedForValue generates a flattened schema for a value instance
 func generateFlattenedForValue(v any) (map[string]any, error) {
 	t := reflect.TypeOf(v)
@@ -278,3 +513,52 @@ func flattenSchemaMap(schema map[string]any) (map[string]any, error) {
 
 	return result, nil
 }
+
+// namedRootSchemaMap keeps the root as a $defs entry behind a root $ref,
+// renaming it (and every $ref to it) to rootName if set.
+func namedRootSchemaMap(schema map[string]any, rootName string) (map[string]any, error) {
+	ref, hasRef := schema["$ref"].(string)
+	defs, hasDefs := schema["$defs"].(map[string]any)
+	if !hasRef || !hasDefs || !strings.HasPrefix(ref, "#/$defs/") {
+		return schema, nil
+	}
+	typeName := ref[len("#/$defs/"):]
+
+	rootDef, ok := defs[typeName].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("definition %s not found in $defs", typeName)
+	}
+	if rootName == "" || rootName == typeName {
+		if rootName != "" {
+			rootDef["title"] = rootName
+		}
+		return schema, nil
+	}
+	if _, taken := defs[rootName]; taken {
+		return nil, fmt.Errorf("root name %s is already used in $defs", rootName)
+	}
+
+	delete(defs, typeName)
+	defs[rootName] = rootDef
+	rootDef["title"] = rootName
+	renameRefs(schema, "#/$defs/"+typeName, "#/$defs/"+rootName)
+	return schema, nil
+}
+
+// renameRefs rewrites every $ref equal to from, at any depth, to to
+func renameRefs(node any, from, to string) {
+	switch n := node.(type) {
+	case map[string]any:
+		for key, value := range n {
+			if key == "$ref" && value == from {
+				n[key] = to
+				continue
+			}
+			renameRefs(value, from, to)
+		}
+	case []any:
+		for _, item := range n {
+			renameRefs(item, from, to)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+// Code generated by godantic. DO NOT EDIT.
+
+export interface TSAccount {
+  /** Server-assigned identifier */
+  readonly id: string;
+  role: "admin" | "member" | "guest";
+  nickname?: string | null;
+  address: TSAddress;
+  previous: TSAddress[];
+  manager?: TSAccount;
+  labels: Record<string, string>;
+  scores: number[];
+  contact: string | TSAddress;
+  created_at: string;
+  /** @deprecated */
+  "legacy-id": number;
+}
+
+export interface TSAddress {
+  street: string;
+  zip: string;
+}
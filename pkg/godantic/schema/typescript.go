@@ -0,0 +1,314 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/invopop/jsonschema"
+)
+
+// GenerateTypeScript generates TypeScript declarations matching the JSON
+// Schema of T, so a frontend can share the API's types instead of keeping a
+// hand-written copy in sync. See TypeScript for how the schema is mapped.
+//
+// Example:
+//
+//	ts, err := schema.GenerateTypeScript[User]()
+//	// export interface User {
+//	//   name: string;
+//	//   role: "admin" | "member";
+//	//   manager?: User;
+//	// }
+func GenerateTypeScript[T any]() (string, error) {
+	s, err := NewGenerator[T]().Generate()
+	if err != nil {
+		return "", err
+	}
+	return TypeScript(s)
+}
+
+// TypeScript renders a schema as TypeScript declarations: every object
+// definition in $defs becomes an exported interface, the root first and the
+// rest sorted by name, and any other definition becomes an exported type
+// alias. It covers the keywords the generator emits:
+//
+//   - required fields are plain members and the rest, such as pointers, are
+//     optional (?) members
+//   - enum and const become literal types, anyOf and oneOf unions (a null
+//     member, as added by Nullable, becomes | null) and allOf intersections
+//   - integer and number become number, and items and additionalProperties
+//     become T[] and Record<string, T>
+//   - description and deprecated become doc comments, and readOnly a
+//     readonly member
+//
+// Validation keywords such as minLength or pattern have no TypeScript
+// counterpart and are left out.
+func TypeScript(s *jsonschema.Schema) (string, error) {
+	rootName := strings.TrimPrefix(s.Ref, "#/$defs/")
+	if s.Ref == "" {
+		rootName = s.Title
+		if rootName == "" {
+			rootName = "Root"
+		}
+	} else if _, ok := s.Definitions[rootName]; !ok || !strings.HasPrefix(s.Ref, "#/$defs/") {
+		return "", fmt.Errorf("root $ref %s does not point into $defs", s.Ref)
+	}
+
+	var buf strings.Builder
+	buf.WriteString("// Code generated by godantic. DO NOT EDIT.\n")
+	if s.Ref == "" {
+		buf.WriteString("\n")
+		writeTSDeclaration(&buf, rootName, s)
+	}
+	names := make([]string, 0, len(s.Definitions))
+	for name := range s.Definitions {
+		if name != rootName {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	if s.Ref != "" {
+		names = slices.Insert(names, 0, rootName)
+	}
+	for _, name := range names {
+		buf.WriteString("\n")
+		writeTSDeclaration(&buf, name, s.Definitions[name])
+	}
+	return buf.String(), nil
+}
+
+// writeTSDeclaration writes def as an interface when it is an object with
+// properties and as a type alias otherwise
+func writeTSDeclaration(buf *strings.Builder, name string, def *jsonschema.Schema) {
+	writeTSDoc(buf, "", def)
+	if def.Properties == nil || def.Properties.Len() == 0 {
+		fmt.Fprintf(buf, "export type %s = %s;\n", tsIdentifier(name), tsType(def))
+		return
+	}
+
+	fmt.Fprintf(buf, "export interface %s {\n", tsIdentifier(name))
+	for pair := def.Properties.Oldest(); pair != nil; pair = pair.Next() {
+		writeTSDoc(buf, "  ", pair.Value)
+		buf.WriteString("  ")
+		if pair.Value != nil && pair.Value.ReadOnly {
+			buf.WriteString("readonly ")
+		}
+		buf.WriteString(tsPropertyName(pair.Key))
+		if !slices.Contains(def.Required, pair.Key) {
+			buf.WriteString("?")
+		}
+		fmt.Fprintf(buf, ": %s;\n", tsType(pair.Value))
+	}
+	if extra := tsAdditionalProperties(def); extra != "" {
+		fmt.Fprintf(buf, "  [key: string]: %s;\n", extra)
+	}
+	buf.WriteString("}\n")
+}
+
+// writeTSDoc writes the description and deprecation of s as a doc comment
+func writeTSDoc(buf *strings.Builder, indent string, s *jsonschema.Schema) {
+	if s == nil || (s.Description == "" && !s.Deprecated) {
+		return
+	}
+	var lines []string
+	if s.Description != "" {
+		lines = strings.Split(strings.ReplaceAll(s.Description, "*/", "*\\/"), "\n")
+	}
+	if s.Deprecated {
+		lines = append(lines, "@deprecated")
+	}
+	if len(lines) == 1 {
+		fmt.Fprintf(buf, "%s/** %s */\n", indent, lines[0])
+		return
+	}
+	fmt.Fprintf(buf, "%s/**\n", indent)
+	for _, line := range lines {
+		fmt.Fprintf(buf, "%s * %s\n", indent, line)
+	}
+	fmt.Fprintf(buf, "%s */\n", indent)
+}
+
+// tsType returns the TypeScript type expression of s
+func tsType(s *jsonschema.Schema) string {
+	switch {
+	case s == nil || s == jsonschema.TrueSchema:
+		return "unknown"
+	case s == jsonschema.FalseSchema:
+		return "never"
+	case s.Ref != "":
+		return tsIdentifier(s.Ref[strings.LastIndex(s.Ref, "/")+1:])
+	case s.Const != nil:
+		return tsLiteral(s.Const)
+	case len(s.Enum) > 0:
+		members := make([]string, len(s.Enum))
+		for i, value := range s.Enum {
+			members[i] = tsLiteral(value)
+		}
+		return tsUnion(members)
+	case len(s.AnyOf) > 0:
+		return tsUnion(tsTypes(s.AnyOf))
+	case len(s.OneOf) > 0:
+		return tsUnion(tsTypes(s.OneOf))
+	case len(s.AllOf) > 0:
+		members := tsTypes(s.AllOf)
+		for i, member := range members {
+			if strings.Contains(member, " | ") {
+				members[i] = "(" + member + ")"
+			}
+		}
+		return strings.Join(members, " & ")
+	}
+
+	switch s.Type {
+	case "string":
+		return "string"
+	case "integer", "number":
+		return "number"
+	case "boolean":
+		return "boolean"
+	case "null":
+		return "null"
+	case "array":
+		if len(s.PrefixItems) > 0 {
+			return "[" + strings.Join(tsTypes(s.PrefixItems), ", ") + "]"
+		}
+		item := tsType(s.Items)
+		if strings.Contains(item, " | ") || strings.Contains(item, " & ") {
+			item = "(" + item + ")"
+		}
+		return item + "[]"
+	case "object":
+		if s.Properties != nil && s.Properties.Len() > 0 {
+			return tsObjectLiteral(s)
+		}
+		if extra := tsAdditionalProperties(s); extra != "" {
+			return "Record<string, " + extra + ">"
+		}
+		return "Record<string, unknown>"
+	}
+	return "unknown"
+}
+
+// tsObjectLiteral returns an inline object type for an object schema with
+// properties that is not a $defs entry
+func tsObjectLiteral(s *jsonschema.Schema) string {
+	var members []string
+	for pair := s.Properties.Oldest(); pair != nil; pair = pair.Next() {
+		member := tsPropertyName(pair.Key)
+		if pair.Value != nil && pair.Value.ReadOnly {
+			member = "readonly " + member
+		}
+		if !slices.Contains(s.Required, pair.Key) {
+			member += "?"
+		}
+		members = append(members, member+": "+tsType(pair.Value))
+	}
+	if extra := tsAdditionalProperties(s); extra != "" {
+		members = append(members, "[key: string]: "+extra)
+	}
+	return "{ " + strings.Join(members, "; ") + " }"
+}
+
+// tsAdditionalProperties returns the type of the extra members s allows,
+// or "" when it allows none
+func tsAdditionalProperties(s *jsonschema.Schema) string {
+	switch {
+	case s.AdditionalProperties == jsonschema.FalseSchema:
+		return ""
+	case s.AdditionalProperties != nil:
+		return tsType(s.AdditionalProperties)
+	case len(s.PatternProperties) == 1:
+		for _, value := range s.PatternProperties {
+			return tsType(value)
+		}
+	case len(s.PatternProperties) > 1:
+		return "unknown"
+	}
+	return ""
+}
+
+// tsTypes returns the type expressions of schemas
+func tsTypes(schemas []*jsonschema.Schema) []string {
+	types := make([]string, len(schemas))
+	for i, s := range schemas {
+		types[i] = tsType(s)
+	}
+	return types
+}
+
+// tsUnion joins members into a union, dropping duplicates and keeping null last
+func tsUnion(members []string) string {
+	var unique []string
+	hasNull := false
+	for _, member := range members {
+		switch {
+		case member == "null":
+			hasNull = true
+		case !slices.Contains(unique, member):
+			unique = append(unique, member)
+		}
+	}
+	if hasNull {
+		unique = append(unique, "null")
+	}
+	return strings.Join(unique, " | ")
+}
+
+// tsLiteral returns value as a TypeScript literal type
+func tsLiteral(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "unknown"
+	}
+	return string(data)
+}
+
+// tsPropertyName returns name as written in a member declaration, quoted
+// unless it is a valid identifier
+func tsPropertyName(name string) string {
+	if isTSIdentifier(name) {
+		return name
+	}
+	return tsLiteral(name)
+}
+
+// tsIdentifier turns a $defs name into a valid identifier, replacing the
+// characters of generic instantiations such as Page[int] and dropping the
+// package paths of their type arguments
+func tsIdentifier(name string) string {
+	if base, args, ok := strings.Cut(name, "["); ok {
+		name = base + "[" + typeArgQualifier.ReplaceAllString(args, "")
+	}
+	ident := strings.Map(func(r rune) rune {
+		if r == '_' || r == '$' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, name)
+	ident = strings.TrimRight(ident, "_")
+	if ident == "" || unicode.IsDigit(rune(ident[0])) {
+		ident = "_" + ident
+	}
+	return ident
+}
+
+// typeArgQualifier matches the package path before a type argument's name
+var typeArgQualifier = regexp.MustCompile(`[\w./-]*[./]`)
+
+// isTSIdentifier reports whether name can be used unquoted as a member name
+func isTSIdentifier(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, r := range name {
+		if r == '_' || r == '$' || unicode.IsLetter(r) || (i > 0 && unicode.IsDigit(r)) {
+			continue
+		}
+		return false
+	}
+	return true
+}
@@ -0,0 +1,120 @@
+package schema_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+	"github.com/deepankarm/godantic/pkg/godantic/schema"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// ═══════════════════════════════════════════════════════════════════════════
+// TypeScript declarations
+// ═══════════════════════════════════════════════════════════════════════════
+
+type TSAccount struct {
+	ID        string            `json:"id"`
+	Role      string            `json:"role"`
+	Nickname  *string           `json:"nickname"`
+	Address   TSAddress         `json:"address"`
+	Previous  []TSAddress       `json:"previous"`
+	Manager   *TSAccount        `json:"manager,omitempty"`
+	Labels    map[string]string `json:"labels"`
+	Scores    []float64         `json:"scores"`
+	Contact   any               `json:"contact"`
+	CreatedAt time.Time         `json:"created_at"`
+	Legacy    int               `json:"legacy-id"`
+}
+
+func (a *TSAccount) FieldID() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.ReadOnly[string](), godantic.Description[string]("Server-assigned identifier"))
+}
+
+func (a *TSAccount) FieldRole() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.OneOf("admin", "member", "guest"))
+}
+
+func (a *TSAccount) FieldNickname() godantic.FieldOptions[*string] {
+	return godantic.Field(godantic.Nullable[*string]())
+}
+
+func (a *TSAccount) FieldContact() godantic.FieldOptions[any] {
+	return godantic.Field(godantic.Union[any]("string", TSAddress{}))
+}
+
+func (a *TSAccount) FieldLegacy() godantic.FieldOptions[int] {
+	return godantic.Field(godantic.Deprecated[int]())
+}
+
+type TSAddress struct {
+	Street string `json:"street"`
+	Zip    string `json:"zip,omitempty"`
+}
+
+func (a *TSAddress) FieldZip() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Regex(`^\d{5}$`))
+}
+
+func TestGenerateTypeScript(t *testing.T) {
+	got, err := schema.GenerateTypeScript[TSAccount]()
+	if err != nil {
+		t.Fatalf("GenerateTypeScript failed: %v", err)
+	}
+	assertGolden(t, "account.ts", got)
+}
+
+func assertGolden(t *testing.T, name, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *updateGolden {
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading golden file (run with -update to create it): %v", err)
+	}
+	if got != string(want) {
+		t.Errorf("%s mismatch (run with -update to accept):\ngot:\n%s\nwant:\n%s", name, got, want)
+	}
+}
+
+type TSPage[T any] struct {
+	Items []T  `json:"items"`
+	Next  *int `json:"next"`
+}
+
+type TSAnimal struct {
+	Pet any `json:"pet"`
+}
+
+type TSCat struct {
+	Kind  string `json:"kind"`
+	Lives int    `json:"lives"`
+}
+
+type TSDog struct {
+	Kind   string   `json:"kind"`
+	Tricks []string `json:"tricks"`
+}
+
+func (a *TSAnimal) FieldPet() godantic.FieldOptions[any] {
+	return godantic.Field(godantic.DiscriminatedUnion[any]("kind", map[string]any{
+		"cat": TSCat{},
+		"dog": TSDog{},
+	}))
+}
+
+func TestGenerateTypeScriptGeneric(t *testing.T) {
+	got, err := schema.GenerateTypeScript[TSPage[TSAnimal]]()
+	if err != nil {
+		t.Fatalf("GenerateTypeScript failed: %v", err)
+	}
+	assertGolden(t, "page.ts", got)
+}
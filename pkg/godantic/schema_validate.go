@@ -0,0 +1,471 @@
+package godantic
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/deepankarm/godantic/pkg/internal/errors"
+)
+
+// ValidateAgainstSchema validates JSON data against a JSON Schema given as a
+// map, for payloads without a Go type (for example LLM output checked against
+// a schema godantic generated). Errors have the same shape as Validate's, with
+// Locs made of property names and "[i]" array indexes.
+//
+// Supported keywords (those the schema generator emits): type, enum, const,
+// properties, required, additionalProperties, propertyNames, items, minItems,
+// maxItems, uniqueItems, minProperties, maxProperties, minLength, maxLength,
+// pattern, format (checked with the RegisterFormat validators), minimum, maximum,
+// exclusiveMinimum, exclusiveMaximum, multipleOf, anyOf, oneOf, allOf, not,
+// if/then/else, discriminator and local $refs ("#/$defs/...", "#/definitions/...",
+// "#/components/schemas/..."). Other keywords are ignored. Lengths count
+// characters, as JSON Schema defines them. A $ref that leads back to itself
+// for the same value, such as {"$defs": {"A": {"$ref": "#/$defs/A"}}}, is
+// reported as ErrorTypeInternal.
+//
+// Example:
+//
+//	schemaMap, _ := schema.GenerateForType(reflect.TypeOf(Answer{}))
+//	if errs := godantic.ValidateAgainstSchema(schemaMap, llmOutput); len(errs) > 0 {
+//	    // ask the model to fix errs
+//	}
+func ValidateAgainstSchema(schema map[string]any, data []byte) ValidationErrors {
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return ValidationErrors{{Loc: []string{}, Message: "JSON unmarshal failed: " + err.Error(), Type: ErrorTypeJSONDecode}}
+	}
+
+	// Round-trip the schema so numbers and lists compare like decoded JSON
+	encoded, err := json.Marshal(schema)
+	if err != nil {
+		return ValidationErrors{{Loc: []string{}, Message: "invalid schema: " + err.Error(), Type: ErrorTypeInternal}}
+	}
+	var root map[string]any
+	if err := json.Unmarshal(encoded, &root); err != nil {
+		return ValidationErrors{{Loc: []string{}, Message: "invalid schema: " + err.Error(), Type: ErrorTypeInternal}}
+	}
+
+	sv := &schemaValidator{root: root}
+	sv.validate(root, value, []string{})
+	return sv.errs
+}
+
+// schemaValidator accumulates errors while walking a value and its schema
+type schemaValidator struct {
+	root map[string]any
+	errs ValidationErrors
+	refs []string // Refs followed for the current value, to stop ref cycles
+}
+
+func (sv *schemaValidator) add(loc []string, errType ErrorType, format string, args ...any) {
+	sv.errs = append(sv.errs, ValidationError{Loc: slices.Clone(loc), Message: fmt.Sprintf(format, args...), Type: errType})
+}
+
+// matches reports whether value satisfies schema, without recording errors
+func (sv *schemaValidator) matches(schema map[string]any, value any) bool {
+	return len(sv.check(schema, value, nil)) == 0
+}
+
+// check validates value against schema and returns the errors instead of recording them
+func (sv *schemaValidator) check(schema map[string]any, value any, loc []string) ValidationErrors {
+	branch := &schemaValidator{root: sv.root, refs: sv.refs}
+	branch.validate(schema, value, loc)
+	return branch.errs
+}
+
+// validateChild validates a value nested in the current one, which starts a
+// new chain of refs: recursive schemas are bounded by the depth of the value
+func (sv *schemaValidator) validateChild(schema map[string]any, value any, loc []string) {
+	refs := sv.refs
+	sv.refs = nil
+	sv.validate(schema, value, loc)
+	sv.refs = refs
+}
+
+func (sv *schemaValidator) validate(schema map[string]any, value any, loc []string) {
+	if schema == nil {
+		return
+	}
+	if ref, ok := schema["$ref"].(string); ok {
+		target, found := sv.resolve(ref)
+		if !found {
+			sv.add(loc, ErrorTypeInternal, "unresolved schema reference %q", ref)
+			return
+		}
+		if slices.Contains(sv.refs, ref) {
+			sv.add(loc, ErrorTypeInternal, "circular schema reference %q", ref)
+			return
+		}
+		sv.refs = append(sv.refs, ref)
+		sv.validate(target, value, loc)
+		sv.refs = sv.refs[:len(sv.refs)-1]
+		return
+	}
+
+	if !sv.validateType(schema, value, loc) {
+		return // Keywords below assume the declared type
+	}
+	if allowed, ok := schema["enum"].([]any); ok && !slices.ContainsFunc(allowed, func(a any) bool { return jsonEqual(a, value) }) {
+		if hint := suggestion(value, allowed); hint != "" {
+			sv.add(loc, ErrorTypeConstraint, "value must be one of %v; %s", allowed, hint)
+		} else {
+			sv.add(loc, ErrorTypeConstraint, "value must be one of %v", allowed)
+		}
+	}
+	if want, ok := schema["const"]; ok && !jsonEqual(want, value) {
+		sv.add(loc, ErrorTypeConstraint, "value must be %v", want)
+	}
+
+	switch v := value.(type) {
+	case string:
+		sv.validateString(schema, v, loc)
+	case float64:
+		sv.validateNumber(schema, v, loc)
+	case []any:
+		sv.validateArray(schema, v, loc)
+	case map[string]any:
+		sv.validateObject(schema, v, loc)
+	}
+
+	sv.validateCombinators(schema, value, loc)
+}
+
+// resolve looks up a local reference such as "#/$defs/User"
+func (sv *schemaValidator) resolve(ref string) (map[string]any, bool) {
+	if ref == "#" {
+		return sv.root, true
+	}
+	pointer, ok := strings.CutPrefix(ref, "#/")
+	if !ok {
+		return nil, false
+	}
+	var node any = sv.root
+	for _, part := range strings.Split(pointer, "/") {
+		part = strings.ReplaceAll(strings.ReplaceAll(part, "~1", "/"), "~0", "~")
+		m, ok := node.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if node, ok = m[part]; !ok {
+			return nil, false
+		}
+	}
+	target, ok := node.(map[string]any)
+	return target, ok
+}
+
+// validateType checks the type keyword and reports whether value has the declared type
+func (sv *schemaValidator) validateType(schema map[string]any, value any, loc []string) bool {
+	var types []string
+	switch t := schema["type"].(type) {
+	case string:
+		types = []string{t}
+	case []any:
+		for _, item := range t {
+			if s, ok := item.(string); ok {
+				types = append(types, s)
+			}
+		}
+	default:
+		return true
+	}
+	if nullable, _ := schema["nullable"].(bool); nullable && value == nil {
+		return true // OpenAPI 3.0
+	}
+	if slices.ContainsFunc(types, func(t string) bool { return hasJSONType(value, t) }) {
+		return true
+	}
+	sv.add(loc, ErrorTypeMismatch, "value must be of type %s, got %s", strings.Join(types, " or "), jsonTypeName(value))
+	return false
+}
+
+func (sv *schemaValidator) validateString(schema map[string]any, s string, loc []string) {
+	length := utf8.RuneCountInString(s)
+	if min, ok := schemaInt(schema, "minLength"); ok && length < min {
+		sv.add(loc, ErrorTypeConstraint, "length must be >= %d", min)
+	}
+	if max, ok := schemaInt(schema, "maxLength"); ok && length > max {
+		sv.add(loc, ErrorTypeConstraint, "length must be <= %d", max)
+	}
+	if pattern, ok := schema["pattern"].(string); ok {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			sv.add(loc, ErrorTypeInternal, "invalid regex pattern %q: %v", pattern, err)
+		} else if !re.MatchString(s) {
+			sv.add(loc, ErrorTypeConstraint, "value does not match pattern %s", pattern)
+		}
+	}
+	if format, ok := schema["format"].(string); ok {
+		if validate := lookupFormat(format); validate != nil {
+			if err := validate(s); err != nil {
+				sv.add(loc, ErrorTypeConstraint, "value is not a valid %s: %v", format, err)
+			}
+		}
+	}
+}
+
+func (sv *schemaValidator) validateNumber(schema map[string]any, n float64, loc []string) {
+	if min, ok := schema["minimum"].(float64); ok && n < min {
+		sv.add(loc, ErrorTypeConstraint, "value must be >= %v", min)
+	}
+	if max, ok := schema["maximum"].(float64); ok && n > max {
+		sv.add(loc, ErrorTypeConstraint, "value must be <= %v", max)
+	}
+	if min, ok := schema["exclusiveMinimum"].(float64); ok && n <= min {
+		sv.add(loc, ErrorTypeConstraint, "value must be > %v", min)
+	}
+	if max, ok := schema["exclusiveMaximum"].(float64); ok && n >= max {
+		sv.add(loc, ErrorTypeConstraint, "value must be < %v", max)
+	}
+	if divisor, ok := schema["multipleOf"].(float64); ok && divisor != 0 {
+		if q := n / divisor; math.Abs(q-math.Round(q)) > 1e-9 {
+			sv.add(loc, ErrorTypeConstraint, "value must be a multiple of %v", divisor)
+		}
+	}
+}
+
+func (sv *schemaValidator) validateArray(schema map[string]any, items []any, loc []string) {
+	if min, ok := schemaInt(schema, "minItems"); ok && len(items) < min {
+		sv.add(loc, ErrorTypeConstraint, "must have at least %d items", min)
+	}
+	if max, ok := schemaInt(schema, "maxItems"); ok && len(items) > max {
+		sv.add(loc, ErrorTypeConstraint, "must have at most %d items", max)
+	}
+	if unique, _ := schema["uniqueItems"].(bool); unique {
+		for i := range items {
+			if slices.ContainsFunc(items[:i], func(prev any) bool { return jsonEqual(prev, items[i]) }) {
+				sv.add(loc, ErrorTypeConstraint, "duplicate item found: %v", items[i])
+				break
+			}
+		}
+	}
+	if itemSchema, ok := schema["items"].(map[string]any); ok {
+		for i, item := range items {
+			sv.validateChild(itemSchema, item, append(loc, "["+strconv.Itoa(i)+"]"))
+		}
+	}
+}
+
+func (sv *schemaValidator) validateObject(schema map[string]any, obj map[string]any, loc []string) {
+	if min, ok := schemaInt(schema, "minProperties"); ok && len(obj) < min {
+		sv.add(loc, ErrorTypeConstraint, "must have at least %d properties", min)
+	}
+	if max, ok := schemaInt(schema, "maxProperties"); ok && len(obj) > max {
+		sv.add(loc, ErrorTypeConstraint, "must have at most %d properties", max)
+	}
+
+	properties, _ := schema["properties"].(map[string]any)
+	if required, ok := schema["required"].([]any); ok {
+		for _, name := range required {
+			key, _ := name.(string)
+			if _, present := obj[key]; !present {
+				sv.add(append(loc, key), ErrorTypeRequired, "required field")
+			}
+		}
+	}
+
+	// Properties are checked in sorted order so errors are deterministic
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if names, ok := schema["propertyNames"].(map[string]any); ok {
+			// A name is a value of its own, outside the refs followed for obj
+			for _, e := range (&schemaValidator{root: sv.root}).check(names, key, append(loc, key)) {
+				e.Message = "invalid property name: " + e.Message
+				sv.errs = append(sv.errs, e)
+			}
+		}
+		if propSchema, ok := properties[key].(map[string]any); ok {
+			sv.validateChild(propSchema, obj[key], append(loc, key))
+			continue
+		}
+		if _, ok := properties[key]; ok {
+			continue // true schema
+		}
+		switch additional := schema["additionalProperties"].(type) {
+		case bool:
+			if !additional {
+				sv.add(append(loc, key), ErrorTypeConstraint, "additional property not allowed")
+			}
+		case map[string]any:
+			sv.validateChild(additional, obj[key], append(loc, key))
+		}
+	}
+}
+
+// validateCombinators checks allOf, not, if/then/else, anyOf and oneOf (routing by
+// discriminator when present)
+func (sv *schemaValidator) validateCombinators(schema map[string]any, value any, loc []string) {
+	for _, branch := range schemaList(schema["allOf"]) {
+		sv.validate(branch, value, loc)
+	}
+
+	if excluded, ok := schema["not"].(map[string]any); ok && sv.matches(excluded, value) {
+		sv.add(loc, ErrorTypeConstraint, "value must not match the schema in not")
+	}
+
+	if condition, ok := schema["if"].(map[string]any); ok {
+		if sv.matches(condition, value) {
+			then, _ := schema["then"].(map[string]any)
+			sv.validate(then, value, loc)
+		} else {
+			otherwise, _ := schema["else"].(map[string]any)
+			sv.validate(otherwise, value, loc)
+		}
+	}
+
+	if target, ok := sv.discriminatorTarget(schema, value, loc); ok {
+		if target != nil {
+			sv.validate(target, value, loc)
+		}
+		return
+	}
+
+	for _, key := range []string{"anyOf", "oneOf"} {
+		branches := schemaList(schema[key])
+		if len(branches) == 0 {
+			continue
+		}
+		matched := 0
+		for _, branch := range branches {
+			if sv.matches(branch, value) {
+				matched++
+			}
+		}
+		switch {
+		case matched == 0:
+			// A nullable value reports the errors of its only non-null branch
+			if nonNull := nonNullBranches(branches); len(nonNull) == 1 && value != nil {
+				sv.validate(nonNull[0], value, loc)
+				continue
+			}
+			sv.add(loc, ErrorTypeConstraint, "value does not match any allowed schema")
+		case matched > 1 && key == "oneOf":
+			sv.add(loc, ErrorTypeConstraint, "value matches more than one schema in oneOf")
+		}
+	}
+}
+
+// discriminatorTarget picks the oneOf/anyOf branch named by a discriminator
+// mapping. ok is false when the schema has no usable discriminator; a nil
+// target means the discriminator error was already recorded.
+func (sv *schemaValidator) discriminatorTarget(schema map[string]any, value any, loc []string) (target map[string]any, ok bool) {
+	discriminator, _ := schema["discriminator"].(map[string]any)
+	field, _ := discriminator["propertyName"].(string)
+	mapping, _ := discriminator["mapping"].(map[string]any)
+	obj, isObject := value.(map[string]any)
+	if field == "" || len(mapping) == 0 || !isObject {
+		return nil, false
+	}
+
+	raw, present := obj[field]
+	if !present {
+		sv.add(append(loc, field), ErrorTypeDiscriminatorMissing, "discriminator field '%s' not found", field)
+		return nil, true
+	}
+	discValue := fmt.Sprintf("%v", raw)
+	ref, known := mapping[discValue].(string)
+	if !known {
+		sv.add(append(loc, field), ErrorTypeDiscriminatorInvalid, "%s", errors.DiscriminatorInvalidMessage(field, discValue, slices.Collect(maps.Keys(mapping))))
+		return nil, true
+	}
+	return map[string]any{"$ref": ref}, true
+}
+
+// nonNullBranches returns the branches that are not just {"type": "null"}
+func nonNullBranches(branches []map[string]any) []map[string]any {
+	var result []map[string]any
+	for _, branch := range branches {
+		if t, _ := branch["type"].(string); t == "null" && len(branch) == 1 {
+			continue
+		}
+		result = append(result, branch)
+	}
+	return result
+}
+
+// schemaList returns the subschemas of an allOf/anyOf/oneOf list
+func schemaList(v any) []map[string]any {
+	list, _ := v.([]any)
+	result := make([]map[string]any, 0, len(list))
+	for _, item := range list {
+		if m, ok := item.(map[string]any); ok {
+			result = append(result, m)
+		}
+	}
+	return result
+}
+
+// schemaInt reads a non-negative integer keyword such as minLength
+func schemaInt(schema map[string]any, key string) (int, bool) {
+	f, ok := schema[key].(float64)
+	return int(f), ok
+}
+
+// hasJSONType reports whether a decoded JSON value has the JSON Schema type t
+func hasJSONType(value any, t string) bool {
+	switch v := value.(type) {
+	case nil:
+		return t == "null"
+	case bool:
+		return t == "boolean"
+	case string:
+		return t == "string"
+	case float64:
+		return t == "number" || (t == "integer" && v == math.Trunc(v) && !math.IsInf(v, 0))
+	case []any:
+		return t == "array"
+	case map[string]any:
+		return t == "object"
+	}
+	return false
+}
+
+// jsonTypeName names the JSON type of a decoded value for error messages
+func jsonTypeName(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case []any:
+		return "array"
+	}
+	return "object"
+}
+
+// jsonEqual compares decoded JSON values
+func jsonEqual(a, b any) bool {
+	switch av := a.(type) {
+	case []any:
+		bv, ok := b.([]any)
+		return ok && slices.EqualFunc(av, bv, jsonEqual)
+	case map[string]any:
+		bv, ok := b.(map[string]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for k, v := range av {
+			if other, ok := bv[k]; !ok || !jsonEqual(v, other) {
+				return false
+			}
+		}
+		return true
+	}
+	return a == b
+}
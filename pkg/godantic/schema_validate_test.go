@@ -0,0 +1,184 @@
+package godantic_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════
+// ValidateAgainstSchema Tests
+// ═══════════════════════════════════════════════════════════════════════════
+
+func TestValidateAgainstSchema(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  map[string]any
+		data    string
+		wantLoc []string // nil means valid
+		wantTyp godantic.ErrorType
+		wantMsg string
+	}{
+		{"type ok", map[string]any{"type": "string"}, `"x"`, nil, "", ""},
+		{"type mismatch", map[string]any{"type": "string"}, `1`, []string{}, godantic.ErrorTypeMismatch, "value must be of type string, got number"},
+		{"integer", map[string]any{"type": "integer"}, `1.5`, []string{}, godantic.ErrorTypeMismatch, "value must be of type integer, got number"},
+		{"type list", map[string]any{"type": []string{"string", "null"}}, `null`, nil, "", ""},
+		{"enum", map[string]any{"enum": []string{"a", "b"}}, `"c"`, []string{}, godantic.ErrorTypeConstraint, "value must be one of [a b]"},
+		{"enum near miss", map[string]any{"enum": []any{"small", "large", 3}}, `"smal"`, []string{}, godantic.ErrorTypeConstraint, `value must be one of [small large 3]; did you mean "small"?`},
+		{"const", map[string]any{"const": 3}, `4`, []string{}, godantic.ErrorTypeConstraint, "value must be 3"},
+		{"minLength", map[string]any{"minLength": 3}, `"hé"`, []string{}, godantic.ErrorTypeConstraint, "length must be >= 3"},
+		{"maxLength", map[string]any{"maxLength": 2}, `"héé"`, []string{}, godantic.ErrorTypeConstraint, "length must be <= 2"},
+		{"pattern", map[string]any{"pattern": "^[a-z]+$"}, `"A1"`, []string{}, godantic.ErrorTypeConstraint, "value does not match pattern ^[a-z]+$"},
+		{"format", map[string]any{"format": "email"}, `"nope"`, []string{}, godantic.ErrorTypeConstraint, "value is not a valid email"},
+		{"unregistered format", map[string]any{"format": "hostname"}, `"x"`, nil, "", ""},
+		{"minimum", map[string]any{"minimum": 1}, `0`, []string{}, godantic.ErrorTypeConstraint, "value must be >= 1"},
+		{"maximum", map[string]any{"maximum": 10}, `11`, []string{}, godantic.ErrorTypeConstraint, "value must be <= 10"},
+		{"exclusiveMinimum", map[string]any{"exclusiveMinimum": 0}, `0`, []string{}, godantic.ErrorTypeConstraint, "value must be > 0"},
+		{"exclusiveMaximum", map[string]any{"exclusiveMaximum": 1}, `1`, []string{}, godantic.ErrorTypeConstraint, "value must be < 1"},
+		{"multipleOf", map[string]any{"multipleOf": 0.5}, `1.25`, []string{}, godantic.ErrorTypeConstraint, "value must be a multiple of 0.5"},
+		{"multipleOf ok", map[string]any{"multipleOf": 0.1}, `0.3`, nil, "", ""},
+		{"minItems", map[string]any{"minItems": 1}, `[]`, []string{}, godantic.ErrorTypeConstraint, "must have at least 1 items"},
+		{"maxItems", map[string]any{"maxItems": 1}, `[1, 2]`, []string{}, godantic.ErrorTypeConstraint, "must have at most 1 items"},
+		{"uniqueItems", map[string]any{"uniqueItems": true}, `[{"a": 1}, {"a": 1}]`, []string{}, godantic.ErrorTypeConstraint, "duplicate item found"},
+		{"items", map[string]any{"items": map[string]any{"type": "integer"}}, `[1, "x"]`, []string{"[1]"}, godantic.ErrorTypeMismatch, "value must be of type integer"},
+		{"minProperties", map[string]any{"minProperties": 1}, `{}`, []string{}, godantic.ErrorTypeConstraint, "must have at least 1 properties"},
+		{"maxProperties", map[string]any{"maxProperties": 1}, `{"a": 1, "b": 2}`, []string{}, godantic.ErrorTypeConstraint, "must have at most 1 properties"},
+		{"required", map[string]any{"required": []string{"name"}}, `{}`, []string{"name"}, godantic.ErrorTypeRequired, "required field"},
+		{"properties", map[string]any{"properties": map[string]any{"age": map[string]any{"minimum": 0}}}, `{"age": -1}`, []string{"age"}, godantic.ErrorTypeConstraint, "value must be >= 0"},
+		{"additionalProperties false", map[string]any{"properties": map[string]any{}, "additionalProperties": false}, `{"x": 1}`, []string{"x"}, godantic.ErrorTypeConstraint, "additional property not allowed"},
+		{"additionalProperties schema", map[string]any{"additionalProperties": map[string]any{"type": "string"}}, `{"x": 1}`, []string{"x"}, godantic.ErrorTypeMismatch, "value must be of type string"},
+		{"$ref", map[string]any{"$ref": "#/$defs/Name", "$defs": map[string]any{"Name": map[string]any{"minLength": 1}}}, `""`, []string{}, godantic.ErrorTypeConstraint, "length must be >= 1"},
+		{"unresolved $ref", map[string]any{"$ref": "#/$defs/Missing"}, `""`, []string{}, godantic.ErrorTypeInternal, `unresolved schema reference "#/$defs/Missing"`},
+		{"circular $ref", map[string]any{"$ref": "#/$defs/A", "$defs": map[string]any{"A": map[string]any{"$ref": "#/$defs/A"}}}, `1`, []string{}, godantic.ErrorTypeInternal, `circular schema reference "#/$defs/A"`},
+		{"circular $ref through allOf", map[string]any{"allOf": []any{map[string]any{"$ref": "#"}}}, `1`, []string{}, godantic.ErrorTypeInternal, `circular schema reference "#"`},
+		{"allOf", map[string]any{"allOf": []any{map[string]any{"minimum": 1}, map[string]any{"maximum": 2}}}, `3`, []string{}, godantic.ErrorTypeConstraint, "value must be <= 2"},
+		{"anyOf", map[string]any{"anyOf": []any{map[string]any{"type": "string"}, map[string]any{"type": "integer"}}}, `true`, []string{}, godantic.ErrorTypeConstraint, "value does not match any allowed schema"},
+		{"anyOf nullable", map[string]any{"anyOf": []any{map[string]any{"minLength": 2}, map[string]any{"type": "null"}}}, `"x"`, []string{}, godantic.ErrorTypeConstraint, "length must be >= 2"},
+		{"not", map[string]any{"not": map[string]any{"enum": []any{"admin", "root"}}}, `"root"`, []string{}, godantic.ErrorTypeConstraint, "value must not match the schema in not"},
+		{"not ok", map[string]any{"not": map[string]any{"pattern": "^_"}}, `"name"`, nil, "", ""},
+		{"oneOf ambiguous", map[string]any{"oneOf": []any{map[string]any{"type": "integer"}, map[string]any{"type": "number"}}}, `1`, []string{}, godantic.ErrorTypeConstraint, "value matches more than one schema in oneOf"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := godantic.ValidateAgainstSchema(tt.schema, []byte(tt.data))
+			if tt.wantLoc == nil {
+				if len(errs) != 0 {
+					t.Errorf("expected no errors, got %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 {
+				t.Fatalf("expected one error, got %v", errs)
+			}
+			if !reflect.DeepEqual(errs[0].Loc, tt.wantLoc) || errs[0].Type != tt.wantTyp || !strings.HasPrefix(errs[0].Message, tt.wantMsg) {
+				t.Errorf("got %v %s %q, want %v %s %q", errs[0].Loc, errs[0].Type, errs[0].Message, tt.wantLoc, tt.wantTyp, tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestValidateAgainstSchema_Discriminator(t *testing.T) {
+	schemaMap := map[string]any{
+		"oneOf": []any{
+			map[string]any{"$ref": "#/$defs/Cat"},
+			map[string]any{"$ref": "#/$defs/Dog"},
+		},
+		"discriminator": map[string]any{
+			"propertyName": "type",
+			"mapping":      map[string]any{"cat": "#/$defs/Cat", "dog": "#/$defs/Dog"},
+		},
+		"$defs": map[string]any{
+			"Cat": map[string]any{"type": "object", "required": []any{"type", "lives"}},
+			"Dog": map[string]any{"type": "object", "required": []any{"type", "breed"}},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		data    string
+		wantLoc []string
+		wantTyp godantic.ErrorType
+	}{
+		{"routes to the mapped variant", `{"type": "dog"}`, []string{"breed"}, godantic.ErrorTypeRequired},
+		{"missing discriminator", `{"lives": 9}`, []string{"type"}, godantic.ErrorTypeDiscriminatorMissing},
+		{"unknown discriminator", `{"type": "bird"}`, []string{"type"}, godantic.ErrorTypeDiscriminatorInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := godantic.ValidateAgainstSchema(schemaMap, []byte(tt.data))
+			if len(errs) != 1 || !reflect.DeepEqual(errs[0].Loc, tt.wantLoc) || errs[0].Type != tt.wantTyp {
+				t.Errorf("expected one %s error at %v, got %v", tt.wantTyp, tt.wantLoc, errs)
+			}
+		})
+	}
+
+	if errs := godantic.ValidateAgainstSchema(schemaMap, []byte(`{"type": "cat", "lives": 9}`)); len(errs) != 0 {
+		t.Errorf("expected no errors, got %v", errs)
+	}
+	errs := godantic.ValidateAgainstSchema(schemaMap, []byte(`{"type": "bird"}`))
+	if want := `"type" must be one of [cat dog], got "bird"`; len(errs) != 1 || errs[0].Message != want {
+		t.Errorf("expected message %q, got %v", want, errs)
+	}
+}
+
+func TestValidateAgainstSchema_NestedLocs(t *testing.T) {
+	schemaMap := map[string]any{
+		"type":     "object",
+		"required": []any{"items"},
+		"properties": map[string]any{
+			"items": map[string]any{
+				"type": "array",
+				"items": map[string]any{
+					"type":       "object",
+					"required":   []any{"qty"},
+					"properties": map[string]any{"qty": map[string]any{"type": "integer", "minimum": 1}},
+				},
+			},
+		},
+	}
+
+	errs := godantic.ValidateAgainstSchema(schemaMap, []byte(`{"items": [{"qty": 1}, {}, {"qty": 0}]}`))
+	want := [][]string{{"items", "[1]", "qty"}, {"items", "[2]", "qty"}}
+	if len(errs) != len(want) {
+		t.Fatalf("expected %d errors, got %v", len(want), errs)
+	}
+	for i, e := range errs {
+		if !reflect.DeepEqual(e.Loc, want[i]) {
+			t.Errorf("error %d at %v, want %v", i, e.Loc, want[i])
+		}
+	}
+}
+
+func TestValidateAgainstSchema_RecursiveRef(t *testing.T) {
+	schemaMap := map[string]any{
+		"$ref": "#/$defs/Node",
+		"$defs": map[string]any{
+			"Node": map[string]any{
+				"type":     "object",
+				"required": []any{"name"},
+				"properties": map[string]any{
+					"name":     map[string]any{"type": "string"},
+					"children": map[string]any{"type": "array", "items": map[string]any{"$ref": "#/$defs/Node"}},
+				},
+			},
+		},
+	}
+
+	errs := godantic.ValidateAgainstSchema(schemaMap, []byte(`{"name": "a", "children": [{"name": "b", "children": [{}]}]}`))
+	if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeRequired {
+		t.Fatalf("expected one required error, got %v", errs)
+	}
+	if want := []string{"children", "[0]", "children", "[0]", "name"}; !reflect.DeepEqual(errs[0].Loc, want) {
+		t.Errorf("error at %v, want %v", errs[0].Loc, want)
+	}
+}
+
+func TestValidateAgainstSchema_InvalidJSON(t *testing.T) {
+	errs := godantic.ValidateAgainstSchema(map[string]any{"type": "object"}, []byte(`{"a":`))
+	if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeJSONDecode {
+		t.Fatalf("expected a json_decode error, got %v", errs)
+	}
+}
@@ -1,6 +1,7 @@
 package godantic_test
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/deepankarm/godantic/pkg/godantic"
@@ -454,3 +455,59 @@ func TestRootSliceMarshal(t *testing.T) {
 		}
 	})
 }
+
+func TestWithLenientArrays(t *testing.T) {
+	lenient := godantic.NewValidator[TUserWithSlice](godantic.WithLenientArrays())
+
+	t.Run("scalar becomes one element", func(t *testing.T) {
+		got, errs := lenient.Unmarshal([]byte(`{"name": "Ada", "tags": "go", "ids": 7}`))
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if !reflect.DeepEqual(got.Tags, []string{"go"}) || !reflect.DeepEqual(got.IDs, []int{7}) {
+			t.Errorf("got tags %v, ids %v", got.Tags, got.IDs)
+		}
+	})
+
+	t.Run("object becomes one struct", func(t *testing.T) {
+		got, errs := lenient.Unmarshal([]byte(`{"name": "Ada", "items": {"id": 1, "name": "pen"}}`))
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if want := []TItem{{ID: 1, Name: "pen"}}; !reflect.DeepEqual(got.Items, want) {
+			t.Errorf("got items %+v, want %+v", got.Items, want)
+		}
+	})
+
+	t.Run("wrapped element is validated", func(t *testing.T) {
+		_, errs := lenient.Unmarshal([]byte(`{"name": "Ada", "items": {"name": "pen"}}`))
+		if len(errs) != 1 || !reflect.DeepEqual(errs[0].Loc, []string{"Items", "[0]", "ID"}) {
+			t.Errorf("expected a required error at Items[0].ID, got %v", errs)
+		}
+	})
+
+	t.Run("arrays and null are unchanged", func(t *testing.T) {
+		got, errs := lenient.Unmarshal([]byte(`{"name": "Ada", "tags": ["a", "b"], "ids": null}`))
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if !reflect.DeepEqual(got.Tags, []string{"a", "b"}) || got.IDs != nil {
+			t.Errorf("got tags %v, ids %v", got.Tags, got.IDs)
+		}
+	})
+
+	t.Run("strict by default", func(t *testing.T) {
+		_, errs := godantic.NewValidator[TUserWithSlice]().Unmarshal([]byte(`{"name": "Ada", "tags": "go"}`))
+		if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeJSONDecode || errs[0].Loc[0] != "Tags" {
+			t.Errorf("expected a decode error at Tags, got %v", errs)
+		}
+	})
+
+	t.Run("compiled", func(t *testing.T) {
+		assertCompiledUnmarshalWith(t, lenient,
+			`{"name": "Ada", "tags": "go", "ids": 7}`,
+			`{"name": "Ada", "items": {"id": 1}}`,
+			`{"name": "Ada", "items": [{"id": 1}]}`,
+		)
+	})
+}
@@ -1,12 +1,24 @@
 package godantic
 
-import "sync"
+import (
+	"bytes"
+	"reflect"
+	"sync"
+
+	"github.com/deepankarm/godantic/pkg/internal/partialjson"
+)
 
 // StreamParser provides stateful parsing for streaming JSON chunks.
 // Designed for LLM streaming APIs (Anthropic, OpenAI, etc.)
+//
+// A StreamParser is safe for concurrent use: Feed, Reset and Buffer are
+// serialized, so the buffer is never corrupted. Chunks fed from several
+// goroutines are appended in whatever order the calls run, though, so a
+// stream whose chunks must stay in order should still be fed from one place.
 type StreamParser[T any] struct {
 	validator *Validator[T]
 	buffer    []byte
+	events    *streamEvents
 	mu        sync.Mutex
 }
 
@@ -45,7 +57,6 @@ func NewStreamParserWithValidator[T any](validator *Validator[T]) *StreamParser[
 //	}
 func (sp *StreamParser[T]) Feed(chunk []byte) (*T, *PartialState, ValidationErrors) {
 	sp.mu.Lock()
-	defer sp.mu.Unlock()
 	sp.buffer = append(sp.buffer, chunk...)
 	// Copy buffer before passing to UnmarshalPartial. The partial JSON
 	// parser repairs truncated strings via append() on sub-slices of the
@@ -53,19 +64,199 @@ func (sp *StreamParser[T]) Feed(chunk []byte) (*T, *PartialState, ValidationErro
 	// the buffer for subsequent Feed() calls.
 	data := make([]byte, len(sp.buffer))
 	copy(data, sp.buffer)
-	return sp.validator.UnmarshalPartial(data)
+	result, state, errs := sp.validator.UnmarshalPartial(data)
+	events := sp.events
+	if events != nil && !events.closed {
+		events.emit(bytes.Clone(sp.buffer), result, state, errs)
+	}
+	sp.mu.Unlock()
+
+	if events != nil {
+		events.flush(&sp.mu)
+	}
+	return result, state, errs
 }
 
-// Reset clears the buffer and starts fresh.
+// Events returns a channel of the changes seen by Feed, for consumers such as
+// live dashboards that would otherwise diff the state after every chunk. Once
+// Events has been called, each Feed sends, in order:
+//   - StreamFieldStarted when a field begins to arrive
+//   - StreamFieldCompleted with the decoded value once it can't change - when
+//     the next field begins or the document ends
+//   - StreamValidationError for each error not reported before
+//   - StreamObjectCompleted with the parsed *T when the document is complete
+//
+// The channel is buffered, and Feed blocks while it is full until the events
+// are read, so read it from another goroutine than the one calling Feed.
+// Close closes it, and unblocks a Feed waiting to send.
+//
+// Example:
+//
+//	events := parser.Events()
+//	go func() {
+//	    for ev := range events {
+//	        dashboard.Update(ev.Path, ev.Value)
+//	    }
+//	}()
+//	for chunk := range stream {
+//	    parser.Feed(chunk)
+//	}
+//	parser.Close()
+func (sp *StreamParser[T]) Events() <-chan StreamEvent {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	if sp.events == nil {
+		sp.events = newStreamEvents()
+	}
+	return sp.events.ch
+}
+
+// Close closes the channel returned by Events, dropping events not sent yet.
+// Later calls to Feed still parse but send no events.
+func (sp *StreamParser[T]) Close() {
+	sp.mu.Lock()
+	events := sp.events
+	if events == nil || events.closed {
+		sp.mu.Unlock()
+		return
+	}
+	events.closed = true
+	events.queue = nil
+	close(events.stop)
+	sp.mu.Unlock()
+
+	// Wait for a send in progress to give up before closing the channel
+	events.sending.Lock()
+	close(events.ch)
+	events.sending.Unlock()
+}
+
+// Reset clears the buffer and starts fresh. Fields of the next document are
+// reported again on the Events channel.
 func (sp *StreamParser[T]) Reset() {
 	sp.mu.Lock()
 	defer sp.mu.Unlock()
 	sp.buffer = sp.buffer[:0]
+	if sp.events != nil {
+		sp.events.reset()
+	}
 }
 
-// Buffer returns the current accumulated buffer.
+// Buffer returns a copy of the current accumulated buffer.
 func (sp *StreamParser[T]) Buffer() []byte {
 	sp.mu.Lock()
 	defer sp.mu.Unlock()
-	return sp.buffer
+	return bytes.Clone(sp.buffer)
+}
+
+// ArrayStreamParser provides stateful parsing for a streamed JSON array of T,
+// such as an LLM emitting a list of items. Each element is reported once it has
+// been fully received, so callers can act on items before the array closes.
+//
+// Like StreamParser, it is safe for concurrent use. The onItem callback runs
+// while the parser is locked, so callbacks never overlap, and it must not call
+// methods of the same parser.
+type ArrayStreamParser[T any] struct {
+	onItem    func(index int, item T)
+	buffer    []byte
+	completed int
+	mu        sync.Mutex
+}
+
+// NewArrayStreamParser creates a parser for a streaming JSON array.
+// onItem is called exactly once per element, in order, as soon as that element
+// is complete; it may be nil if you only need the results of Feed.
+func NewArrayStreamParser[T any](onItem func(index int, item T)) *ArrayStreamParser[T] {
+	return &ArrayStreamParser[T]{
+		onItem: onItem,
+		buffer: make([]byte, 0, 1024),
+	}
+}
+
+// Feed adds a new chunk of JSON data and returns every element parsed so far,
+// including a trailing element that may still be incomplete. Use Completed to
+// tell how many leading elements are final. Validation errors for the element
+// still being received are suppressed for its incomplete fields, like
+// UnmarshalPartial.
+//
+// Example:
+//
+//	parser := godantic.NewArrayStreamParser(func(i int, task Task) {
+//	    fmt.Println("task ready:", task.Title)
+//	})
+//
+//	for chunk := range stream {
+//	    tasks, state, errs := parser.Feed(chunk)
+//	    // tasks[:parser.Completed()] are final
+//	}
+func (sp *ArrayStreamParser[T]) Feed(chunk []byte) ([]T, *PartialState, ValidationErrors) {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	sp.buffer = append(sp.buffer, chunk...)
+	// Copy for the same reason as StreamParser.Feed
+	data := make([]byte, len(sp.buffer))
+	copy(data, sp.buffer)
+
+	parseResult, parseErrs := parsePartialJSON(data)
+	if parseErrs != nil {
+		return nil, &PartialState{IsComplete: false}, parseErrs
+	}
+
+	var items []T
+	result, state, errs := unmarshalPartialCommon[[]T](reflect.ValueOf(&items), parseResult, "")
+	if result == nil {
+		return nil, state, errs
+	}
+	items = *result
+
+	completed := completedArrayElements(len(items), parseResult, data)
+	for i := sp.completed; i < completed; i++ {
+		if sp.onItem != nil {
+			sp.onItem(i, items[i])
+		}
+	}
+	sp.completed = max(sp.completed, completed)
+
+	return items, state, errs
+}
+
+// Completed returns how many leading elements have been fully received.
+func (sp *ArrayStreamParser[T]) Completed() int {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	return sp.completed
+}
+
+// Reset clears the buffer and starts fresh, including element callbacks.
+func (sp *ArrayStreamParser[T]) Reset() {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	sp.buffer = sp.buffer[:0]
+	sp.completed = 0
+}
+
+// Buffer returns a copy of the current accumulated buffer.
+func (sp *ArrayStreamParser[T]) Buffer() []byte {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+	return bytes.Clone(sp.buffer)
+}
+
+// completedArrayElements counts the leading elements of a root array that are final.
+// Every element before the last was followed by a comma. The last one is final when
+// the array closed, or when the parser stopped between values ("array") and the
+// element can't grow any further - a bare number at the very end might still gain digits.
+func completedArrayElements(n int, parseResult *partialjson.ParseResult, data []byte) int {
+	if n == 0 {
+		return 0
+	}
+	switch parseResult.TruncatedAt {
+	case "complete":
+		return n
+	case "array":
+		if last := data[len(data)-1]; last < '0' || last > '9' {
+			return n
+		}
+	}
+	return n - 1
 }
@@ -0,0 +1,204 @@
+package godantic
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/deepankarm/godantic/pkg/internal/partialjson"
+)
+
+// streamEventBuffer is the capacity of the channel returned by StreamParser.Events
+const streamEventBuffer = 64
+
+// StreamEventType identifies what a StreamEvent reports.
+type StreamEventType string
+
+// Stream event types, in the order they are sent for a single field.
+const (
+	StreamFieldStarted    StreamEventType = "field_started"    // A field's key and the start of its value arrived
+	StreamFieldCompleted  StreamEventType = "field_completed"  // A field's value can no longer change
+	StreamValidationError StreamEventType = "validation_error" // Validation reported a new error
+	StreamObjectCompleted StreamEventType = "object_completed" // The whole document arrived
+)
+
+// StreamEvent is a change observed by StreamParser.Feed.
+type StreamEvent struct {
+	Type StreamEventType
+
+	// Path is the JSON path of the field, e.g. "user.email" or "items[0].price",
+	// or the Path of the error for StreamValidationError, which names fields
+	// the same way. Empty for StreamObjectCompleted.
+	Path string
+
+	// Value is the field's value decoded from JSON for StreamFieldCompleted,
+	// and the parsed *T for StreamObjectCompleted.
+	Value any
+
+	// Error is the reported error for StreamValidationError.
+	Error *ValidationError
+}
+
+// streamEvents tracks what a StreamParser has already sent on its channel.
+// Events are collected under the parser's lock and queued; they are sent
+// after it is released, holding only sending, so a Feed waiting for a reader
+// never keeps Close, Reset or Buffer from running.
+type streamEvents struct {
+	ch        chan StreamEvent
+	closed    bool
+	stop      chan struct{} // Closed by Close to abandon a send in progress
+	queue     []StreamEvent // Collected but not yet sent, guarded by the parser's lock
+	sending   sync.Mutex    // Held while sending, so events keep the order of the Feeds
+	started   map[string]bool
+	completed map[string]bool
+	reported  map[string]bool // "<path>: <message>" of sent validation errors
+	done      bool
+}
+
+func newStreamEvents() *streamEvents {
+	return &streamEvents{ch: make(chan StreamEvent, streamEventBuffer), stop: make(chan struct{})}
+}
+
+// flush sends the queued events, taking them from the queue under mu, the
+// parser's lock, which the caller must not hold. It gives up once Close
+// closes stop.
+func (se *streamEvents) flush(mu *sync.Mutex) {
+	se.sending.Lock()
+	defer se.sending.Unlock()
+	for {
+		mu.Lock()
+		events := se.queue
+		se.queue = nil
+		mu.Unlock()
+		if len(events) == 0 {
+			return
+		}
+		for _, ev := range events {
+			select {
+			case se.ch <- ev:
+			case <-se.stop:
+				return
+			}
+		}
+	}
+}
+
+// reset forgets the sent events, keeping the channel
+func (se *streamEvents) reset() {
+	se.started, se.completed, se.reported = nil, nil, nil
+	se.done = false
+}
+
+// emit queues the events for the buffer data after a Feed returned result,
+// state and errs, for flush to send.
+func (se *streamEvents) emit(data []byte, result any, state *PartialState, errs ValidationErrors) {
+	if se.started == nil {
+		se.started = make(map[string]bool)
+		se.completed = make(map[string]bool)
+		se.reported = make(map[string]bool)
+	}
+
+	if parseResult, parseErrs := parsePartialJSON(data); parseErrs == nil {
+		fields := streamFields(parseResult.Repaired, nil, nil)
+		// A field is final once anything outside it follows, such as the next
+		// key. The input ends at tail: the truncated value or key, else the
+		// last field.
+		var tail []string
+		if n := len(parseResult.Incomplete); n > 0 {
+			tail = parseResult.Incomplete[n-1]
+		} else {
+			for _, field := range fields {
+				if !field.end {
+					tail = field.path
+				}
+			}
+		}
+		for _, field := range fields {
+			name := partialjson.JoinPath(field.path)
+			if !field.end {
+				if !se.started[name] {
+					se.started[name] = true
+					se.queue = append(se.queue, StreamEvent{Type: StreamFieldStarted, Path: name})
+				}
+				continue
+			}
+			final := state.IsComplete || !hasPathPrefix(tail, field.path)
+			if final && !se.completed[name] {
+				se.completed[name] = true
+				var value any
+				_ = json.Unmarshal(field.raw, &value)
+				se.queue = append(se.queue, StreamEvent{Type: StreamFieldCompleted, Path: name, Value: value})
+			}
+		}
+	}
+
+	for _, e := range errs {
+		// Missing fields may still arrive
+		if e.Type == ErrorTypeRequired && !state.IsComplete {
+			continue
+		}
+		key := e.Path() + ": " + e.Message
+		if se.reported[key] {
+			continue
+		}
+		se.reported[key] = true
+		se.queue = append(se.queue, StreamEvent{Type: StreamValidationError, Path: e.Path(), Error: &e})
+	}
+
+	if state.IsComplete && !se.done {
+		se.done = true
+		se.queue = append(se.queue, StreamEvent{Type: StreamObjectCompleted, Value: result})
+	}
+}
+
+// streamField marks the start or, with end set, the end of an object member
+// of a JSON document
+type streamField struct {
+	path []string
+	raw  json.RawMessage
+	end  bool
+}
+
+// streamFields appends the object members found in data, at any depth, in
+// document order: each member's start, those nested in it, then its end.
+// Array elements are "[i]" path segments.
+func streamFields(data []byte, path []string, fields []streamField) []streamField {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return fields
+	}
+	switch tok {
+	case json.Delim('{'):
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return fields
+			}
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return fields
+			}
+			fieldPath := append(slices.Clone(path), key.(string))
+			fields = append(fields, streamField{path: fieldPath, raw: raw})
+			fields = streamFields(raw, fieldPath, fields)
+			fields = append(fields, streamField{path: fieldPath, raw: raw, end: true})
+		}
+	case json.Delim('['):
+		for i := 0; dec.More(); i++ {
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return fields
+			}
+			fields = streamFields(raw, append(slices.Clone(path), "["+strconv.Itoa(i)+"]"), fields)
+		}
+	}
+	return fields
+}
+
+// hasPathPrefix reports whether path is prefix or lies under it
+func hasPathPrefix(path, prefix []string) bool {
+	return len(path) >= len(prefix) && slices.Equal(path[:len(prefix)], prefix)
+}
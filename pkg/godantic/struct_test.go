@@ -252,6 +252,31 @@ func TestPointerFields(t *testing.T) {
 	})
 }
 
+// TOptionalLimit has a pointer field whose options use the element type
+type TOptionalLimit struct {
+	Limit *int `json:"limit"`
+}
+
+func (o *TOptionalLimit) FieldLimit() godantic.FieldOptions[int] {
+	return godantic.Field(godantic.Min(1))
+}
+
+func TestPointerFields_ZeroIsProvided(t *testing.T) {
+	validator := godantic.NewValidator[TOptionalLimit]()
+	zero, five := 0, 5
+
+	if errs := validator.Validate(&TOptionalLimit{}); len(errs) != 0 {
+		t.Errorf("expected nil to skip constraints, got %v", errs)
+	}
+	if errs := validator.Validate(&TOptionalLimit{Limit: &five}); len(errs) != 0 {
+		t.Errorf("expected no errors, got %v", errs)
+	}
+	errs := validator.Validate(&TOptionalLimit{Limit: &zero})
+	if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeConstraint {
+		t.Errorf("expected a pointer to 0 to be checked against Min, got %v", errs)
+	}
+}
+
 func TestNestedRequiredFieldErrors(t *testing.T) {
 	validator := godantic.NewValidator[TComplexUser]()
 	email := "test@example.com"
@@ -286,3 +311,87 @@ func TestNestedRequiredFieldErrors(t *testing.T) {
 		t.Errorf("expected HomeAddr.Street/City/ZipCode errors, got: %v", errs)
 	}
 }
+
+// TIgnoredFields has fields that encoding/json never touches. Their Field
+// methods must not produce schema properties, defaults or validation errors.
+type TIgnoredFields struct {
+	Name     string `json:"name"`
+	Computed string `json:"-"`
+	secret   string
+	internal int
+}
+
+func (f *TIgnoredFields) FieldName() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Required[string]())
+}
+
+func (f *TIgnoredFields) FieldComputed() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Required[string](), godantic.Default("computed"), godantic.MinLen(20))
+}
+
+func (f *TIgnoredFields) FieldSecret() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Required[string](), godantic.Default("secret"))
+}
+
+func (f *TIgnoredFields) FieldInternal() godantic.FieldOptions[int] {
+	return godantic.Field(godantic.Required[int](), godantic.Default(7), godantic.Min(100))
+}
+
+func TestIgnoredFields(t *testing.T) {
+	validator := godantic.NewValidator[TIgnoredFields]()
+
+	assertUntouched := func(t *testing.T, obj *TIgnoredFields) {
+		t.Helper()
+		if obj.Computed != "" || obj.secret != "" || obj.internal != 0 {
+			t.Errorf("ignored fields should stay zero, got %+v", *obj)
+		}
+	}
+
+	t.Run("Validate", func(t *testing.T) {
+		obj := &TIgnoredFields{Name: "a", Computed: "short", internal: 1}
+		if errs := validator.Validate(obj); len(errs) != 0 {
+			t.Errorf("expected no errors, got %v", errs)
+		}
+	})
+
+	t.Run("Unmarshal", func(t *testing.T) {
+		obj, errs := validator.Unmarshal([]byte(`{"name":"a","Computed":"x","-":"x","secret":"x","internal":1}`))
+		if len(errs) != 0 {
+			t.Fatalf("expected no errors, got %v", errs)
+		}
+		assertUntouched(t, obj)
+	})
+
+	t.Run("Unmarshal reports only serialized fields", func(t *testing.T) {
+		_, errs := validator.Unmarshal([]byte(`{}`))
+		if len(errs) != 1 || errs[0].Loc[0] != "Name" {
+			t.Errorf("expected only the Name error, got %v", errs)
+		}
+	})
+
+	t.Run("UnmarshalPartial", func(t *testing.T) {
+		obj, _, errs := validator.UnmarshalPartial([]byte(`{"name":"a","internal":1`))
+		if len(errs) != 0 {
+			t.Fatalf("expected no errors, got %v", errs)
+		}
+		assertUntouched(t, obj)
+	})
+
+	t.Run("ValidateFromStringMap", func(t *testing.T) {
+		obj, errs := validator.ValidateFromStringMap(map[string]string{"name": "a", "internal": "not-a-number"})
+		if len(errs) != 0 {
+			t.Fatalf("expected no errors, got %v", errs)
+		}
+		assertUntouched(t, obj)
+	})
+
+	t.Run("Marshal", func(t *testing.T) {
+		data, errs := validator.Marshal(&TIgnoredFields{Name: "a", Computed: "x", secret: "x"})
+		if len(errs) != 0 {
+			t.Fatalf("expected no errors, got %v", errs)
+		}
+		if string(data) != `{"name":"a"}` {
+			t.Errorf("expected only name, got %s", data)
+		}
+	})
+}
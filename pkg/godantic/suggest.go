@@ -0,0 +1,81 @@
+package godantic
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxSuggestionLen bounds the length, in bytes, of a value compared against
+// the allowed values. Longer input is untrusted and gets no hint, so the
+// quadratic edit distance stays cheap.
+const maxSuggestionLen = 64
+
+// suggestion returns a "did you mean" hint naming the allowed string value
+// closest to val, or "" when val is not a string or no value is a near miss.
+// A near miss is at most two edits away, and at most half the value's length.
+// Case is ignored, so "HIGH" suggests "high". Values longer than
+// maxSuggestionLen get no hint.
+func suggestion[T any](val T, allowed []T) string {
+	input := reflect.ValueOf(val)
+	if input.Kind() != reflect.String || input.Len() > maxSuggestionLen {
+		return ""
+	}
+	lowered := strings.ToLower(input.String())
+
+	best, bestDist := "", -1
+	for _, a := range allowed {
+		option := reflect.ValueOf(a)
+		if option.Kind() != reflect.String {
+			continue
+		}
+		candidate := option.String()
+		dist, ok := nearMiss(lowered, strings.ToLower(candidate), len(candidate))
+		if !ok {
+			continue
+		}
+		if bestDist < 0 || dist < bestDist {
+			best, bestDist = candidate, dist
+		}
+	}
+	if bestDist < 0 {
+		return ""
+	}
+	return fmt.Sprintf("did you mean %q?", best)
+}
+
+// nearMiss returns the edit distance between a and b when it is at most two,
+// and at most half of size. The distance is at least the difference in rune
+// counts, so pairs too far apart in length are skipped without computing it.
+func nearMiss(a, b string, size int) (int, bool) {
+	limit := min(2, size/2)
+	if diff := utf8.RuneCountInString(a) - utf8.RuneCountInString(b); diff > limit || -diff > limit {
+		return 0, false
+	}
+	dist := levenshtein(a, b)
+	return dist, dist <= limit
+}
+
+// levenshtein returns the number of single-rune insertions, deletions and
+// substitutions turning a into b
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
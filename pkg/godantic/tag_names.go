@@ -0,0 +1,147 @@
+package godantic
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/deepankarm/godantic/pkg/internal/reflectutil"
+)
+
+// tagFieldCache caches the renamed fields of a struct per tag
+var tagFieldCache sync.Map // map[tagFieldKey][]reflectutil.TaggedField
+
+type tagFieldKey struct {
+	typ reflect.Type
+	tag string
+}
+
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	jsonMarshalerType   = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// taggedFields returns the fields of typ with their names under tag
+func taggedFields(typ reflect.Type, tag string) []reflectutil.TaggedField {
+	key := tagFieldKey{typ: typ, tag: tag}
+	if cached, ok := tagFieldCache.Load(key); ok {
+		return cached.([]reflectutil.TaggedField)
+	}
+	fields := reflectutil.TaggedFields(typ, tag)
+	tagFieldCache.Store(key, fields)
+	return fields
+}
+
+// fromTagNames rewrites the object keys in data, JSON for a value of typ, from
+// their names under tag to the names encoding/json decodes. Wire keys match
+// case-insensitively; a key that is only a renamed field's json name is
+// dropped so it cannot populate the field. Data that does not have the shape
+// of typ is returned untouched for the decoder to report.
+func fromTagNames(data []byte, typ reflect.Type, tag string) []byte {
+	return renameTagKeys(data, typ, tag, false)
+}
+
+// toTagNames rewrites the keys json.Marshal wrote for a value of typ to their
+// names under tag, dropping fields the tag ignores.
+func toTagNames(data []byte, typ reflect.Type, tag string) []byte {
+	return renameTagKeys(data, typ, tag, true)
+}
+
+func renameTagKeys(data []byte, typ reflect.Type, tag string, toWire bool) []byte {
+	if tag == "" || bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return data
+	}
+	typ = reflectutil.UnwrapPointer(typ)
+	if encodesItself(typ, toWire) {
+		return data
+	}
+
+	switch typ.Kind() {
+	case reflect.Struct:
+		fields := taggedFields(typ, tag)
+		return rewriteMembers(data, func(key string, raw json.RawMessage) (string, json.RawMessage, bool) {
+			field, known := matchTaggedField(fields, key, toWire)
+			if field == nil {
+				return key, raw, !known
+			}
+			if toWire {
+				if field.TagName == "-" {
+					return key, nil, false
+				}
+				return field.TagName, renameTagKeys(raw, field.Field.Type, tag, toWire), true
+			}
+			return field.JSONName, renameTagKeys(raw, field.Field.Type, tag, toWire), true
+		})
+
+	case reflect.Slice, reflect.Array:
+		if typ.Elem().Kind() == reflect.Uint8 {
+			return data // []byte is encoded as a base64 string
+		}
+		var elements []json.RawMessage
+		if err := json.Unmarshal(data, &elements); err != nil {
+			return data
+		}
+		for i := range elements {
+			elements[i] = renameTagKeys(elements[i], typ.Elem(), tag, toWire)
+		}
+		result, err := json.Marshal(elements)
+		if err != nil {
+			return data
+		}
+		return result
+
+	case reflect.Map:
+		return rewriteObject(data, func(key string, raw json.RawMessage) (json.RawMessage, bool) {
+			return renameTagKeys(raw, typ.Elem(), tag, toWire), true
+		})
+	}
+	return data
+}
+
+// matchTaggedField finds the field a member key names. When no field matches,
+// known reports whether the key still belongs to a renamed field (its json
+// name on input), so the member should be dropped.
+func matchTaggedField(fields []reflectutil.TaggedField, key string, toWire bool) (field *reflectutil.TaggedField, known bool) {
+	if toWire {
+		for i := range fields {
+			if fields[i].JSONName == key {
+				return &fields[i], true
+			}
+		}
+		return nil, false
+	}
+
+	for i := range fields {
+		if fields[i].TagName != "-" && fields[i].TagName == key {
+			return &fields[i], true
+		}
+	}
+	for i := range fields {
+		if fields[i].TagName != "-" && strings.EqualFold(fields[i].TagName, key) {
+			return &fields[i], true
+		}
+	}
+	for i := range fields {
+		if fields[i].TagName != fields[i].JSONName && strings.EqualFold(fields[i].JSONName, key) {
+			return nil, true
+		}
+	}
+	return nil, false
+}
+
+// encodesItself reports whether typ has its own JSON encoding (or decoding),
+// so its keys are not field names
+func encodesItself(typ reflect.Type, toWire bool) bool {
+	if typ.Kind() == reflect.Struct && reflectutil.IsBasicType(typ) {
+		return true // time.Time and friends
+	}
+	ptr := reflect.PointerTo(typ)
+	if toWire {
+		return typ.Implements(jsonMarshalerType) || ptr.Implements(jsonMarshalerType)
+	}
+	return ptr.Implements(jsonUnmarshalerType) || ptr.Implements(textUnmarshalerType)
+}
@@ -0,0 +1,190 @@
+package godantic_test
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════
+// Custom Tag Name Tests
+// ═══════════════════════════════════════════════════════════════════════════
+
+type TAPILine struct {
+	SKU string `api:"item_sku" json:"sku"`
+	Qty int    `api:"item_qty" json:"qty"`
+}
+
+func (l *TAPILine) FieldQty() godantic.FieldOptions[int] {
+	return godantic.Field(godantic.Min(1), godantic.Default(1))
+}
+
+type TAPIOrder struct {
+	ID     string            `api:"order_id" json:"id"`
+	Note   string            `json:"note"`
+	Secret string            `api:"-" json:"secret"`
+	Lines  []TAPILine        `api:"order_lines" json:"lines"`
+	Ship   *TAPILine         `api:"ship_line" json:"ship"`
+	Labels map[string]string `api:"order_labels" json:"labels"`
+}
+
+func (o *TAPIOrder) FieldID() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Required[string]())
+}
+
+func TestWithTagName_Unmarshal(t *testing.T) {
+	validator := godantic.NewValidator[TAPIOrder](godantic.WithTagName("api"))
+	data := []byte(`{
+		"order_id": "o-1",
+		"note": "leave at door",
+		"secret": "ignored",
+		"order_lines": [{"item_sku": "A", "item_qty": 2}, {"ITEM_SKU": "B"}],
+		"ship_line": {"item_sku": "S", "item_qty": 1},
+		"order_labels": {"id": "kept"}
+	}`)
+
+	order, errs := validator.Unmarshal(data)
+	if len(errs) != 0 {
+		t.Fatalf("expected no errors, got %v", errs)
+	}
+	want := TAPIOrder{
+		ID:     "o-1",
+		Note:   "leave at door",
+		Lines:  []TAPILine{{SKU: "A", Qty: 2}, {SKU: "B", Qty: 1}},
+		Ship:   &TAPILine{SKU: "S", Qty: 1},
+		Labels: map[string]string{"id": "kept"},
+	}
+	if !reflect.DeepEqual(*order, want) {
+		t.Errorf("got %+v, want %+v", *order, want)
+	}
+
+	compiled, cerrs := validator.Compile().Unmarshal(data)
+	if len(cerrs) != 0 || !reflect.DeepEqual(*compiled, want) {
+		t.Errorf("compiled: got %+v %v, want %+v", *compiled, cerrs, want)
+	}
+
+	t.Run("json names do not populate renamed fields", func(t *testing.T) {
+		_, errs := validator.Unmarshal([]byte(`{"id": "o-1", "order_lines": [{"sku": "A", "item_sku": "B"}]}`))
+		if len(errs) != 1 || errs[0].Loc[0] != "order_id" || errs[0].Type != godantic.ErrorTypeRequired {
+			t.Fatalf("expected a required error on order_id, got %v", errs)
+		}
+	})
+
+	t.Run("errors name fields by the tag", func(t *testing.T) {
+		bad := []byte(`{"order_id": "o-1", "order_lines": [{"item_sku": "A", "item_qty": -1}]}`)
+		_, errs := validator.Unmarshal(bad)
+		if len(errs) != 1 || strings.Join(errs[0].Loc, ".") != "order_lines.[0].item_qty" {
+			t.Fatalf("expected an error at order_lines.[0].item_qty, got %v", errs)
+		}
+		if errs[0].Path() != "order_lines[0].item_qty" || errs[0].Pointer() != "/order_lines/0/item_qty" {
+			t.Errorf("expected tag names in Path and Pointer, got %q and %q", errs[0].Path(), errs[0].Pointer())
+		}
+
+		_, cerrs := validator.Compile().Unmarshal(bad)
+		if !reflect.DeepEqual(cerrs, errs) {
+			t.Errorf("compiled: expected %v, got %v", errs, cerrs)
+		}
+
+		verrs := validator.Validate(&TAPIOrder{ID: "o-1", Lines: []TAPILine{{SKU: "A", Qty: -1}}})
+		if len(verrs) != 1 || strings.Join(verrs[0].Loc, ".") != "order_lines.[0].item_qty" {
+			t.Errorf("Validate: expected an error at order_lines.[0].item_qty, got %v", verrs)
+		}
+	})
+
+	t.Run("report uses tag names", func(t *testing.T) {
+		_, report, errs := validator.UnmarshalWithReport([]byte(`{"order_id": "o-1", "order_lines": [{"item_sku": "A"}]}`))
+		if len(errs) != 0 {
+			t.Fatalf("expected no errors, got %v", errs)
+		}
+		if want := []string{"order_lines[0].item_qty"}; !reflect.DeepEqual(report.DefaultedFields, want) {
+			t.Errorf("expected %v, got %v", want, report.DefaultedFields)
+		}
+	})
+}
+
+func TestWithTagName_Marshal(t *testing.T) {
+	validator := godantic.NewValidator[TAPIOrder](godantic.WithTagName("api"))
+	order := TAPIOrder{ID: "o-1", Secret: "s", Lines: []TAPILine{{SKU: "A"}}}
+
+	data, errs := validator.Marshal(&order)
+	if len(errs) != 0 {
+		t.Fatalf("expected no errors, got %v", errs)
+	}
+	want := `{"order_id":"o-1","note":"","order_lines":[{"item_sku":"A","item_qty":1}],"ship_line":null,"order_labels":null}`
+	if string(data) != want {
+		t.Errorf("got %s, want %s", data, want)
+	}
+
+	roundTrip, errs := validator.Unmarshal(data)
+	if len(errs) != 0 || roundTrip.ID != "o-1" || len(roundTrip.Lines) != 1 {
+		t.Errorf("expected the output to unmarshal again, got %+v %v", roundTrip, errs)
+	}
+}
+
+func TestWithTagName_StringMap(t *testing.T) {
+	type TAPIQuery struct {
+		Page  int    `api:"p" json:"page"`
+		Query string `json:"q"`
+	}
+	validator := godantic.NewValidator[TAPIQuery](godantic.WithTagName("api"))
+
+	query, errs := validator.ValidateFromMultiValueMap(map[string][]string{"p": {"3"}, "q": {"shoes"}})
+	if len(errs) != 0 {
+		t.Fatalf("expected no errors, got %v", errs)
+	}
+	if query.Page != 3 || query.Query != "shoes" {
+		t.Errorf("got %+v", *query)
+	}
+
+	_, errs = validator.ValidateFromMultiValueMap(map[string][]string{"p": {"three"}})
+	if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeCoercion || strings.Join(errs[0].Loc, ".") != "p" {
+		t.Errorf("expected a coercion error at p, got %v", errs)
+	}
+}
+
+func TestWithTagName_Partial(t *testing.T) {
+	validator := godantic.NewValidator[TAPIOrder](godantic.WithTagName("api"))
+	data := []byte(`{"order_id": "o-1", "id": "ignored", "order_lines": [{"item_sku": "A", "item_qty": -1}, {"item_sku": "B`)
+
+	t.Run("UnmarshalPartial", func(t *testing.T) {
+		order, state, errs := validator.UnmarshalPartial(data)
+		if order == nil || order.ID != "o-1" || len(order.Lines) != 2 || order.Lines[1].SKU != "B" {
+			t.Fatalf("expected tag-named keys to be decoded, got %+v", order)
+		}
+		if state.IsComplete {
+			t.Error("expected an incomplete state")
+		}
+		if len(errs) != 1 || strings.Join(errs[0].Loc, ".") != "order_lines.[0].item_qty" {
+			t.Errorf("expected one error at order_lines.[0].item_qty, got %v", errs)
+		}
+		if !slices.Contains(state.PendingFields, "ship_line") || slices.Contains(state.PendingFields, "ship") {
+			t.Errorf("expected pending fields named by the tag, got %v", state.PendingFields)
+		}
+	})
+
+	t.Run("UnmarshalPartialInto", func(t *testing.T) {
+		order := TAPIOrder{ID: "stale", Note: "stale", Ship: &TAPILine{SKU: "stale"}}
+		_, errs := validator.UnmarshalPartialInto(data, &order)
+		if order.ID != "o-1" || order.Note != "" || order.Ship != nil {
+			t.Errorf("expected tag-named keys decoded and stale values reset, got %+v", order)
+		}
+		if len(errs) != 1 {
+			t.Errorf("expected one error, got %v", errs)
+		}
+	})
+
+	t.Run("StreamParser", func(t *testing.T) {
+		parser := godantic.NewStreamParserWithValidator(validator)
+		var order *TAPIOrder
+		for _, chunk := range []string{`{"order_id": "o-`, `1", "order_lines": [{"item_sku": "A"}`, `], "ship_line": {"item_sku": "S"}}`} {
+			order, _, _ = parser.Feed([]byte(chunk))
+		}
+		want := TAPIOrder{ID: "o-1", Lines: []TAPILine{{SKU: "A", Qty: 1}}, Ship: &TAPILine{SKU: "S", Qty: 1}}
+		if order == nil || !reflect.DeepEqual(*order, want) {
+			t.Errorf("got %+v, want %+v", order, want)
+		}
+	})
+}
@@ -255,3 +255,206 @@ func TestValidationErrorWithPointers(t *testing.T) {
 		}
 	})
 }
+
+type TEstimate struct {
+	Hours float64 `json:"hours"`
+}
+
+func (e *TEstimate) FieldHours() godantic.FieldOptions[float64] {
+	return godantic.Field(godantic.Min(0.0))
+}
+
+type TPlanTask struct {
+	Title    string    `json:"title"`
+	Estimate TEstimate `json:"estimate"`
+}
+
+func (t *TPlanTask) FieldTitle() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Required[string]())
+}
+
+type TPlan struct {
+	Owner string      `json:"owner"`
+	Tasks []TPlanTask `json:"tasks"`
+}
+
+func (p *TPlan) FieldOwner() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Required[string]())
+}
+
+func TestValidationErrorsPretty(t *testing.T) {
+	validator := godantic.NewValidator[TPlan]()
+
+	_, errs := validator.Unmarshal([]byte(`{"tasks": [
+		{"title": "a", "estimate": {"hours": 1}},
+		{"title": "b", "estimate": {"hours": 2}},
+		{"title": "c", "estimate": {"hours": -1}},
+		{"estimate": {"hours": 3}}
+	]}`))
+
+	expected := "owner: required field\n" +
+		"tasks[2].estimate.hours: value must be >= 0\n" +
+		"tasks[3].title: required field"
+	if got := errs.Pretty(); got != expected {
+		t.Errorf("Pretty() =\n%s\nwant\n%s", got, expected)
+	}
+	if got := strings.Join(errs[1].Loc, "."); got != "Tasks.[2].Estimate.Hours" {
+		t.Errorf("expected Loc to keep the Go field names, got %s", got)
+	}
+}
+
+func TestValidationErrorPointer(t *testing.T) {
+	t.Run("nested object and array index", func(t *testing.T) {
+		errs := godantic.NewValidator[TPlan]().Validate(&TPlan{
+			Owner: "ann",
+			Tasks: []TPlanTask{{Title: "a"}, {Title: "b", Estimate: TEstimate{Hours: -1}}},
+		})
+		if len(errs) != 1 || errs[0].Pointer() != "/tasks/1/estimate/hours" {
+			t.Fatalf("expected an error at /tasks/1/estimate/hours, got %v", errs)
+		}
+	})
+
+	t.Run("map keys are escaped", func(t *testing.T) {
+		type TEstimates struct {
+			ByTeam map[string]TEstimate `json:"by_team"`
+		}
+		errs := godantic.NewValidator[TEstimates]().Validate(&TEstimates{
+			ByTeam: map[string]TEstimate{"a/b~c": {Hours: -1}},
+		})
+		if len(errs) != 1 || errs[0].Pointer() != "/by_team/a~1b~0c/hours" {
+			t.Fatalf("expected an error at /by_team/a~1b~0c/hours, got %v", errs)
+		}
+	})
+}
+
+func TestUnmarshal_ReturnsSubmittedValuesOnError(t *testing.T) {
+	t.Run("struct keeps invalid values", func(t *testing.T) {
+		validator := godantic.NewValidator[TUser]()
+		user, errs := validator.Unmarshal([]byte(`{"name": "Ann", "age": 200}`))
+		if len(errs) != 2 {
+			t.Fatalf("expected errors for email and age, got %v", errs)
+		}
+		if user == nil {
+			t.Fatal("expected the submitted values alongside the errors")
+		}
+		if user.Name != "Ann" || user.Age != 200 {
+			t.Errorf("expected submitted values to be kept, got %+v", user)
+		}
+	})
+
+	t.Run("nested slice keeps every element", func(t *testing.T) {
+		validator := godantic.NewValidator[TPlan]()
+		plan, errs := validator.Unmarshal([]byte(`{"owner": "ann", "tasks": [
+			{"title": "a", "estimate": {"hours": 1}},
+			{"title": "b", "estimate": {"hours": -1}}
+		]}`))
+		if len(errs) != 1 {
+			t.Fatalf("expected one error, got %v", errs)
+		}
+		if plan == nil || len(plan.Tasks) != 2 || plan.Tasks[1].Estimate.Hours != -1 {
+			t.Errorf("expected both tasks with their submitted values, got %+v", plan)
+		}
+	})
+
+	t.Run("discriminated union keeps the variant", func(t *testing.T) {
+		validator := NewTAnimalValidator()
+		animal, errs := validator.Unmarshal([]byte(`{"species": "cat", "name": "Tom", "lives_left": 12}`))
+		if len(errs) != 1 || errs[0].Path() != "lives_left" {
+			t.Fatalf("expected one error at lives_left, got %v", errs)
+		}
+		if animal == nil {
+			t.Fatal("expected the decoded variant alongside the errors")
+		}
+		cat, ok := (*animal).(*TCat)
+		if !ok || cat.Name != "Tom" || cat.LivesLeft != 12 {
+			t.Errorf("expected the submitted cat, got %#v", *animal)
+		}
+	})
+
+	t.Run("undecodable input returns nil", func(t *testing.T) {
+		validator := godantic.NewValidator[TUser]()
+		if user, errs := validator.Unmarshal([]byte(`{"name": 1}`)); user != nil || len(errs) == 0 {
+			t.Errorf("expected nil and a decode error, got %+v, %v", user, errs)
+		}
+	})
+}
+
+type TRegistration struct {
+	Username string `json:"username"`
+	Age      int    `json:"age"`
+	Plan     string `json:"plan"`
+	Password string `json:"password"`
+}
+
+func (r *TRegistration) FieldUsername() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.MaxLen(8), godantic.Regex(`^[a-z]+$`))
+}
+
+func (r *TRegistration) FieldAge() godantic.FieldOptions[int] {
+	return godantic.Field(godantic.Min(18))
+}
+
+func (r *TRegistration) FieldPlan() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.OneOf("free", "pro"))
+}
+
+func (r *TRegistration) FieldPassword() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.MinLen(12), godantic.WriteOnly[string]())
+}
+
+func TestValidationErrorInput(t *testing.T) {
+	validator := godantic.NewValidator[TRegistration]()
+
+	inputs := func(errs godantic.ValidationErrors) map[string][]any {
+		got := map[string][]any{}
+		for _, e := range errs {
+			got[e.Path()] = append(got[e.Path()], e.Input)
+		}
+		return got
+	}
+
+	t.Run("reports the offending value", func(t *testing.T) {
+		errs := validator.Validate(&TRegistration{Username: "Ann", Age: 7, Plan: "gold", Password: "long enough secret"})
+		got := inputs(errs)
+		if len(got["username"]) != 1 || got["username"][0] != "Ann" {
+			t.Errorf("expected pattern error input %q, got %v", "Ann", got["username"])
+		}
+		if len(got["age"]) != 1 || got["age"][0] != 7 {
+			t.Errorf("expected min error input 7, got %v", got["age"])
+		}
+		if len(got["plan"]) != 1 || got["plan"][0] != "gold" {
+			t.Errorf("expected enum error input %q, got %v", "gold", got["plan"])
+		}
+	})
+
+	t.Run("truncates long strings", func(t *testing.T) {
+		long := strings.Repeat("x", 100)
+		_, errs := validator.Unmarshal([]byte(`{"username": "` + long + `", "age": 20, "plan": "pro", "password": "long enough secret"}`))
+		if len(errs) != 1 {
+			t.Fatalf("expected one maxLen error, got %v", errs)
+		}
+		if want := strings.Repeat("x", 64) + "..."; errs[0].Input != want {
+			t.Errorf("expected truncated input, got %v", errs[0].Input)
+		}
+	})
+
+	t.Run("write-only fields hide the value", func(t *testing.T) {
+		_, errs := validator.Unmarshal([]byte(`{"username": "ann", "age": 20, "plan": "pro", "password": "hunter2"}`))
+		if len(errs) != 1 || errs[0].Path() != "password" {
+			t.Fatalf("expected one password error, got %v", errs)
+		}
+		if errs[0].Input != nil {
+			t.Errorf("expected no input for a write-only field, got %v", errs[0].Input)
+		}
+	})
+
+	t.Run("custom validators report no value", func(t *testing.T) {
+		errs := godantic.NewValidator[TUser]().Validate(&TUser{Name: "Ann", Email: "ann@example.com", Age: 200})
+		if len(errs) != 1 || errs[0].Path() != "age" {
+			t.Fatalf("expected one age error, got %v", errs)
+		}
+		if errs[0].Input != nil {
+			t.Errorf("expected no input from a custom validator, got %v", errs[0].Input)
+		}
+	})
+}
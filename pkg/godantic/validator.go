@@ -1,7 +1,9 @@
 package godantic
 
 import (
+	"context"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"reflect"
 	"strconv"
@@ -30,6 +32,11 @@ const (
 	ErrorTypeDiscriminatorInvalid = errors.ErrorTypeDiscriminatorInvalid
 	ErrorTypeMismatch             = errors.ErrorTypeMismatch
 	ErrorTypeMarshalError         = errors.ErrorTypeMarshalError
+	ErrorTypeContext              = errors.ErrorTypeContext
+	ErrorTypeCoercion             = errors.ErrorTypeCoercion
+	ErrorTypeMaxDepth             = errors.ErrorTypeMaxDepth
+	ErrorTypeFileTooLarge         = errors.ErrorTypeFileTooLarge
+	ErrorTypeUnsupportedMediaType = errors.ErrorTypeUnsupportedMediaType
 )
 
 // Ordered is a constraint for types that support comparison
@@ -41,9 +48,57 @@ type Ordered interface {
 
 // FieldOptions defines validation rules and metadata
 type FieldOptions[T any] struct {
-	Required_    bool
-	Validators_  []func(T) error
-	Constraints_ map[string]any // For schema generation (description, example, min, max, minLength, etc.)
+	Required_          bool
+	Validators_        []func(T) error
+	ContextValidators_ []func(context.Context, T) error // Validators needing I/O, run with the caller's context
+	SiblingValidators_ []func(T, map[string]any) error  // Validators reading the enclosing object's raw values
+	Constraints_       map[string]any                   // For schema generation (description, example, min, max, minLength, etc.)
+	Errors_            []error                          // Construction errors (e.g. invalid Regex patterns), reported by Validator.Err
+}
+
+// erasedValidators wraps the typed validators as func(any) error so callers
+// holding only a reflect.Value can run them without reflect.Value.Call.
+func (fo FieldOptions[T]) erasedValidators() ([]func(any) error, []func(context.Context, any) error) {
+	validators := make([]func(any) error, len(fo.Validators_))
+	for i, fn := range fo.Validators_ {
+		validators[i] = func(val any) error { return fn(assertValue[T](val)) }
+	}
+	ctxValidators := make([]func(context.Context, any) error, len(fo.ContextValidators_))
+	for i, fn := range fo.ContextValidators_ {
+		ctxValidators[i] = func(ctx context.Context, val any) error { return fn(ctx, assertValue[T](val)) }
+	}
+	return validators, ctxValidators
+}
+
+// erasedSiblingValidators wraps the typed sibling validators like erasedValidators.
+func (fo FieldOptions[T]) erasedSiblingValidators() []func(any, map[string]any) error {
+	validators := make([]func(any, map[string]any) error, len(fo.SiblingValidators_))
+	for i, fn := range fo.SiblingValidators_ {
+		validators[i] = func(val any, siblings map[string]any) error { return fn(assertValue[T](val), siblings) }
+	}
+	return validators
+}
+
+// assertValue converts a field value to T, falling back to a reflect conversion
+// for values of a different but assignable type (e.g. a named slice type) and
+// taking the address of a copy when T is a pointer type
+func assertValue[T any](val any) T {
+	if typed, ok := val.(T); ok {
+		return typed
+	}
+	var zero T
+	if val == nil {
+		return zero
+	}
+	typ := reflect.TypeOf(&zero).Elem()
+	rv := reflect.ValueOf(val)
+	// Pointer fields are validated through the value they point to
+	if typ.Kind() == reflect.Pointer && rv.Type().ConvertibleTo(typ.Elem()) {
+		ptr := reflect.New(typ.Elem())
+		ptr.Elem().Set(rv.Convert(typ.Elem()))
+		return ptr.Interface().(T)
+	}
+	return rv.Convert(typ).Interface().(T)
 }
 
 func (fo FieldOptions[T]) validateWith(fn func(T) error) FieldOptions[T] {
@@ -68,6 +123,116 @@ func Required[T any]() func(FieldOptions[T]) FieldOptions[T] {
 	}
 }
 
+// ValidateCtx adds a context-aware validator for checks that need I/O, such as
+// "username not already taken". It receives the context passed to
+// ValidateContext (context.Background() for Validate and Unmarshal) and runs
+// only after the field's other validators pass.
+//
+// Example:
+//
+//	godantic.ValidateCtx(func(ctx context.Context, name string) error {
+//	    taken, err := users.Exists(ctx, name)
+//	    if err != nil {
+//	        return err
+//	    }
+//	    if taken {
+//	        return fmt.Errorf("username %q is already taken", name)
+//	    }
+//	    return nil
+//	})
+func ValidateCtx[T any](fn func(context.Context, T) error) func(FieldOptions[T]) FieldOptions[T] {
+	return func(fo FieldOptions[T]) FieldOptions[T] {
+		fo.ContextValidators_ = append(fo.ContextValidators_, fn)
+		return fo
+	}
+}
+
+// ValidateWithContext adds a validator that also receives the raw values of the
+// object holding the field, for rules that depend on a sibling such as "state is
+// required when country is US" without a struct-level hook. For nested structs
+// and slice elements, siblings is the enclosing object at the field's own level,
+// not the root.
+//
+// When unmarshaling, siblings is that object as sent, decoded by encoding/json
+// (numbers are float64, absent keys are missing) before defaults and coercion.
+// When validating a struct with Validate, it is the struct encoded as JSON.
+// It runs with the field's other validators and must not modify siblings.
+//
+// Example:
+//
+//	func (a *Address) FieldState() godantic.FieldOptions[string] {
+//	    return godantic.Field(
+//	        godantic.ValidateWithContext(func(state string, siblings map[string]any) error {
+//	            if siblings["country"] == "US" && state == "" {
+//	                return fmt.Errorf("state is required for US addresses")
+//	            }
+//	            return nil
+//	        }),
+//	    )
+//	}
+func ValidateWithContext[T any](fn func(val T, siblings map[string]any) error) func(FieldOptions[T]) FieldOptions[T] {
+	return func(fo FieldOptions[T]) FieldOptions[T] {
+		fo.SiblingValidators_ = append(fo.SiblingValidators_, fn)
+		return fo
+	}
+}
+
+// StrictRequired marks a field as required even when it also has a Default.
+// The default is not applied to the field: a missing value is reported as a
+// required error, and the field stays in the schema's "required" list when
+// schema generation uses DefaultsImplyOptional.
+func StrictRequired[T any]() func(FieldOptions[T]) FieldOptions[T] {
+	return func(fo FieldOptions[T]) FieldOptions[T] {
+		fo = ensureConstraints(fo)
+		fo.Required_ = true
+		fo.Constraints_[ConstraintStrictRequired] = true
+		return fo
+	}
+}
+
+// RequiredNonEmpty marks a field as required and also treats an empty value as
+// missing: "", an empty slice, array or map, and a nil pointer, including a
+// pointer to an empty value. It fails with a required error ("required field
+// must not be empty"), so unlike Required it rejects [] and {} sent by a client,
+// and unlike MinLen(1) or MinItems(1) it never lets the field be left out and
+// covers maps and pointers as well. The schema lists the field as required with
+// minLength, minItems or minProperties 1.
+func RequiredNonEmpty[T any]() func(FieldOptions[T]) FieldOptions[T] {
+	return func(fo FieldOptions[T]) FieldOptions[T] {
+		fo = ensureConstraints(fo)
+		fo.Required_ = true
+		fo.Constraints_[ConstraintNonEmpty] = true
+		return fo
+	}
+}
+
+// OmitEmpty drops the field from Marshal output when it holds its zero value,
+// like the `json:",omitempty"` tag but without editing the struct. Marshal applies
+// defaults first, so a field with a Default is only omitted if it ends up zero.
+// It has no effect on json.Marshal, Unmarshal or the generated schema.
+func OmitEmpty[T any]() func(FieldOptions[T]) FieldOptions[T] {
+	return func(fo FieldOptions[T]) FieldOptions[T] {
+		fo = ensureConstraints(fo)
+		fo.Constraints_[ConstraintOmitEmpty] = true
+		return fo
+	}
+}
+
+// MarshalAs replaces the field's value with fn's result in Marshal output, for
+// serialization such as date-only times or redacted secrets without a
+// MarshalJSON method on the whole struct. Validation still sees the raw value,
+// and like OmitEmpty it has no effect on json.Marshal, Unmarshal or the
+// generated schema. A nil pointer field stays null.
+func MarshalAs[T any](fn func(T) any) func(FieldOptions[T]) FieldOptions[T] {
+	return func(fo FieldOptions[T]) FieldOptions[T] {
+		fo = ensureConstraints(fo)
+		fo.Constraints_[ConstraintMarshalAs] = func(val any) any {
+			return fn(assertValue[T](val))
+		}
+		return fo
+	}
+}
+
 // Validate adds a custom validator function (can be used with Field)
 func Validate[T any](fn func(T) error) func(FieldOptions[T]) FieldOptions[T] {
 	return func(fo FieldOptions[T]) FieldOptions[T] {
@@ -76,11 +241,45 @@ func Validate[T any](fn func(T) error) func(FieldOptions[T]) FieldOptions[T] {
 	}
 }
 
+// ValidateMulti adds a custom validator reporting several problems at once,
+// such as every rule a password breaks. Each returned error becomes its own
+// ValidationError at the field's location; nil entries are ignored.
+//
+// Example:
+//
+//	godantic.ValidateMulti(func(pw string) []error {
+//	    var errs []error
+//	    if len(pw) < 12 {
+//	        errs = append(errs, errors.New("must be at least 12 characters"))
+//	    }
+//	    if !strings.ContainsAny(pw, "0123456789") {
+//	        errs = append(errs, errors.New("must contain a digit"))
+//	    }
+//	    return errs
+//	})
+func ValidateMulti[T any](fn func(T) []error) func(FieldOptions[T]) FieldOptions[T] {
+	return Validate(func(val T) error {
+		var multi errors.MultiError
+		for _, err := range fn(val) {
+			if err != nil {
+				multi = append(multi, err)
+			}
+		}
+		if len(multi) == 0 {
+			return nil
+		}
+		return multi
+	})
+}
+
 // fieldOptionHolder holds field options with type erasure
 type fieldOptionHolder struct {
-	required    bool
-	validators  []func(any) error
-	constraints map[string]any // Includes description, example, and all schema metadata
+	required          bool
+	validators        []func(any) error
+	ctxValidators     []func(context.Context, any) error
+	siblingValidators []func(any, map[string]any) error
+	constraints       map[string]any // Includes description, example, and all schema metadata
+	errs              []error        // Errors from building the field options
 }
 
 // Required returns whether the field is required
@@ -97,6 +296,7 @@ func (foh *fieldOptionHolder) Constraints() map[string]any {
 type Validator[T any] struct {
 	fieldOptions map[string]*fieldOptionHolder
 	config       validatorConfig
+	err          error // Field option construction errors, see Err
 }
 
 // NewValidator creates a new validator for type T.
@@ -117,6 +317,8 @@ func NewValidator[T any](opts ...ValidatorOption) *Validator[T] {
 	// Only scan field options if this is a concrete struct (not a discriminated union interface)
 	if v.config.discriminator == nil {
 		v.scanFieldOptions()
+	} else {
+		v.err = v.config.discriminator.err(v.config.tagName)
 	}
 
 	return v
@@ -126,11 +328,75 @@ func (v *Validator[T]) scanFieldOptions() {
 	var zero T
 	typ := reflect.TypeOf(zero)
 	v.fieldOptions = scanner.scanFieldOptionsFromType(typ)
+	if typ != nil {
+		v.err = stderrors.Join(scanner.collectOptionErrors(typ, v.config.tagName, "", map[reflect.Type]bool{})...)
+	}
+}
+
+// Err reports errors in the field definitions of T and its nested structs,
+// such as a Regex pattern that does not compile, or for a WithDiscriminator
+// union, in its variants, variants that are not structs and variants whose
+// discriminator field has a Const other than their key. Fields with such errors fail
+// validation instead of panicking, so check Err once after NewValidator (for
+// example in a test or at startup) to catch definition mistakes early.
+//
+// Example:
+//
+//	validator := godantic.NewValidator[User]()
+//	if err := validator.Err(); err != nil {
+//	    log.Fatalf("invalid User definition: %v", err)
+//	}
+func (v *Validator[T]) Err() error {
+	return v.err
 }
 
+// With returns a copy of v with opts applied on top of its options, for
+// per-request variants such as a different WithOnError hook. The copy is
+// built like NewValidator, so its field options and Err reflect the merged
+// options; v is unchanged. Variants added to v with RegisterVariant are shared
+// unless opts replace the discriminator.
+//
+// Example:
+//
+//	base := godantic.NewValidator[Event](godantic.WithDiscriminator("type", variants))
+//	v := base.With(godantic.WithOnError(func(errs godantic.ValidationErrors) {
+//	    log.Printf("request %s: %v", requestID, errs)
+//	}))
+func (v *Validator[T]) With(opts ...ValidatorOption) *Validator[T] {
+	return NewValidator[T](append([]ValidatorOption{v.config}, opts...)...)
+}
+
+// Validate validates obj against the field options of T.
+// Hooks registered with WithOnError/WithOnSuccess run synchronously before it returns.
 func (v *Validator[T]) Validate(obj *T) ValidationErrors {
 	objPtr := reflect.ValueOf(obj)
-	return walkValidate(objPtr)
+	errs := walkValidate(objPtr, &v.config)
+	v.notify(errs)
+	return errs
+}
+
+// ValidateContext validates obj like Validate, passing ctx to validators added
+// with ValidateCtx (including those on nested structs and slice elements).
+// If ctx is cancelled or its deadline passes, validation stops and the result
+// ends with an ErrorTypeContext error.
+func (v *Validator[T]) ValidateContext(ctx context.Context, obj *T) ValidationErrors {
+	objPtr := reflect.ValueOf(obj)
+	errs := walkValidateContext(ctx, objPtr, &v.config)
+	v.notify(errs)
+	return errs
+}
+
+// notify invokes the configured OnError/OnSuccess hooks for a validation result.
+func (v *Validator[T]) notify(errs ValidationErrors) {
+	if len(errs) > 0 {
+		if v.config.onError != nil {
+			v.config.onError(errs)
+		}
+		return
+	}
+	if v.config.onSuccess != nil {
+		v.config.onSuccess()
+	}
 }
 
 // ApplyDefaults applies default values to zero-valued fields that have defaults defined.
@@ -147,10 +413,74 @@ func (v *Validator[T]) ApplyDefaults(obj *T) error {
 // 2. Apply default values to zero-valued fields
 // 3. Validate the struct
 // Returns the populated struct and any validation errors.
+//
+// Once the JSON decodes, the struct is returned even when validation fails,
+// holding the submitted values (invalid ones included) with defaults applied,
+// so a handler can re-render a form with the user's input. It is nil only when
+// nothing could be decoded: malformed JSON, a decode error on a field, an
+// unknown or missing discriminator, or a failing BeforeValidate hook. An
+// AfterValidate hook only runs, and can only fail, once validation passed.
+//
+// Hooks registered with WithOnError/WithOnSuccess run synchronously before it returns.
 func (v *Validator[T]) Unmarshal(data []byte) (*T, ValidationErrors) {
+	obj, errs := v.unmarshal(data, nil)
+	v.notify(errs)
+	return obj, errs
+}
+
+// UnmarshalUnion behaves like Unmarshal and also returns the discriminator
+// value that selected the variant of a WithDiscriminator union, such as "dog",
+// for logging or metrics without a type switch. The variant is returned
+// whenever one was selected, even if decoding or validating it then failed,
+// and is "" when the discriminator is missing or unknown, or T is not a union.
+//
+// Example:
+//
+//	animal, variant, errs := validator.UnmarshalUnion(data)
+//	metrics.Inc("animals." + variant)
+func (v *Validator[T]) UnmarshalUnion(data []byte) (*T, string, ValidationErrors) {
+	if v.config.discriminator == nil {
+		obj, errs := v.Unmarshal(data)
+		return obj, "", errs
+	}
+	obj, variant, errs := v.validateDiscriminatedUnion(data, v.config.discriminator, nil)
+	v.notify(errs)
+	return obj, variant, errs
+}
+
+// Report describes how Unmarshal populated a value.
+type Report struct {
+	// DefaultedFields lists JSON paths (e.g. "config.port", "items[0].qty") of
+	// fields that were absent from the input and filled from their Default.
+	// Fields present in the JSON are never listed, even when an explicit zero
+	// value is replaced by the default.
+	DefaultedFields []string
+}
+
+// UnmarshalWithReport behaves like Unmarshal and also reports which fields were
+// filled by defaults rather than provided in the JSON. Useful for checking how
+// closely LLM output follows the schema.
+//
+// Example:
+//
+//	cfg, report, errs := validator.UnmarshalWithReport(data)
+//	for _, path := range report.DefaultedFields {
+//	    log.Printf("model omitted %s, using default", path)
+//	}
+func (v *Validator[T]) UnmarshalWithReport(data []byte) (*T, Report, ValidationErrors) {
+	var report Report
+	obj, errs := v.unmarshal(data, &report)
+	v.notify(errs)
+	return obj, report, errs
+}
+
+// unmarshal implements Unmarshal without invoking validation hooks.
+// If report is non-nil, it is populated with the fields filled by defaults.
+func (v *Validator[T]) unmarshal(data []byte, report *Report) (*T, ValidationErrors) {
 	// Check if this is a discriminated union validator
 	if v.config.discriminator != nil {
-		return v.validateDiscriminatedUnion(data, v.config.discriminator)
+		obj, _, errs := v.validateDiscriminatedUnion(data, v.config.discriminator, report)
+		return obj, errs
 	}
 
 	var obj T
@@ -168,7 +498,7 @@ func (v *Validator[T]) Unmarshal(data []byte) (*T, ValidationErrors) {
 	}
 
 	// Use the tree walker for unmarshal + defaults + validation
-	errs := walkParse(objPtr, data)
+	errs := walkParse(objPtr, data, &v.config, report)
 
 	// Return nil on JSON decode errors (before we have a valid struct)
 	for _, e := range errs {
@@ -234,6 +564,9 @@ func (v *Validator[T]) transformSliceHooks(objPtr reflect.Value, data []byte) ([
 // 1. Validate the struct
 // 2. Apply default values to zero-valued fields
 // 3. Marshal the struct to JSON
+// Both `json:",omitempty"` tags and OmitEmpty field options are checked after step 2,
+// so a zero field that gets a non-zero default is always present in the output.
+// MarshalAs field options transform the encoded value only, after validation.
 // Returns the JSON bytes and any validation errors.
 func (v *Validator[T]) Marshal(obj *T) ([]byte, ValidationErrors) {
 	// Check if this is a discriminated union validator
@@ -250,8 +583,8 @@ func (v *Validator[T]) Marshal(obj *T) ([]byte, ValidationErrors) {
 		}}
 	}
 
-	// Validate struct
-	errs := v.Validate(obj)
+	// Validate struct, without the OnError/OnSuccess hooks, which observe input
+	errs := walkValidate(reflect.ValueOf(obj), &v.config)
 	if len(errs) > 0 {
 		return nil, errs
 	}
@@ -265,7 +598,7 @@ func (v *Validator[T]) Marshal(obj *T) ([]byte, ValidationErrors) {
 		}}
 	}
 
-	// Marshal to JSON
+	// Marshal to JSON, then drop zero-valued OmitEmpty fields and transform MarshalAs fields
 	data, err := json.Marshal(obj)
 	if err != nil {
 		return nil, ValidationErrors{{
@@ -274,6 +607,8 @@ func (v *Validator[T]) Marshal(obj *T) ([]byte, ValidationErrors) {
 			Type:    ErrorTypeJSONEncode,
 		}}
 	}
+	data = applyMarshalOptions(reflect.ValueOf(obj).Elem(), data)
+	data = toTagNames(data, reflect.TypeOf(obj), v.config.tagName)
 
 	// AfterSerialize hook: transform JSON after marshaling
 	data, err = callAfterSerializeHook[T](data)
@@ -327,5 +662,53 @@ func (v *Validator[T]) UnmarshalPartial(data []byte) (*T, *PartialState, Validat
 	var obj T
 	objPtr := reflect.New(reflect.TypeOf(obj))
 
-	return unmarshalPartialCommon[T](objPtr, parseResult)
+	return unmarshalPartialCommon[T](objPtr, parseResult, v.config.tagName)
+}
+
+// UnmarshalPartialInto parses potentially incomplete JSON into a caller-provided struct.
+// It behaves like UnmarshalPartial but reuses dst instead of allocating a new value,
+// which reduces GC pressure when re-parsing a growing buffer in a streaming loop.
+//
+// Fields present in data are decoded in place (slices reuse their capacity, maps and
+// pointers their storage). Fields absent from data are reset to their zero value so
+// stale values from a previous call don't survive; defaults are then reapplied.
+//
+// Example:
+//
+//	var call ToolCall
+//	for chunk := range stream {
+//	    buf = append(buf, chunk...)
+//	    state, errs := validator.UnmarshalPartialInto(buf, &call)
+//	    render(&call, state)
+//	}
+func (v *Validator[T]) UnmarshalPartialInto(data []byte, dst *T) (PartialState, ValidationErrors) {
+	if dst == nil {
+		return PartialState{}, ValidationErrors{{Loc: []string{}, Message: "dst must not be nil", Type: ErrorTypeInternal}}
+	}
+
+	// Discriminated unions may switch concrete type between calls, so there is
+	// nothing to reuse safely - decode fresh and assign.
+	if v.config.discriminator != nil {
+		result, state, errs := v.unmarshalPartialDiscriminatedUnion(data, v.config.discriminator)
+		if result != nil {
+			*dst = *result
+		}
+		return *state, errs
+	}
+
+	parseResult, parseErrs := parsePartialJSON(data)
+	if parseErrs != nil {
+		return PartialState{IsComplete: false}, parseErrs
+	}
+
+	state, errs, ok := decodePartial(reflect.ValueOf(dst), parseResult, true, v.config.tagName)
+	if !ok {
+		return *state, errs
+	}
+
+	if hookErrs := applyAfterValidateIfComplete(dst, state); hookErrs != nil {
+		return *state, hookErrs
+	}
+
+	return *state, errs
 }
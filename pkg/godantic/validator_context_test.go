@@ -0,0 +1,193 @@
+package godantic_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════
+// ValidateContext / ValidateCtx Tests
+// ═══════════════════════════════════════════════════════════════════════════
+
+type ctxKey string
+
+// takenUsernames simulates a datastore lookup keyed off the request context
+var takenUsernames = map[string]bool{"admin": true, "root": true}
+
+func usernameAvailable(ctx context.Context, name string) error {
+	if tenant, _ := ctx.Value(ctxKey("tenant")).(string); tenant == "readonly" {
+		return fmt.Errorf("signups disabled for tenant %q", tenant)
+	}
+	if takenUsernames[name] {
+		return fmt.Errorf("username %q is already taken", name)
+	}
+	return nil
+}
+
+type TSignup struct {
+	Username string
+	Profile  TSignupProfile
+	Invites  []TSignupProfile
+}
+
+type TSignupProfile struct {
+	Handle string
+}
+
+func (s *TSignup) FieldUsername() godantic.FieldOptions[string] {
+	return godantic.Field(
+		godantic.Required[string](),
+		godantic.MinLen(3),
+		godantic.ValidateCtx(usernameAvailable),
+	)
+}
+
+func (p *TSignupProfile) FieldHandle() godantic.FieldOptions[string] {
+	return godantic.Field(
+		godantic.ValidateCtx(func(ctx context.Context, handle string) error {
+			if strings.HasPrefix(handle, "@") {
+				return usernameAvailable(ctx, handle[1:])
+			}
+			return nil
+		}),
+	)
+}
+
+func TestValidateContext(t *testing.T) {
+	validator := godantic.NewValidator[TSignup]()
+
+	t.Run("passes when available", func(t *testing.T) {
+		errs := validator.ValidateContext(context.Background(), &TSignup{Username: "alice"})
+		if len(errs) > 0 {
+			t.Errorf("unexpected errors: %v", errs)
+		}
+	})
+
+	t.Run("reports context validator errors", func(t *testing.T) {
+		errs := validator.ValidateContext(context.Background(), &TSignup{Username: "admin"})
+		if len(errs) != 1 {
+			t.Fatalf("expected 1 error, got %v", errs)
+		}
+		if errs[0].Type != godantic.ErrorTypeConstraint || errs[0].Loc[0] != "Username" {
+			t.Errorf("unexpected error: %v", errs[0])
+		}
+	})
+
+	t.Run("context values reach validators", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), ctxKey("tenant"), "readonly")
+		errs := validator.ValidateContext(ctx, &TSignup{Username: "alice"})
+		if len(errs) != 1 || !strings.Contains(errs[0].Message, "signups disabled") {
+			t.Errorf("expected tenant error, got %v", errs)
+		}
+	})
+
+	t.Run("propagates to nested structs and slices", func(t *testing.T) {
+		errs := validator.ValidateContext(context.Background(), &TSignup{
+			Username: "alice",
+			Profile:  TSignupProfile{Handle: "@root"},
+			Invites:  []TSignupProfile{{Handle: "bob"}, {Handle: "@admin"}},
+		})
+		if len(errs) != 2 {
+			t.Fatalf("expected 2 errors, got %v", errs)
+		}
+		if strings.Join(errs[0].Loc, ".") != "Profile.Handle" {
+			t.Errorf("expected Profile.Handle, got %v", errs[0].Loc)
+		}
+		if strings.Join(errs[1].Loc, ".") != "Invites.[1].Handle" {
+			t.Errorf("expected Invites.[1].Handle, got %v", errs[1].Loc)
+		}
+	})
+
+	t.Run("skipped when synchronous validators fail", func(t *testing.T) {
+		lookups := 0
+		countedLookupHook = func() { lookups++ }
+		defer func() { countedLookupHook = nil }()
+
+		v := godantic.NewValidator[TCountedLookup]()
+		errs := v.ValidateContext(context.Background(), &TCountedLookup{Name: "ab"})
+		if len(errs) != 1 || !strings.Contains(errs[0].Message, "length") {
+			t.Errorf("expected only the MinLen error, got %v", errs)
+		}
+		if lookups != 0 {
+			t.Errorf("expected no lookups for an invalid value, got %d", lookups)
+		}
+	})
+
+	t.Run("cancelled context stops validation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		errs := validator.ValidateContext(ctx, &TSignup{Username: "admin"})
+		if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeContext {
+			t.Fatalf("expected a single context error, got %v", errs)
+		}
+	})
+
+	t.Run("cancellation during a validator stops the walk", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		var visited int
+		v := godantic.NewValidator[TCancelProbe]()
+		cancelProbeHook = func() {
+			visited++
+			cancel()
+		}
+		defer func() { cancelProbeHook = nil }()
+
+		errs := v.ValidateContext(ctx, &TCancelProbe{Items: []TCancelProbeItem{{"a"}, {"b"}, {"c"}}})
+		if visited != 1 {
+			t.Errorf("expected walk to stop after first validator, visited %d", visited)
+		}
+		if len(errs) == 0 || errs[len(errs)-1].Type != godantic.ErrorTypeContext {
+			t.Errorf("expected trailing context error, got %v", errs)
+		}
+	})
+
+	t.Run("Validate runs context validators with background context", func(t *testing.T) {
+		errs := validator.Validate(&TSignup{Username: "root"})
+		if len(errs) != 1 {
+			t.Errorf("expected uniqueness error, got %v", errs)
+		}
+	})
+}
+
+var cancelProbeHook func()
+
+type TCancelProbe struct {
+	Items []TCancelProbeItem
+}
+
+type TCancelProbeItem struct {
+	Name string
+}
+
+func (i *TCancelProbeItem) FieldName() godantic.FieldOptions[string] {
+	return godantic.Field(
+		godantic.ValidateCtx(func(ctx context.Context, _ string) error {
+			if cancelProbeHook != nil {
+				cancelProbeHook()
+			}
+			return ctx.Err()
+		}),
+	)
+}
+
+var countedLookupHook func()
+
+type TCountedLookup struct {
+	Name string
+}
+
+func (c *TCountedLookup) FieldName() godantic.FieldOptions[string] {
+	return godantic.Field(
+		godantic.MinLen(3),
+		godantic.ValidateCtx(func(context.Context, string) error {
+			if countedLookupHook != nil {
+				countedLookupHook()
+			}
+			return nil
+		}),
+	)
+}
@@ -1,33 +1,75 @@
 package godantic
 
 import (
+	"bytes"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"reflect"
 
 	"github.com/deepankarm/godantic/pkg/internal/reflectutil"
 )
 
-// validateDiscriminatedUnion handles validation for discriminated union types (interfaces)
-func (v *Validator[T]) validateDiscriminatedUnion(data []byte, cfg *discriminatorConfig) (*T, ValidationErrors) {
+// RegisterVariant adds a variant to a WithDiscriminator union after the
+// validator is built, for plugins that contribute their own types at runtime.
+// Unmarshal, UnmarshalPartial and Marshal accept the variant as soon as it is
+// registered, including on validators made with With, which share the
+// variants; compiled validators decode it through the regular path. It is
+// safe to call while the validator is in use.
+//
+// It returns an error, and registers nothing, when the validator is not a
+// discriminated union, value is already registered, proto is not a struct or
+// pointer to a struct, or proto has the field definition errors Err reports.
+//
+// Example:
+//
+//	if err := animals.RegisterVariant("axolotl", Axolotl{}); err != nil {
+//	    return fmt.Errorf("plugin %s: %w", name, err)
+//	}
+func (v *Validator[T]) RegisterVariant(value string, proto any) error {
+	cfg := v.config.discriminator
+	if cfg == nil {
+		return fmt.Errorf("register variant %q: validator has no discriminator, see WithDiscriminator", value)
+	}
+	typ := reflect.TypeOf(proto)
+	if typ == nil || reflectutil.UnwrapPointer(typ).Kind() != reflect.Struct {
+		return fmt.Errorf("discriminator variant %q: %v is not a struct", value, typ)
+	}
+	if err := stderrors.Join(cfg.variantErrors(value, typ, v.config.tagName)...); err != nil {
+		return err
+	}
+
+	cfg.mu.Lock()
+	defer cfg.mu.Unlock()
+	if existing, ok := cfg.variants[value]; ok {
+		return fmt.Errorf("discriminator variant %q is already registered as %v", value, existing)
+	}
+	cfg.variants[value] = typ
+	return nil
+}
+
+// validateDiscriminatedUnion handles validation for discriminated union types
+// (interfaces). It also returns the discriminator value of the selected
+// variant, or "" when none was selected.
+func (v *Validator[T]) validateDiscriminatedUnion(data []byte, cfg *discriminatorConfig, report *Report) (*T, string, ValidationErrors) {
 	instance, errs := newUnionFromJSON[T](data, cfg)
 	if errs != nil {
-		return nil, errs
+		return nil, "", errs
 	}
 
 	// Use Walker for unmarshal + defaults + validation (single traversal)
-	if walkErrs := walkParse(instance.ptr, data); len(walkErrs) > 0 {
+	if walkErrs := walkParse(instance.ptr, data, &v.config, report); len(walkErrs) > 0 {
 		for _, e := range walkErrs {
 			if e.Type == ErrorTypeJSONDecode {
-				return nil, walkErrs
+				return nil, instance.variant, walkErrs
 			}
 		}
 		result := instance.Result()
-		return &result, walkErrs
+		return &result, instance.variant, walkErrs
 	}
 
 	result := instance.Result()
-	return &result, nil
+	return &result, instance.variant, nil
 }
 
 // marshalDiscriminatedUnion handles marshaling (struct → JSON) for discriminated unions
@@ -40,7 +82,7 @@ func (v *Validator[T]) marshalDiscriminatedUnion(obj *T, cfg *discriminatorConfi
 	if err := walkDefaults(instance.ptr); err != nil {
 		return nil, ValidationErrors{{Message: fmt.Sprintf("apply defaults failed: %v", err), Type: ErrorTypeInternal}}
 	}
-	if errs := walkValidate(instance.ptr); len(errs) > 0 {
+	if errs := walkValidate(instance.ptr, &v.config); len(errs) > 0 {
 		return nil, errs
 	}
 
@@ -48,13 +90,61 @@ func (v *Validator[T]) marshalDiscriminatedUnion(obj *T, cfg *discriminatorConfi
 	if err != nil {
 		return nil, ValidationErrors{{Message: fmt.Sprintf("json marshal failed: %v", err), Type: ErrorTypeJSONEncode}}
 	}
+	data = applyMarshalOptions(instance.ptr.Elem(), data)
+	data = toTagNames(data, instance.concreteType, v.config.tagName)
+	if key := v.config.discriminatorOutputKey; key != "" && key != cfg.field {
+		data = renameDiscriminatorKey(data, cfg.field, key)
+	}
 	return data, nil
 }
 
+// renameDiscriminatorKey moves the discriminator member from field to key,
+// keeping its position. A member already named key is dropped.
+func renameDiscriminatorKey(data []byte, field, key string) []byte {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return data
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return data
+		}
+		name, _ := tok.(string)
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return data
+		}
+
+		switch name {
+		case key:
+			continue
+		case field:
+			name = key
+		}
+		encodedName, err := json.Marshal(name)
+		if err != nil {
+			return data
+		}
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		buf.Write(encodedName)
+		buf.WriteByte(':')
+		buf.Write(raw)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes()
+}
+
 // unionInstance encapsulates discriminated union processing state
 type unionInstance[T any] struct {
 	ptr          reflect.Value
 	concreteType reflect.Type
+	variant      string // Discriminator value that selected concreteType
 }
 
 // Result converts the internal value to the target interface type T.
@@ -74,13 +164,14 @@ func newUnionFromJSON[T any](data []byte, cfg *discriminatorConfig) (*unionInsta
 		return nil, ValidationErrors{{Loc: []string{cfg.field}, Message: fmt.Sprintf("discriminator field '%s' not found", cfg.field), Type: ErrorTypeDiscriminatorMissing}}
 	}
 
-	concreteType, validationErr := cfg.lookupConcreteType(fmt.Sprintf("%v", discValue))
+	variant := fmt.Sprintf("%v", discValue)
+	concreteType, validationErr := cfg.lookupConcreteType(variant)
 	if validationErr != nil {
 		return nil, ValidationErrors{*validationErr}
 	}
 
 	elemType := reflectutil.UnwrapPointer(concreteType)
-	return &unionInstance[T]{ptr: reflect.New(elemType), concreteType: concreteType}, nil
+	return &unionInstance[T]{ptr: reflect.New(elemType), concreteType: concreteType, variant: variant}, nil
 }
 
 // newUnionFromStruct creates a union instance from an existing struct value.
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"slices"
+	"strings"
 
 	"github.com/deepankarm/godantic/pkg/internal/reflectutil"
 )
@@ -22,7 +24,10 @@ func (v *Validator[T]) unmarshalPartialDiscriminatedUnion(data []byte, cfg *disc
 		// If discriminator is incomplete or missing, we can't determine the type yet
 		partialState := buildPartialStateFromPaths(parseResult.Incomplete, parseResult.TruncatedAt)
 
-		// Add discriminator as incomplete field
+		// Add discriminator as incomplete field, replacing the parser's entry for it
+		partialState.IncompleteFields = slices.DeleteFunc(partialState.IncompleteFields, func(f IncompleteField) bool {
+			return f.JSONPath == cfg.field
+		})
 		partialState.IncompleteFields = append([]IncompleteField{{
 			Path:     []string{cfg.field},
 			JSONPath: cfg.field,
@@ -34,7 +39,7 @@ func (v *Validator[T]) unmarshalPartialDiscriminatedUnion(data []byte, cfg *disc
 	}
 
 	// Use common partial marshal flow
-	result, state, errs := unmarshalPartialCommon[T](instance.ptr, parseResult)
+	result, state, errs := unmarshalPartialCommon[T](instance.ptr, parseResult, v.config.tagName)
 	if result == nil {
 		return nil, state, errs
 	}
@@ -69,10 +74,10 @@ func newUnionFromJSONPartial[T any](repairedData []byte, cfg *discriminatorConfi
 			discValueStr = fmt.Sprintf("%v", discValue)
 		}
 
-		// Try to look up the type anyway (might be partial like "do" -> "dog")
-		// But if it fails, that's expected
+		// A partial value that already names a variant selects it, unless it
+		// could still grow into another one ("text" on the way to "text_delta")
 		concreteType, validationErr := cfg.lookupConcreteType(discValueStr)
-		if validationErr != nil || concreteType == nil {
+		if validationErr != nil || concreteType == nil || !ok || cfg.isVariantPrefix(discValueStr) {
 			return nil, ValidationErrors{{
 				Loc:     []string{cfg.field},
 				Message: fmt.Sprintf("discriminator field '%s' is incomplete or missing", cfg.field),
@@ -93,3 +98,15 @@ func newUnionFromJSONPartial[T any](repairedData []byte, cfg *discriminatorConfi
 	elemType := reflectutil.UnwrapPointer(concreteType)
 	return &unionInstance[T]{ptr: reflect.New(elemType), concreteType: concreteType}, nil
 }
+
+// isVariantPrefix reports whether value is a proper prefix of a variant key
+func (cfg *discriminatorConfig) isVariantPrefix(value string) bool {
+	cfg.mu.RLock()
+	defer cfg.mu.RUnlock()
+	for key := range cfg.variants {
+		if len(key) > len(value) && strings.HasPrefix(key, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,191 @@
+package godantic_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+)
+
+func TestWithOnError(t *testing.T) {
+	var calls int
+	var received godantic.ValidationErrors
+	validator := godantic.NewValidator[TUser](
+		godantic.WithOnError(func(errs godantic.ValidationErrors) {
+			calls++
+			received = errs
+		}),
+	)
+
+	t.Run("validate failure invokes hook", func(t *testing.T) {
+		calls, received = 0, nil
+		errs := validator.Validate(&TUser{})
+		if len(errs) == 0 {
+			t.Fatal("expected validation errors")
+		}
+		if calls != 1 {
+			t.Fatalf("expected hook to be called once, got %d", calls)
+		}
+		if len(received) != len(errs) || received[0].Message != errs[0].Message {
+			t.Errorf("hook received %v, caller got %v", received, errs)
+		}
+	})
+
+	t.Run("unmarshal failure invokes hook", func(t *testing.T) {
+		calls, received = 0, nil
+		_, errs := validator.Unmarshal([]byte(`{"age": 30}`))
+		if len(errs) == 0 {
+			t.Fatal("expected validation errors")
+		}
+		if calls != 1 {
+			t.Fatalf("expected hook to be called once, got %d", calls)
+		}
+		if len(received) != len(errs) {
+			t.Errorf("hook received %d errors, caller got %d", len(received), len(errs))
+		}
+	})
+
+	t.Run("json decode failure invokes hook", func(t *testing.T) {
+		calls = 0
+		_, errs := validator.Unmarshal([]byte(`{invalid`))
+		if !errs.HasJSONDecodeError() {
+			t.Fatalf("expected json decode error, got %v", errs)
+		}
+		if calls != 1 {
+			t.Errorf("expected hook to be called once, got %d", calls)
+		}
+	})
+
+	t.Run("success does not invoke error hook", func(t *testing.T) {
+		calls = 0
+		_, errs := validator.Unmarshal([]byte(`{"name": "Alice", "email": "alice@example.com", "age": 30}`))
+		if len(errs) > 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if calls != 0 {
+			t.Errorf("expected no hook calls, got %d", calls)
+		}
+	})
+}
+
+func TestWithOnSuccess(t *testing.T) {
+	var successes, failures int
+	validator := godantic.NewValidator[TUser](
+		godantic.WithOnSuccess(func() { successes++ }),
+		godantic.WithOnError(func(godantic.ValidationErrors) { failures++ }),
+	)
+
+	validator.Validate(&TUser{Name: "Alice", Email: "alice@example.com", Age: 30})
+	validator.Unmarshal([]byte(`{"name": "Bob", "email": "bob@example.com", "age": 40}`))
+	validator.Unmarshal([]byte(`{}`))
+
+	if successes != 2 {
+		t.Errorf("expected 2 successes, got %d", successes)
+	}
+	if failures != 1 {
+		t.Errorf("expected 1 failure, got %d", failures)
+	}
+}
+
+func TestObserverHooks_NotCalledByMarshal(t *testing.T) {
+	var successes, failures int
+	validator := godantic.NewValidator[TUser](
+		godantic.WithOnSuccess(func() { successes++ }),
+		godantic.WithOnError(func(godantic.ValidationErrors) { failures++ }),
+	)
+
+	if _, errs := validator.Marshal(&TUser{Name: "Alice", Email: "alice@example.com", Age: 30}); len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if _, errs := validator.Marshal(&TUser{}); len(errs) == 0 {
+		t.Fatal("expected validation errors")
+	}
+	if successes != 0 || failures != 0 {
+		t.Errorf("expected Marshal not to call the hooks, got %d successes and %d failures", successes, failures)
+	}
+}
+
+func TestWithOnError_DiscriminatedUnion(t *testing.T) {
+	var calls int
+	validator := godantic.NewValidator[TAnimal](
+		godantic.WithDiscriminatorTyped("species", map[TAnimalSpecies]any{
+			TSpeciesCat: &TCat{},
+			TSpeciesDog: &TDog{},
+		}),
+		godantic.WithOnError(func(godantic.ValidationErrors) { calls++ }),
+	)
+
+	_, errs := validator.Unmarshal([]byte(`{"species": "fish"}`))
+	if len(errs) == 0 {
+		t.Fatal("expected discriminator error")
+	}
+	if calls != 1 {
+		t.Errorf("expected hook to be called once, got %d", calls)
+	}
+}
+
+func TestValidator_With(t *testing.T) {
+	var baseCalls, cloneCalls int
+	base := godantic.NewValidator[TAnimal](
+		godantic.WithDiscriminatorTyped("species", map[TAnimalSpecies]any{
+			TSpeciesCat: &TCat{},
+			TSpeciesDog: &TDog{},
+		}),
+		godantic.WithOnError(func(godantic.ValidationErrors) { baseCalls++ }),
+	)
+	clone := base.With(godantic.WithOnError(func(godantic.ValidationErrors) { cloneCalls++ }))
+
+	t.Run("clone keeps the discriminator", func(t *testing.T) {
+		animal, errs := clone.Unmarshal([]byte(`{"species": "cat", "name": "Tom", "lives_left": 9}`))
+		if len(errs) != 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if cat, ok := (*animal).(*TCat); !ok || cat.Name != "Tom" {
+			t.Errorf("expected *TCat named Tom, got %#v", *animal)
+		}
+	})
+
+	t.Run("clone uses the overridden hook", func(t *testing.T) {
+		baseCalls, cloneCalls = 0, 0
+		if _, errs := clone.Unmarshal([]byte(`{"species": "fish"}`)); len(errs) == 0 {
+			t.Fatal("expected discriminator error")
+		}
+		if cloneCalls != 1 || baseCalls != 0 {
+			t.Errorf("expected only the clone's hook to run, got clone=%d base=%d", cloneCalls, baseCalls)
+		}
+	})
+
+	t.Run("base is unchanged", func(t *testing.T) {
+		baseCalls, cloneCalls = 0, 0
+		if _, errs := base.Unmarshal([]byte(`{"species": "fish"}`)); len(errs) == 0 {
+			t.Fatal("expected discriminator error")
+		}
+		if baseCalls != 1 || cloneCalls != 0 {
+			t.Errorf("expected only the base hook to run, got clone=%d base=%d", cloneCalls, baseCalls)
+		}
+	})
+
+	t.Run("struct validator", func(t *testing.T) {
+		lenient := godantic.NewValidator[tForwardedHeaders]()
+		strict := lenient.With(godantic.WithStrictSingleValue())
+		headers := map[string][]string{"X-Api-Key": {"first", "second"}}
+		if _, errs := strict.ValidateFromHeaders(headers); len(errs) != 1 {
+			t.Errorf("expected a single-value error from the clone, got %v", errs)
+		}
+		if _, errs := lenient.ValidateFromHeaders(headers); len(errs) != 0 {
+			t.Errorf("expected the base to stay lenient, got %v", errs)
+		}
+	})
+	t.Run("clone rechecks a replaced discriminator", func(t *testing.T) {
+		if err := base.Err(); err != nil {
+			t.Fatalf("unexpected base error: %v", err)
+		}
+		broken := base.With(godantic.WithDiscriminator("species", map[string]any{"fish": "not a struct"}))
+		if err := broken.Err(); err == nil || !strings.Contains(err.Error(), `discriminator variant "fish"`) {
+			t.Errorf("expected the clone to report the bad variant, got %v", err)
+		}
+		if err := base.Err(); err != nil {
+			t.Errorf("expected the base to stay valid, got %v", err)
+		}
+	})
+}
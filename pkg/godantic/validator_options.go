@@ -1,8 +1,16 @@
 package godantic
 
 import (
+	stderrors "errors"
 	"fmt"
+	"maps"
 	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/deepankarm/godantic/pkg/internal/errors"
+	"github.com/deepankarm/godantic/pkg/internal/reflectutil"
 )
 
 // ValidatorOption configures a Validator with additional capabilities
@@ -12,25 +20,119 @@ type ValidatorOption interface {
 
 // validatorConfig holds configuration for a Validator
 type validatorConfig struct {
-	discriminator *discriminatorConfig
+	discriminator          *discriminatorConfig
+	discriminatorOutputKey string                 // JSON key Marshal writes the discriminator under ("" = field name)
+	onError                func(ValidationErrors) // Called when validation produces errors
+	onSuccess              func()                 // Called when validation succeeds
+
+	strictSingleValue  bool          // Reject multiple values for scalar fields in multi-value maps
+	useNumber          bool          // Decode numbers in interface values as json.Number
+	lenientArrays      bool          // Decode a single value given for a slice field as one element
+	tagName            string        // Struct tag naming fields on the wire ("" = json)
+	absentOnlyDefaults bool          // Default only absent fields, not explicit zeros (set for parameter maps)
+	noDefaults         bool          // Decode without applying defaults (WithoutDefaults)
+	maxDepth           int           // Deepest nested struct walked (0 = no limit)
+	coerce             coerceOptions // String coercion settings for map/header validation
+}
+
+// apply replaces cfg with c, so Validator.With can pass its options on to
+// NewValidator
+func (c validatorConfig) apply(cfg *validatorConfig) {
+	*cfg = c
 }
 
 // discriminatorConfig holds configuration for discriminated union validation
 type discriminatorConfig struct {
 	field    string                  // The discriminator field name (e.g., "event", "type")
+	mu       sync.RWMutex            // Guards variants, which RegisterVariant extends
 	variants map[string]reflect.Type // Map of discriminator value -> concrete type
+	errs     []error                 // Variants left out because they are not structs
+}
+
+// err reports the unusable variants of cfg and field option errors in the
+// rest, with fields named by tag
+func (cfg *discriminatorConfig) err(tag string) error {
+	errs := slices.Clone(cfg.errs)
+	variants := cfg.variantTypes()
+	for _, key := range slices.Sorted(maps.Keys(variants)) {
+		errs = append(errs, cfg.variantErrors(key, variants[key], tag)...)
+	}
+	return stderrors.Join(errs...)
+}
+
+// variantErrors reports field option errors in the variant registered under
+// key and a Const on its discriminator field that differs from key
+func (cfg *discriminatorConfig) variantErrors(key string, variant reflect.Type, tag string) []error {
+	typ := reflectutil.UnwrapPointer(variant)
+	errs := scanner.collectOptionErrors(typ, tag, typ.Name()+".", map[reflect.Type]bool{})
+	if err := cfg.constMismatch(key, typ); err != nil {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// variantTypes returns a snapshot of the registered variants
+func (cfg *discriminatorConfig) variantTypes() map[string]reflect.Type {
+	cfg.mu.RLock()
+	defer cfg.mu.RUnlock()
+	return maps.Clone(cfg.variants)
+}
+
+// constMismatch reports a Const on the discriminator field of the variant
+// registered under key that is not key. Decoding picks the variant by key, so
+// such a variant would always fail its own Const check.
+func (cfg *discriminatorConfig) constMismatch(key string, typ reflect.Type) error {
+	owner, field, ok := discriminatorField(typ, cfg.field)
+	if !ok {
+		return nil
+	}
+	holder, ok := scanner.scanFieldOptionsFromType(owner)[field.Name]
+	if !ok {
+		return nil
+	}
+	value, ok := holder.constraints[ConstraintConst]
+	if !ok {
+		return nil
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.String && rv.String() == key {
+		return nil
+	}
+	return fmt.Errorf("discriminator variant %q: %s.%s has Const(%#v), which does not match its key", key, typ.Name(), field.Name, value)
+}
+
+// discriminatorField finds the field of typ decoded from the JSON member
+// name, looking into embedded structs, and returns it with the struct
+// declaring it
+func discriminatorField(typ reflect.Type, name string) (reflect.Type, reflect.StructField, bool) {
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		if field.Anonymous && reflectutil.UnwrapPointer(field.Type).Kind() == reflect.Struct {
+			if owner, found, ok := discriminatorField(reflectutil.UnwrapPointer(field.Type), name); ok {
+				return owner, found, true
+			}
+			continue
+		}
+		jsonName := reflectutil.JSONFieldName(field)
+		if jsonName == "" {
+			jsonName = field.Name
+		}
+		if field.IsExported() && strings.EqualFold(jsonName, name) {
+			return typ, field, true
+		}
+	}
+	return nil, reflect.StructField{}, false
 }
 
 // lookupConcreteType looks up the concrete type for a discriminator value
 func (cfg *discriminatorConfig) lookupConcreteType(discriminatorValue string) (reflect.Type, *ValidationError) {
+	cfg.mu.RLock()
+	defer cfg.mu.RUnlock()
 	if concreteType, ok := cfg.variants[discriminatorValue]; ok {
 		return concreteType, nil
 	}
-	validValues := make([]string, 0, len(cfg.variants))
-	for k := range cfg.variants {
-		validValues = append(validValues, k)
-	}
-	return nil, &ValidationError{Loc: []string{cfg.field}, Message: fmt.Sprintf("invalid discriminator value '%s', expected one of: %v", discriminatorValue, validValues), Type: ErrorTypeDiscriminatorInvalid}
+	validValues := slices.Collect(maps.Keys(cfg.variants))
+	return nil, &ValidationError{Loc: []string{cfg.field}, Message: errors.DiscriminatorInvalidMessage(cfg.field, discriminatorValue, validValues), Type: ErrorTypeDiscriminatorInvalid}
 }
 
 // WithDiscriminator configures a validator to handle discriminated unions (interfaces).
@@ -46,6 +148,12 @@ func (cfg *discriminatorConfig) lookupConcreteType(discriminatorValue string) (r
 //	        "bird": Bird{},
 //	    }),
 //	)
+//
+// Variants need no Field methods: a plain struct is valid whenever the JSON
+// decodes into it. Variants that are not structs or pointers to structs, such
+// as nil, cannot be reflected; they are left out and reported by Validator.Err.
+// Err also reports a variant whose discriminator field has a Const that differs
+// from its key, which would make every input for that key fail.
 func WithDiscriminator(field string, variants map[string]any) ValidatorOption {
 	return &discriminatorOption{
 		field:    field,
@@ -61,13 +169,20 @@ type discriminatorOption struct {
 func (d *discriminatorOption) apply(cfg *validatorConfig) {
 	// Convert variants map to map[string]reflect.Type
 	typeMap := make(map[string]reflect.Type, len(d.variants))
-	for key, val := range d.variants {
-		typeMap[key] = reflect.TypeOf(val)
+	var errs []error
+	for _, key := range slices.Sorted(maps.Keys(d.variants)) {
+		typ := reflect.TypeOf(d.variants[key])
+		if typ == nil || reflectutil.UnwrapPointer(typ).Kind() != reflect.Struct {
+			errs = append(errs, fmt.Errorf("discriminator variant %q: %v is not a struct", key, typ))
+			continue
+		}
+		typeMap[key] = typ
 	}
 
 	cfg.discriminator = &discriminatorConfig{
 		field:    d.field,
 		variants: typeMap,
+		errs:     errs,
 	}
 }
 
@@ -90,3 +205,238 @@ func WithDiscriminatorTyped[K ~string](field string, variants map[K]any) Validat
 	}
 	return WithDiscriminator(field, stringVariants)
 }
+
+// WithDiscriminatorOutputKey makes Marshal write the discriminator of a
+// WithDiscriminator union under key instead of the field's own JSON name, for
+// formats such as JSON-LD that expect "@type". Unmarshal still reads the
+// discriminator from the field's JSON name.
+//
+// Example:
+//
+//	validator := godantic.NewValidator[Node](
+//	    godantic.WithDiscriminator("type", map[string]any{"Person": Person{}}),
+//	    godantic.WithDiscriminatorOutputKey("@type"),
+//	)
+//	data, _ := validator.Marshal(&node) // {"@type":"Person",...}
+func WithDiscriminatorOutputKey(key string) ValidatorOption {
+	return discriminatorOutputKeyOption(key)
+}
+
+type discriminatorOutputKeyOption string
+
+func (o discriminatorOutputKeyOption) apply(cfg *validatorConfig) {
+	cfg.discriminatorOutputKey = string(o)
+}
+
+// WithOnError registers a callback invoked whenever Validate or Unmarshal
+// produces validation errors. The callback receives the same ValidationErrors
+// returned to the caller and runs synchronously before the call returns.
+// Marshal validates without calling it, so outgoing values don't count as
+// failures.
+//
+// Example (metrics):
+//
+//	validator := godantic.NewValidator[User](
+//	    godantic.WithOnError(func(errs godantic.ValidationErrors) {
+//	        for _, e := range errs {
+//	            failures.WithLabelValues(strings.Join(e.Loc, ".")).Inc()
+//	        }
+//	    }),
+//	)
+func WithOnError(fn func(ValidationErrors)) ValidatorOption {
+	return onErrorOption(fn)
+}
+
+type onErrorOption func(ValidationErrors)
+
+func (o onErrorOption) apply(cfg *validatorConfig) {
+	cfg.onError = o
+}
+
+// WithOnSuccess registers a callback invoked whenever Validate or Unmarshal
+// completes without validation errors. It runs synchronously before the call
+// returns. Like WithOnError, it is not called by Marshal.
+func WithOnSuccess(fn func()) ValidatorOption {
+	return onSuccessOption(fn)
+}
+
+type onSuccessOption func()
+
+func (o onSuccessOption) apply(cfg *validatorConfig) {
+	cfg.onSuccess = o
+}
+
+// WithStrictSingleValue makes ValidateFromMultiValueMap and ValidateFromHeaders
+// report an error when a scalar (non-slice) field receives more than one value,
+// instead of silently taking the first.
+func WithStrictSingleValue() ValidatorOption {
+	return strictSingleValueOption{}
+}
+
+type strictSingleValueOption struct{}
+
+func (strictSingleValueOption) apply(cfg *validatorConfig) {
+	cfg.strictSingleValue = true
+}
+
+// WithUseNumber makes Unmarshal decode JSON numbers held in interface values
+// (any, map[string]any, []any) as json.Number instead of float64. This keeps
+// large integer IDs exact and avoids 42 turning into 42.0 on re-encode.
+// Min, Max and MultipleOf on json.Number fields compare numerically.
+//
+// Example:
+//
+//	validator := godantic.NewValidator[Event](godantic.WithUseNumber())
+func WithUseNumber() ValidatorOption {
+	return useNumberOption{}
+}
+
+type useNumberOption struct{}
+
+func (useNumberOption) apply(cfg *validatorConfig) {
+	cfg.useNumber = true
+}
+
+// WithoutDefaults makes Unmarshal, UnmarshalWithReport and the map/header
+// validators leave fields with a Default as decoded, to see exactly what the
+// client sent (e.g. for a PATCH). Fields are still validated, and a missing
+// Required field is reported even when it has a Default. Marshal and
+// ApplyDefaults still apply defaults.
+//
+// Example:
+//
+//	raw := validator.With(godantic.WithoutDefaults())
+//	patch, errs := raw.Unmarshal(data)
+func WithoutDefaults() ValidatorOption {
+	return withoutDefaultsOption{}
+}
+
+type withoutDefaultsOption struct{}
+
+func (withoutDefaultsOption) apply(cfg *validatorConfig) {
+	cfg.noDefaults = true
+}
+
+// WithMaxDepth limits how deeply Validate, ValidateContext, Unmarshal and
+// Marshal walk into nested structs, slice elements and map values, for
+// recursive types fed untrusted, deeply nested input. depth counts the
+// segments of an error Loc: a depth of 2 walks A.B but stops at A.B.C with an
+// ErrorTypeMaxDepth error, after the errors found so far. Zero means no limit.
+//
+// Example:
+//
+//	validator := godantic.NewValidator[Comment](godantic.WithMaxDepth(32))
+func WithMaxDepth(depth int) ValidatorOption {
+	return maxDepthOption(depth)
+}
+
+type maxDepthOption int
+
+func (o maxDepthOption) apply(cfg *validatorConfig) {
+	cfg.maxDepth = max(int(o), 0)
+}
+
+// WithTagName makes the validator read field names from the given struct tag
+// instead of `json`, for types whose wire names differ from their JSON encoding.
+// Unmarshal, UnmarshalWithReport, UnmarshalPartial (and so StreamParser),
+// Marshal and the map/header validators use the tag's names, and
+// Report.DefaultedFields and error Locs list them; fields without the tag keep
+// their json name (or Go name). Keys are matched case-insensitively like
+// encoding/json.
+//
+// Example:
+//
+//	type User struct {
+//	    Name string `api:"user_name" json:"name"`
+//	}
+//
+//	validator := godantic.NewValidator[User](godantic.WithTagName("api"))
+//	user, errs := validator.Unmarshal([]byte(`{"user_name":"ada"}`))
+func WithTagName(name string) ValidatorOption {
+	return tagNameOption(name)
+}
+
+type tagNameOption string
+
+func (o tagNameOption) apply(cfg *validatorConfig) {
+	cfg.tagName = string(o)
+	if cfg.tagName == "json" {
+		cfg.tagName = ""
+	}
+}
+
+// WithBoolValues sets the spellings accepted for bool fields by
+// ValidateFromStringMap, ValidateFromMultiValueMap and ValidateFromHeaders
+// (path, query, header and cookie params), and by ValidateParamWith for a
+// single parameter. Matching is case-insensitive, and any
+// other value is reported as ErrorTypeCoercion. Without this option, bools
+// accept the strconv.ParseBool forms ("1", "t", "true", "0", "f", "false", ...).
+//
+// Example (HTML checkboxes and lenient clients):
+//
+//	validator := godantic.NewValidator[Filters](
+//	    godantic.WithBoolValues(
+//	        []string{"1", "true", "yes", "on"},
+//	        []string{"0", "false", "no", "off"},
+//	    ),
+//	)
+func WithBoolValues(truthy, falsy []string) ValidatorOption {
+	return boolValuesOption{truthy: truthy, falsy: falsy}
+}
+
+type boolValuesOption struct {
+	truthy, falsy []string
+}
+
+func (o boolValuesOption) apply(cfg *validatorConfig) {
+	cfg.coerce.truthy = lowercaseSet(o.truthy)
+	cfg.coerce.falsy = lowercaseSet(o.falsy)
+}
+
+// WithDurationSeconds makes time.Duration fields read a value without a unit
+// as seconds: "30" and 30 both mean 30s. Without it, duration strings must
+// carry a unit ("30s", "1h30m") and JSON numbers are nanoseconds, as with
+// encoding/json. It applies to Unmarshal, the map/header validators and
+// ValidateParamWith.
+//
+// Example:
+//
+//	validator := godantic.NewValidator[Config](godantic.WithDurationSeconds())
+func WithDurationSeconds() ValidatorOption {
+	return durationSecondsOption{}
+}
+
+type durationSecondsOption struct{}
+
+func (durationSecondsOption) apply(cfg *validatorConfig) {
+	cfg.coerce.durationSeconds = true
+}
+
+// WithLenientArrays makes Unmarshal accept a single value where a slice field
+// expects an array, decoding it as a one-element slice: {"tags": "go"} reads
+// as {"tags": ["go"]} and an object given for a []Struct field as a slice of
+// that one struct. The element is then validated, along with slice
+// constraints such as MinItems, as if the array had been sent. null still
+// leaves the field nil. Without it, a non-array value for a slice field is a
+// decode error.
+//
+// Example (clients that send one item unwrapped):
+//
+//	validator := godantic.NewValidator[Post](godantic.WithLenientArrays())
+func WithLenientArrays() ValidatorOption {
+	return lenientArraysOption{}
+}
+
+type lenientArraysOption struct{}
+
+func (lenientArraysOption) apply(cfg *validatorConfig) {
+	cfg.lenientArrays = true
+}
+
+func lowercaseSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[strings.ToLower(v)] = true
+	}
+	return set
+}
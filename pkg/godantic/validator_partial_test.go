@@ -1,6 +1,9 @@
 package godantic_test
 
 import (
+	"fmt"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/deepankarm/godantic/pkg/godantic"
@@ -558,6 +561,131 @@ func TestUnmarshalPartial_Union(t *testing.T) {
 	})
 }
 
+func TestUnmarshalPartial_UnionVariantFields(t *testing.T) {
+	validator := NewTAnimalValidator()
+
+	tests := []struct {
+		name        string
+		input       string
+		wantType    string
+		wantWaiting []string
+		wantPending []string
+		wantErrLocs []string
+	}{
+		{
+			name:        "truncated_variant_field",
+			input:       `{"species": "dog", "name": "Buddy", "breed": "Gol`,
+			wantType:    "*godantic_test.TDog",
+			wantWaiting: []string{"breed"},
+			wantPending: []string{"is_good"},
+		},
+		{
+			name:        "discriminator_mid_stream",
+			input:       `{"name": "Tweety", "species": "bird", "can_fly": tr`,
+			wantType:    "*godantic_test.TBird",
+			wantWaiting: []string{"can_fly"},
+			wantPending: []string{"wingspan"},
+			wantErrLocs: []string{"Wingspan"},
+		},
+		{
+			name:        "variant_fields_not_started",
+			input:       `{"species": "cat", `,
+			wantType:    "*godantic_test.TCat",
+			wantPending: []string{"name", "lives_left", "is_indoor"},
+			wantErrLocs: []string{"Name", "LivesLeft"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, state, errs := validator.UnmarshalPartial([]byte(tt.input))
+			if result == nil {
+				t.Fatalf("expected result, got errors: %v", errs)
+			}
+			if got := fmt.Sprintf("%T", *result); got != tt.wantType {
+				t.Errorf("variant = %s, want %s", got, tt.wantType)
+			}
+			if state.IsComplete {
+				t.Error("expected incomplete")
+			}
+			if !slices.Equal(state.WaitingFor(), tt.wantWaiting) {
+				t.Errorf("WaitingFor() = %v, want %v", state.WaitingFor(), tt.wantWaiting)
+			}
+			if !slices.Equal(state.PendingFields, tt.wantPending) {
+				t.Errorf("PendingFields = %v, want %v", state.PendingFields, tt.wantPending)
+			}
+			var locs []string
+			for _, err := range errs {
+				locs = append(locs, err.Loc[0])
+			}
+			if !slices.Equal(locs, tt.wantErrLocs) {
+				t.Errorf("errors at %v, want %v", locs, tt.wantErrLocs)
+			}
+		})
+	}
+
+	t.Run("partial_discriminator_listed_once", func(t *testing.T) {
+		_, state, _ := validator.UnmarshalPartial([]byte(`{"species": "ca`))
+		if got := state.WaitingFor(); !slices.Equal(got, []string{"species"}) {
+			t.Errorf("WaitingFor() = %v, want [species]", got)
+		}
+	})
+}
+
+// TStreamEvent is a union whose discriminator values share a prefix.
+type TStreamEvent interface{ isStreamEvent() }
+
+type TTextEvent struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+func (TTextEvent) isStreamEvent() {}
+
+type TTextDeltaEvent struct {
+	Type  string `json:"type"`
+	Delta string `json:"delta"`
+}
+
+func (TTextDeltaEvent) isStreamEvent() {}
+
+func TestUnmarshalPartial_UnionDiscriminatorPrefix(t *testing.T) {
+	validator := godantic.NewValidator[TStreamEvent](
+		godantic.WithDiscriminator("type", map[string]any{
+			"text":       TTextEvent{},
+			"text_delta": TTextDeltaEvent{},
+		}),
+	)
+
+	// "text" may still become "text_delta", so no variant is chosen yet
+	result, state, errs := validator.UnmarshalPartial([]byte(`{"type": "text`))
+	if result != nil {
+		t.Errorf("expected no variant yet, got %T", *result)
+	}
+	if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeDiscriminatorMissing {
+		t.Errorf("expected discriminator_missing, got %v", errs)
+	}
+	if got := state.WaitingFor(); !slices.Equal(got, []string{"type"}) {
+		t.Errorf("WaitingFor() = %v, want [type]", got)
+	}
+
+	result, _, _ = validator.UnmarshalPartial([]byte(`{"type": "text_delta", "delta": "Hel`))
+	if result == nil {
+		t.Fatal("expected result")
+	}
+	if delta, ok := (*result).(TTextDeltaEvent); !ok || delta.Delta != "Hel" {
+		t.Errorf("got %#v, want TTextDeltaEvent{Delta: Hel}", *result)
+	}
+
+	result, _, _ = validator.UnmarshalPartial([]byte(`{"type": "text", "text": "Hel`))
+	if result == nil {
+		t.Fatal("expected result")
+	}
+	if _, ok := (*result).(TTextEvent); !ok {
+		t.Errorf("got %T, want TTextEvent", *result)
+	}
+}
+
 func TestUnmarshalPartial_UnionArray(t *testing.T) {
 	validator := godantic.NewValidator[TAnimalList]()
 
@@ -705,3 +833,213 @@ func TestPartialState_IsFieldComplete(t *testing.T) {
 		t.Error("expected 'age' to be incomplete")
 	}
 }
+
+func TestPartialState_TruncationReason(t *testing.T) {
+	validator := godantic.NewValidator[TUserWithSlice]()
+
+	tests := []struct {
+		name   string
+		input  string
+		reason string
+		paths  [][]string
+	}{
+		{"mid-string", `{"name": "Jo`, "string", [][]string{{"name"}}},
+		{"after colon", `{"name": "John", "tags":`, "value", [][]string{{"tags"}}},
+		{"mid-array", `{"name": "John", "tags": ["a",`, "array", nil},
+		{"mid-string in array", `{"name": "John", "tags": ["a", "b`, "string", [][]string{{"tags", "[1]"}}},
+		{"complete", `{"name": "John"}`, "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, state, _ := validator.UnmarshalPartial([]byte(tt.input))
+			if state.TruncationReason != tt.reason {
+				t.Errorf("expected reason %q, got %q", tt.reason, state.TruncationReason)
+			}
+			if len(state.IncompletePaths) != len(tt.paths) {
+				t.Fatalf("expected paths %v, got %v", tt.paths, state.IncompletePaths)
+			}
+			for i, path := range tt.paths {
+				if strings.Join(state.IncompletePaths[i], ".") != strings.Join(path, ".") {
+					t.Errorf("expected path %v, got %v", path, state.IncompletePaths[i])
+				}
+			}
+		})
+	}
+}
+
+type TStreamAddress struct {
+	City string `json:"city"`
+	Zip  string `json:"zip"`
+}
+
+type TStreamProfile struct {
+	Name     string          `json:"name"`
+	Nickname *string         `json:"nickname"`
+	Address  *TStreamAddress `json:"address"`
+}
+
+func TestPartialState_PendingFields(t *testing.T) {
+	parser := godantic.NewStreamParser[TStreamProfile]()
+
+	result, state, _ := parser.Feed([]byte(`{"name": "Jo`))
+	if result.Nickname != nil || result.Address != nil {
+		t.Errorf("expected fields that haven't arrived to stay nil, got %+v", result)
+	}
+	if want := []string{"nickname", "address"}; !slices.Equal(state.PendingFields, want) {
+		t.Errorf("PendingFields = %v, want %v", state.PendingFields, want)
+	}
+	if state.IsFieldPending("name") || !state.IsFieldPending("nickname") {
+		t.Errorf("expected only name to have started, got %v", state.PendingFields)
+	}
+
+	// The nickname never arrives, the address is in progress
+	result, state, _ = parser.Feed([]byte(`hn", "address": {"city": "Ber`))
+	if result.Nickname != nil {
+		t.Errorf("expected Nickname to stay nil, got %q", *result.Nickname)
+	}
+	if want := []string{"nickname", "address.zip"}; !slices.Equal(state.PendingFields, want) {
+		t.Errorf("PendingFields = %v, want %v", state.PendingFields, want)
+	}
+	if state.IsFieldComplete("address", "city") {
+		t.Error("expected address.city to be in progress")
+	}
+
+	_, state, _ = parser.Feed([]byte(`lin", "zip": null}}`))
+	if !state.IsComplete || len(state.PendingFields) != 0 {
+		t.Errorf("expected no pending fields once complete, got %+v", state)
+	}
+
+	t.Run("array elements", func(t *testing.T) {
+		validator := godantic.NewValidator[TUserWithSlice]()
+		_, state, _ := validator.UnmarshalPartial([]byte(`{"tags": ["a",`))
+		if !slices.Contains(state.PendingFields, "name") {
+			t.Errorf("expected name to be pending, got %v", state.PendingFields)
+		}
+
+		parser := godantic.NewArrayStreamParser[TStreamAddress](nil)
+		_, state, _ = parser.Feed([]byte(`[{"city": "Berlin", "zip": "10115"}, {"city": "Pa`))
+		if want := []string{"[1].zip"}; !slices.Equal(state.PendingFields, want) {
+			t.Errorf("PendingFields = %v, want %v", state.PendingFields, want)
+		}
+	})
+}
+
+// ═══════════════════════════════════════════════════════════════════════════
+// UnmarshalPartialInto - Reusing caller-provided structs
+// ═══════════════════════════════════════════════════════════════════════════
+
+func TestUnmarshalPartialInto_Streaming(t *testing.T) {
+	validator := godantic.NewValidator[TUserWithSlice]()
+	var dst TUserWithSlice
+
+	state, _ := validator.UnmarshalPartialInto([]byte(`{"name": "Al`), &dst)
+	if state.IsComplete {
+		t.Error("expected incomplete state")
+	}
+	if dst.Name != "Al" {
+		t.Errorf("expected name 'Al', got %q", dst.Name)
+	}
+
+	state, errs := validator.UnmarshalPartialInto([]byte(`{"name": "Alice", "tags": ["a", "b"]}`), &dst)
+	if !state.IsComplete {
+		t.Errorf("expected complete state, waiting for %v", state.WaitingFor())
+	}
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if dst.Name != "Alice" || len(dst.Tags) != 2 {
+		t.Errorf("unexpected result: %+v", dst)
+	}
+}
+
+func TestUnmarshalPartialInto_ReusesSliceCapacity(t *testing.T) {
+	validator := godantic.NewValidator[TUserWithSlice]()
+	dst := TUserWithSlice{Tags: make([]string, 0, 8)}
+	backing := &dst.Tags[:1][0]
+
+	validator.UnmarshalPartialInto([]byte(`{"name": "x", "tags": ["a", "b", "c"]}`), &dst)
+	if len(dst.Tags) != 3 {
+		t.Fatalf("expected 3 tags, got %v", dst.Tags)
+	}
+	if &dst.Tags[0] != backing {
+		t.Error("expected slice backing array to be reused")
+	}
+}
+
+func TestUnmarshalPartialInto_ResetsStaleFields(t *testing.T) {
+	validator := godantic.NewValidator[TUserWithSlice]()
+	var dst TUserWithSlice
+
+	validator.UnmarshalPartialInto([]byte(`{"name": "Alice", "tags": ["a"], "items": [{"id": 1, "name": "first"}, {"id": 2}]}`), &dst)
+	if len(dst.Items) != 2 || dst.Items[0].Name != "first" {
+		t.Fatalf("unexpected first decode: %+v", dst)
+	}
+
+	validator.UnmarshalPartialInto([]byte(`{"name": "Bob", "items": [{"id": 3}]}`), &dst)
+	if dst.Name != "Bob" {
+		t.Errorf("expected name 'Bob', got %q", dst.Name)
+	}
+	if dst.Tags != nil {
+		t.Errorf("expected absent tags to be reset, got %v", dst.Tags)
+	}
+	if len(dst.Items) != 1 || dst.Items[0].ID != 3 || dst.Items[0].Name != "" {
+		t.Errorf("expected stale item fields to be reset, got %+v", dst.Items)
+	}
+
+	// Growing back into the old capacity must not resurrect stale elements
+	validator.UnmarshalPartialInto([]byte(`{"name": "Bob", "items": [{"id": 3}, {"id": 4}]}`), &dst)
+	if len(dst.Items) != 2 || dst.Items[1].Name != "" {
+		t.Errorf("expected fresh second item, got %+v", dst.Items)
+	}
+}
+
+func TestUnmarshalPartialInto_Maps(t *testing.T) {
+	validator := godantic.NewValidator[TUserWithMap]()
+	var dst TUserWithMap
+
+	validator.UnmarshalPartialInto([]byte(`{"name": "x", "metadata": {"a": "1", "b": "2"}}`), &dst)
+	validator.UnmarshalPartialInto([]byte(`{"name": "x", "metadata": {"b": "3"}}`), &dst)
+
+	if len(dst.Metadata) != 1 || dst.Metadata["b"] != "3" {
+		t.Errorf("expected only key 'b', got %v", dst.Metadata)
+	}
+}
+
+func TestUnmarshalPartialInto_Defaults(t *testing.T) {
+	validator := godantic.NewValidator[TQueryParams]()
+	dst := TQueryParams{Page: 5, Limit: 50}
+
+	state, errs := validator.UnmarshalPartialInto([]byte(`{"page": 2}`), &dst)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if !state.IsComplete {
+		t.Error("expected complete state")
+	}
+	if dst.Page != 2 || dst.Limit != 10 {
+		t.Errorf("expected page=2 limit=10 (default), got page=%d limit=%d", dst.Page, dst.Limit)
+	}
+}
+
+func TestUnmarshalPartialInto_DiscriminatedUnion(t *testing.T) {
+	validator := NewTAnimalValidator()
+	var dst TAnimal
+
+	validator.UnmarshalPartialInto([]byte(`{"species": "cat", "name": "Whiskers"`), &dst)
+	if _, ok := dst.(*TCat); !ok {
+		t.Fatalf("expected *TCat, got %T", dst)
+	}
+
+	validator.UnmarshalPartialInto([]byte(`{"species": "dog", "name": "Rex"`), &dst)
+	if dog, ok := dst.(*TDog); !ok || dog.Name != "Rex" {
+		t.Errorf("expected *TDog named Rex, got %#v", dst)
+	}
+}
+
+func TestUnmarshalPartialInto_NilDst(t *testing.T) {
+	validator := godantic.NewValidator[TUser]()
+	_, errs := validator.UnmarshalPartialInto([]byte(`{}`), nil)
+	if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeInternal {
+		t.Errorf("expected internal error, got %v", errs)
+	}
+}
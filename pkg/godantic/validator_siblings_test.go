@@ -0,0 +1,129 @@
+package godantic_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/godantic"
+)
+
+// ═══════════════════════════════════════════════════════════════════════════
+// ValidateWithContext Tests
+// ═══════════════════════════════════════════════════════════════════════════
+
+type TParcelAddress struct {
+	Country    string `json:"country"`
+	PostalCode string `json:"postal_code"`
+}
+
+func (a *TParcelAddress) FieldPostalCode() godantic.FieldOptions[string] {
+	return godantic.Field(
+		godantic.Required[string](),
+		godantic.ValidateWithContext(func(code string, siblings map[string]any) error {
+			if siblings["country"] == "US" && len(code) != 5 {
+				return fmt.Errorf("US postal codes have 5 digits")
+			}
+			return nil
+		}),
+	)
+}
+
+// TParcel has its own country, so the address rule only passes if it sees
+// the address's fields rather than the root's
+type TParcel struct {
+	Country     string           `json:"country"`
+	Destination TParcelAddress   `json:"destination"`
+	Stops       []TParcelAddress `json:"stops"`
+}
+
+func TestValidateWithContext(t *testing.T) {
+	validator := godantic.NewValidator[TParcel]()
+	compiled := validator.Compile()
+
+	tests := []struct {
+		name     string
+		json     string
+		shipment TParcel
+		wantLoc  []string // nil means valid
+	}{
+		{
+			name:     "rule depends on sibling",
+			json:     `{"country": "CA", "destination": {"country": "US", "postal_code": "123"}}`,
+			shipment: TParcel{Country: "CA", Destination: TParcelAddress{Country: "US", PostalCode: "123"}},
+			wantLoc:  []string{"Destination", "PostalCode"},
+		},
+		{
+			name:     "siblings are the nested object, not the root",
+			json:     `{"country": "US", "destination": {"country": "CA", "postal_code": "K1A 0B1"}}`,
+			shipment: TParcel{Country: "US", Destination: TParcelAddress{Country: "CA", PostalCode: "K1A 0B1"}},
+		},
+		{
+			name: "slice elements see their own object",
+			json: `{"destination": {"country": "US", "postal_code": "94103"},
+				"stops": [{"country": "CA", "postal_code": "V5K"}, {"country": "US", "postal_code": "V5K"}]}`,
+			shipment: TParcel{
+				Destination: TParcelAddress{Country: "US", PostalCode: "94103"},
+				Stops:       []TParcelAddress{{Country: "CA", PostalCode: "V5K"}, {Country: "US", PostalCode: "V5K"}},
+			},
+			wantLoc: []string{"Stops", "[1]", "PostalCode"},
+		},
+	}
+
+	check := func(t *testing.T, errs godantic.ValidationErrors, wantLoc []string) {
+		t.Helper()
+		if wantLoc == nil {
+			if len(errs) != 0 {
+				t.Errorf("expected no errors, got %v", errs)
+			}
+			return
+		}
+		if len(errs) != 1 || fmt.Sprint(errs[0].Loc) != fmt.Sprint(wantLoc) || errs[0].Type != godantic.ErrorTypeConstraint {
+			t.Fatalf("expected one constraint error at %v, got %v", wantLoc, errs)
+		}
+		if errs[0].Message != "US postal codes have 5 digits" {
+			t.Errorf("unexpected message %q", errs[0].Message)
+		}
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errs := validator.Unmarshal([]byte(tt.json))
+			check(t, errs, tt.wantLoc)
+			_, errs = compiled.Unmarshal([]byte(tt.json))
+			check(t, errs, tt.wantLoc)
+			shipment := tt.shipment
+			check(t, validator.Validate(&shipment), tt.wantLoc)
+		})
+	}
+
+	t.Run("unmarshal passes the object as sent", func(t *testing.T) {
+		probe := godantic.NewValidator[TSiblingProbe]()
+		if _, errs := probe.Unmarshal([]byte(`{"name": "a", "count": 2}`)); len(errs) != 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if len(seenSiblings) != 2 || seenSiblings["count"] != float64(2) {
+			t.Errorf("expected the decoded JSON object, got %v", seenSiblings)
+		}
+		if _, errs := probe.Unmarshal([]byte(`{"name": "a"}`)); len(errs) != 0 {
+			t.Fatalf("unexpected errors: %v", errs)
+		}
+		if _, ok := seenSiblings["count"]; ok {
+			t.Errorf("expected absent keys to be missing, got %v", seenSiblings)
+		}
+	})
+}
+
+// seenSiblings records what TSiblingProbe's validator received
+var seenSiblings map[string]any
+
+type TSiblingProbe struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+func (p *TSiblingProbe) FieldName() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.ValidateWithContext(func(name string, siblings map[string]any) error {
+		seenSiblings = siblings
+		return nil
+	}))
+}
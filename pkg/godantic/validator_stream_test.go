@@ -1,7 +1,12 @@
 package godantic_test
 
 import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/deepankarm/godantic/pkg/godantic"
 )
@@ -82,6 +87,135 @@ func TestStreamParser_Reset(t *testing.T) {
 	}
 }
 
+func TestStreamParser_Events(t *testing.T) {
+	parser := godantic.NewStreamParser[TUser]()
+	events := parser.Events()
+
+	for _, chunk := range []string{`{"name": "Jo`, `hn", "email": "john@example.com", "a`, `ge": 200}`} {
+		parser.Feed([]byte(chunk))
+	}
+	parser.Close()
+
+	var got []string
+	for ev := range events {
+		switch ev.Type {
+		case godantic.StreamFieldCompleted:
+			got = append(got, fmt.Sprintf("%s %s=%v", ev.Type, ev.Path, ev.Value))
+		case godantic.StreamValidationError:
+			got = append(got, fmt.Sprintf("%s %s: %s", ev.Type, ev.Path, ev.Error.Message))
+		case godantic.StreamObjectCompleted:
+			got = append(got, fmt.Sprintf("%s %+v", ev.Type, ev.Value))
+		default:
+			got = append(got, fmt.Sprintf("%s %s", ev.Type, ev.Path))
+		}
+	}
+
+	want := []string{
+		"field_started name",
+		"field_completed name=John",
+		"field_started email",
+		"field_completed email=john@example.com",
+		"field_started age",
+		"field_completed age=200",
+		"validation_error age: age must be between 0 and 150",
+		"object_completed &{Name:John Email:john@example.com Age:200}",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("events =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestStreamParser_EventsNested(t *testing.T) {
+	parser := godantic.NewStreamParser[TOrganization]()
+	events := parser.Events()
+
+	for _, chunk := range []string{
+		`{"name": "Ac`,
+		`me", "employees": [{"name": "Ann", "email": "a`,
+		`nn@example.com"}, {"name": "Bo`,
+		`b", "email": "bob@example.com"}]}`,
+	} {
+		parser.Feed([]byte(chunk))
+	}
+	parser.Close()
+
+	var got []string
+	for ev := range events {
+		got = append(got, fmt.Sprintf("%s %s", ev.Type, ev.Path))
+	}
+	want := []string{
+		"field_started name",
+		"field_completed name",
+		"field_started employees",
+		"field_started employees[0].name",
+		"field_completed employees[0].name",
+		"field_started employees[0].email",
+		"field_completed employees[0].email",
+		"field_started employees[1].name",
+		"field_completed employees[1].name",
+		"field_started employees[1].email",
+		"field_completed employees[1].email",
+		"field_completed employees",
+		"object_completed ",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("events =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestStreamParser_EventsWithoutReader(t *testing.T) {
+	parser := godantic.NewStreamParser[TOrganization]()
+	events := parser.Events()
+
+	// A single chunk with more events than the channel holds
+	var doc strings.Builder
+	doc.WriteString(`{"name": "Acme", "employees": [`)
+	for i := range 50 {
+		if i > 0 {
+			doc.WriteString(",")
+		}
+		fmt.Fprintf(&doc, `{"name": "e%d", "email": "e%d@example.com"}`, i, i)
+	}
+	doc.WriteString(`]}`)
+
+	fed := make(chan struct{})
+	go func() {
+		parser.Feed([]byte(doc.String()))
+		close(fed)
+	}()
+	for deadline := time.Now().Add(2 * time.Second); len(events) < cap(events); time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("expected Feed to fill the channel")
+		}
+	}
+
+	unblocked := make(chan struct{})
+	go func() {
+		parser.Buffer()
+		parser.Reset()
+		parser.Close()
+		close(unblocked)
+	}()
+	select {
+	case <-unblocked:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Buffer, Reset and Close must not wait for a Feed blocked on the channel")
+	}
+	select {
+	case <-fed:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Close must unblock the Feed")
+	}
+
+	n := 0
+	for range events {
+		n++
+	}
+	if n != cap(events) {
+		t.Errorf("expected the %d buffered events before the channel closed, got %d", cap(events), n)
+	}
+}
+
 // ═══════════════════════════════════════════════════════════════════════════
 // StreamParser - Collections
 // ═══════════════════════════════════════════════════════════════════════════
@@ -421,3 +555,190 @@ func TestStreamParser_MapWithNestedStruct(t *testing.T) {
 		t.Errorf("unexpected errors: %v", errs)
 	}
 }
+
+// ═══════════════════════════════════════════════════════════════════════════
+// StreamParser - Root-Level Arrays
+// ═══════════════════════════════════════════════════════════════════════════
+
+type TStreamTask struct {
+	Title string `json:"title"`
+	Done  bool   `json:"done"`
+}
+
+func (t *TStreamTask) FieldTitle() godantic.FieldOptions[string] {
+	return godantic.Field(godantic.Required[string](), godantic.MinLen(3))
+}
+
+func TestStreamParser_RootArray(t *testing.T) {
+	parser := godantic.NewStreamParser[[]TStreamTask]()
+
+	result, state, errs := parser.Feed([]byte(`[{"title": "Write tests", "done": true}, {"title": "Sh`))
+	if result == nil || len(*result) != 2 {
+		t.Fatalf("expected 2 tasks, got %v", result)
+	}
+	if (*result)[1].Title != "Sh" {
+		t.Errorf("Title = %q, want 'Sh'", (*result)[1].Title)
+	}
+	if state.IsComplete || state.IsFieldComplete("[1]", "title") {
+		t.Errorf("expected [1].title incomplete, got %v", state.WaitingFor())
+	}
+	if len(errs) != 0 {
+		t.Errorf("errors for incomplete fields should be suppressed, got %v", errs)
+	}
+
+	// Cut off between elements: no field is truncated, but the array is still open
+	parser.Reset()
+	_, state, _ = parser.Feed([]byte(`[{"title": "Write tests"},`))
+	if state.IsComplete {
+		t.Error("expected incomplete while the array is unclosed")
+	}
+}
+
+func TestArrayStreamParser(t *testing.T) {
+	var ready []string
+	parser := godantic.NewArrayStreamParser(func(i int, task TStreamTask) {
+		ready = append(ready, task.Title)
+	})
+
+	chunks := []struct {
+		chunk     string
+		items     int
+		completed int
+	}{
+		{`[{"title": "Wri`, 1, 0},
+		{`te tests", "done": true}`, 1, 1},
+		{`, {"title": "Ship it"`, 2, 1},
+		{`, "done": false},`, 2, 2},
+		{` {"title": "Re`, 3, 2},
+		{`lease"}]`, 3, 3},
+	}
+	for _, c := range chunks {
+		items, _, _ := parser.Feed([]byte(c.chunk))
+		if len(items) != c.items {
+			t.Errorf("after %q: got %d items, want %d", c.chunk, len(items), c.items)
+		}
+		if parser.Completed() != c.completed {
+			t.Errorf("after %q: Completed() = %d, want %d", c.chunk, parser.Completed(), c.completed)
+		}
+	}
+
+	want := []string{"Write tests", "Ship it", "Release"}
+	if len(ready) != len(want) {
+		t.Fatalf("callbacks = %v, want %v", ready, want)
+	}
+	for i := range want {
+		if ready[i] != want[i] {
+			t.Errorf("callback %d = %q, want %q", i, ready[i], want[i])
+		}
+	}
+
+	t.Run("reports errors for completed elements", func(t *testing.T) {
+		parser := godantic.NewArrayStreamParser[TStreamTask](nil)
+		_, _, errs := parser.Feed([]byte(`[{"title": "ab"}, {"title": "x`))
+		if len(errs) != 1 || errs[0].Loc[0] != "[0]" {
+			t.Errorf("expected a single error for [0], got %v", errs)
+		}
+	})
+
+	t.Run("trailing numbers wait for a delimiter", func(t *testing.T) {
+		var got []int
+		parser := godantic.NewArrayStreamParser(func(_ int, n int) { got = append(got, n) })
+		parser.Feed([]byte(`[1, 2`))
+		if parser.Completed() != 1 {
+			t.Errorf("Completed() = %d, want 1", parser.Completed())
+		}
+		parser.Feed([]byte(`3]`))
+		if len(got) != 2 || got[1] != 23 {
+			t.Errorf("callbacks = %v, want [1 23]", got)
+		}
+	})
+
+	t.Run("reset restarts callbacks", func(t *testing.T) {
+		count := 0
+		parser := godantic.NewArrayStreamParser(func(int, TStreamTask) { count++ })
+		parser.Feed([]byte(`[{"title": "one"}]`))
+		parser.Reset()
+		parser.Feed([]byte(`[{"title": "two"}]`))
+		if count != 2 {
+			t.Errorf("expected 2 callbacks, got %d", count)
+		}
+	})
+}
+
+// ═══════════════════════════════════════════════════════════════════════════
+// Concurrency (run with -race)
+// ═══════════════════════════════════════════════════════════════════════════
+
+func TestStreamParser_ConcurrentFeed(t *testing.T) {
+	parser := godantic.NewStreamParser[TUser]()
+	parser.Feed([]byte(`{"name":`))
+
+	// Whitespace chunks are valid in any interleaving
+	const perGoroutine = 50
+	var wg sync.WaitGroup
+	for range 2 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range perGoroutine {
+				parser.Feed([]byte(" "))
+				_ = parser.Buffer()
+			}
+		}()
+	}
+	wg.Wait()
+
+	result, state, errs := parser.Feed([]byte(`"John","email":"john@example.com","age":30}`))
+	if result == nil || result.Name != "John" || !state.IsComplete || len(errs) != 0 {
+		t.Fatalf("got %+v, %+v, %v", result, state, errs)
+	}
+	if got := strings.Count(string(parser.Buffer()), " "); got != 2*perGoroutine {
+		t.Errorf("buffer has %d spaces, want %d", got, 2*perGoroutine)
+	}
+}
+
+func TestArrayStreamParser_ConcurrentFeed(t *testing.T) {
+	var indices []int
+	parser := godantic.NewArrayStreamParser(func(i int, _ TStreamTask) {
+		indices = append(indices, i)
+	})
+	parser.Feed([]byte(`[`))
+
+	// Each chunk is a whole element, so any interleaving is a valid array
+	const perGoroutine = 25
+	var wg sync.WaitGroup
+	for range 2 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range perGoroutine {
+				parser.Feed([]byte(`{"title": "task"},`))
+				_ = parser.Completed()
+			}
+		}()
+	}
+	wg.Wait()
+
+	items, state, errs := parser.Feed([]byte(`{"title": "last"}]`))
+	if len(items) != 2*perGoroutine+1 || !state.IsComplete || len(errs) != 0 {
+		t.Fatalf("got %d items, %+v, %v", len(items), state, errs)
+	}
+	if len(indices) != len(items) {
+		t.Fatalf("got %d callbacks, want %d", len(indices), len(items))
+	}
+	for i, idx := range indices {
+		if idx != i {
+			t.Fatalf("callback %d reported index %d", i, idx)
+		}
+	}
+}
+
+func TestStreamParser_BufferIsCopy(t *testing.T) {
+	parser := godantic.NewStreamParser[TUser]()
+	parser.Feed([]byte(`{"name": "Jo`))
+	buf := parser.Buffer()
+	buf[0] = 'X'
+	if result, _, _ := parser.Feed([]byte(`hn"}`)); result == nil || result.Name != "John" {
+		t.Errorf("modifying Buffer() changed the parser's state, got %+v", result)
+	}
+}
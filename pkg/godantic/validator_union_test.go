@@ -2,6 +2,9 @@ package godantic_test
 
 import (
 	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/deepankarm/godantic/pkg/godantic"
@@ -23,6 +26,7 @@ func TestUnion_Unmarshal(t *testing.T) {
 		wantType    string // "cat", "dog", "bird"
 		wantErr     bool
 		errType     string
+		errMsg      string
 	}{
 		{
 			name:        "cat",
@@ -50,6 +54,7 @@ func TestUnion_Unmarshal(t *testing.T) {
 			json:    `{"species": "fish", "name": "Nemo"}`,
 			wantErr: true,
 			errType: "discriminator_invalid",
+			errMsg:  `"species" must be one of [bird cat dog], got "fish"`,
 		},
 		{
 			name:    "missing_discriminator",
@@ -70,6 +75,9 @@ func TestUnion_Unmarshal(t *testing.T) {
 				if errs[0].Type != godantic.ErrorType(tt.errType) {
 					t.Errorf("error type = %s, want %s", errs[0].Type, tt.errType)
 				}
+				if tt.errMsg != "" && errs[0].Message != tt.errMsg {
+					t.Errorf("error message = %q, want %q", errs[0].Message, tt.errMsg)
+				}
 				return
 			}
 
@@ -282,6 +290,36 @@ func TestUnion_Marshal_Validation(t *testing.T) {
 	}
 }
 
+func TestUnion_Marshal_OutputKey(t *testing.T) {
+	validator := godantic.NewValidator[TAnimal](
+		godantic.WithDiscriminatorOutputKey("@type"),
+		godantic.WithDiscriminator("species", map[string]any{
+			"cat": &TCat{},
+			"dog": &TDog{},
+		}),
+	)
+
+	var animal TAnimal = &TCat{Species: TSpeciesCat, Name: "Whiskers", LivesLeft: 7}
+	jsonData, errs := validator.Marshal(&animal)
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if !strings.HasPrefix(string(jsonData), `{"@type":"cat",`) {
+		t.Errorf("expected the discriminator first under @type, got %s", jsonData)
+	}
+
+	var result map[string]any
+	if err := json.Unmarshal(jsonData, &result); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if _, ok := result["species"]; ok {
+		t.Errorf("expected species to be renamed, got %s", jsonData)
+	}
+	if result["name"] != "Whiskers" {
+		t.Errorf("name = %v, want Whiskers", result["name"])
+	}
+}
+
 // ═══════════════════════════════════════════════════════════════════════════
 // Discriminated Union - String Keys (non-typed variant)
 // ═══════════════════════════════════════════════════════════════════════════
@@ -377,6 +415,232 @@ func TestUnion_Unmarshal_TypedNilErrorBehavior(t *testing.T) {
 	}
 }
 
+// ═══════════════════════════════════════════════════════════════════════════
+// Discriminated Union - Variants Without Field Methods
+// ═══════════════════════════════════════════════════════════════════════════
+
+// TFish has no Field methods, so any JSON that decodes into it is valid
+type TFish struct {
+	Species TAnimalSpecies `json:"species"`
+	Name    string         `json:"name"`
+	Fins    int            `json:"fins"`
+}
+
+func (f TFish) GetSpecies() TAnimalSpecies { return f.Species }
+func (f TFish) isAnimal()                  {}
+
+func TestUnion_PlainVariant(t *testing.T) {
+	validator := godantic.NewValidator[TAnimal](
+		godantic.WithDiscriminator("species", map[string]any{
+			"cat":  &TCat{},
+			"fish": TFish{},
+		}),
+	)
+	if err := validator.Err(); err != nil {
+		t.Fatalf("unexpected definition error: %v", err)
+	}
+
+	animal, errs := validator.Unmarshal([]byte(`{"species": "fish", "name": "Nemo"}`))
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	fish, ok := (*animal).(TFish)
+	if !ok || fish.Name != "Nemo" || fish.Fins != 0 {
+		t.Fatalf("expected TFish{Name: Nemo}, got %#v", *animal)
+	}
+
+	data, errs := validator.Marshal(animal)
+	if len(errs) != 0 || string(data) != `{"species":"fish","name":"Nemo","fins":0}` {
+		t.Errorf("unexpected round trip: %s, %v", data, errs)
+	}
+
+	// Decoding still has to succeed
+	_, errs = validator.Unmarshal([]byte(`{"species": "fish", "fins": "many"}`))
+	if len(errs) != 1 || errs[0].Type != godantic.ErrorTypeJSONDecode {
+		t.Errorf("expected a decode error, got %v", errs)
+	}
+}
+
+// TAxolotl is registered at runtime, as a plugin would
+type TAxolotl struct {
+	Species TAnimalSpecies `json:"species"`
+	Name    string         `json:"name"`
+	Gills   int            `json:"gills"`
+}
+
+func (a TAxolotl) GetSpecies() TAnimalSpecies { return a.Species }
+func (a TAxolotl) isAnimal()                  {}
+
+func (a *TAxolotl) FieldGills() godantic.FieldOptions[int] {
+	return godantic.Field(godantic.Required[int](), godantic.Min(1))
+}
+
+func TestUnion_RegisterVariant(t *testing.T) {
+	validator := NewTAnimalValidator()
+	compiled := validator.Compile()
+	input := []byte(`{"species": "axolotl", "name": "Wooper", "gills": 6}`)
+
+	if _, errs := validator.Unmarshal(input); len(errs) != 1 || errs[0].Type != godantic.ErrorTypeDiscriminatorInvalid {
+		t.Fatalf("expected an unknown variant before registering, got %v", errs)
+	}
+
+	if err := validator.RegisterVariant("axolotl", TAxolotl{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for name, unmarshal := range map[string]func([]byte) (*TAnimal, godantic.ValidationErrors){
+		"validator": validator.Unmarshal,
+		"compiled":  compiled.Unmarshal,
+	} {
+		animal, errs := unmarshal(input)
+		if len(errs) != 0 {
+			t.Fatalf("%s: unexpected errors: %v", name, errs)
+		}
+		if axolotl, ok := (*animal).(TAxolotl); !ok || axolotl.Name != "Wooper" || axolotl.Gills != 6 {
+			t.Errorf("%s: expected TAxolotl{Name: Wooper}, got %#v", name, *animal)
+		}
+
+		// The variant's own field options apply
+		_, errs = unmarshal([]byte(`{"species": "axolotl", "name": "Wooper", "gills": 0}`))
+		if len(errs) != 1 || errs[0].Loc[0] != "Gills" {
+			t.Errorf("%s: expected a gills error, got %v", name, errs)
+		}
+	}
+
+	var animal TAnimal = TAxolotl{Species: "axolotl", Name: "Wooper", Gills: 6}
+	if data, errs := validator.Marshal(&animal); len(errs) != 0 || !strings.Contains(string(data), `"gills":6`) {
+		t.Errorf("unexpected marshal result: %s, %v", data, errs)
+	}
+
+	t.Run("rejected registrations", func(t *testing.T) {
+		if err := validator.RegisterVariant("cat", TAxolotl{}); err == nil {
+			t.Error("expected an error for an existing value")
+		}
+		if err := validator.RegisterVariant("rock", "granite"); err == nil {
+			t.Error("expected an error for a non-struct variant")
+		}
+		if err := godantic.NewValidator[TCat]().RegisterVariant("axolotl", TAxolotl{}); err == nil {
+			t.Error("expected an error without a discriminator")
+		}
+	})
+}
+
+func TestUnion_RegisterVariantConcurrently(t *testing.T) {
+	validator := NewTAnimalValidator()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := range 50 {
+			_ = validator.RegisterVariant(fmt.Sprintf("plugin%d", i), TFish{})
+		}
+	}()
+	for range 50 {
+		validator.Unmarshal([]byte(`{"species": "plugin1", "name": "Nemo"}`))
+	}
+	<-done
+	if _, errs := validator.Unmarshal([]byte(`{"species": "plugin49", "name": "Nemo"}`)); len(errs) != 0 {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+}
+
+func TestUnion_UnmarshalUnionVariant(t *testing.T) {
+	validator := NewTAnimalValidator()
+
+	tests := []struct {
+		input       string
+		wantVariant string
+		wantType    string
+		wantErrs    bool
+	}{
+		{`{"species": "cat", "name": "Whiskers", "lives_left": 9}`, "cat", "*godantic_test.TCat", false},
+		{`{"species": "dog", "name": "Buddy", "breed": "Golden"}`, "dog", "*godantic_test.TDog", false},
+		{`{"species": "bird", "name": "Tweety", "wingspan": 0.2}`, "bird", "*godantic_test.TBird", false},
+		{`{"species": "dog", "name": "Buddy"}`, "dog", "*godantic_test.TDog", true}, // Breed is required
+		{`{"species": "fish", "name": "Nemo"}`, "", "", true},
+		{`{"name": "Nemo"}`, "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			animal, variant, errs := validator.UnmarshalUnion([]byte(tt.input))
+			if variant != tt.wantVariant {
+				t.Errorf("variant = %q, want %q", variant, tt.wantVariant)
+			}
+			if (len(errs) > 0) != tt.wantErrs {
+				t.Errorf("errors = %v, want errors: %v", errs, tt.wantErrs)
+			}
+			gotType := ""
+			if animal != nil {
+				gotType = fmt.Sprintf("%T", *animal)
+			}
+			if gotType != tt.wantType {
+				t.Errorf("type = %s, want %s", gotType, tt.wantType)
+			}
+		})
+	}
+
+	t.Run("not a union", func(t *testing.T) {
+		animal, variant, errs := godantic.NewValidator[TSimpleAnimal]().UnmarshalUnion([]byte(`{"type": "dog", "name": "Rex"}`))
+		if animal == nil || variant != "" || len(errs) != 0 {
+			t.Errorf("got %v, %q, %v; want a result and no variant", animal, variant, errs)
+		}
+	})
+}
+
+func TestUnion_UnreflectableVariants(t *testing.T) {
+	validator := godantic.NewValidator[TAnimal](
+		godantic.WithDiscriminator("species", map[string]any{
+			"cat":    &TCat{},
+			"ghost":  nil,
+			"string": "not a struct",
+		}),
+	)
+
+	err := validator.Err()
+	if err == nil {
+		t.Fatal("expected the unusable variants to be reported")
+	}
+	for _, want := range []string{
+		`discriminator variant "ghost": <nil> is not a struct`,
+		`discriminator variant "string": string is not a struct`,
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected %q in %q", want, err)
+		}
+	}
+
+	// Unusable variants are left out instead of panicking at validation time
+	_, errs := validator.Unmarshal([]byte(`{"species": "ghost"}`))
+	if len(errs) != 1 || errs[0].Message != `"species" must be one of [cat], got "ghost"` {
+		t.Errorf("expected ghost to be rejected, got %v", errs)
+	}
+}
+
+func TestUnion_ConstMismatchesKey(t *testing.T) {
+	validator := godantic.NewValidator[TAnimal](
+		godantic.WithDiscriminator("species", map[string]any{
+			"cat": TCat{},
+			"dog": TBird{}, // TBird's species is Const("bird")
+		}),
+	)
+
+	err := validator.Err()
+	if err == nil {
+		t.Fatal("expected the Const/key mismatch to be reported")
+	}
+	want := `discriminator variant "dog": TBird.Species has Const("bird"), which does not match its key`
+	if err.Error() != want {
+		t.Errorf("got %q, want %q", err, want)
+	}
+
+	matching := godantic.NewValidator[TAnimal](
+		godantic.WithDiscriminator("species", map[string]any{"cat": TCat{}, "bird": &TBird{}}),
+	)
+	if err := matching.Err(); err != nil {
+		t.Errorf("unexpected error for matching keys: %v", err)
+	}
+}
+
 // ═══════════════════════════════════════════════════════════════════════════
 // Discriminated Union - Defaults
 // ═══════════════════════════════════════════════════════════════════════════
@@ -638,16 +902,56 @@ func TestUnion_SliceValidation(t *testing.T) {
 	})
 
 	t.Run("multiple_validation_errors", func(t *testing.T) {
-		_, errs := validator.Unmarshal([]byte(`{
+		data := []byte(`{
 			"title": "Test",
 			"items": [
 				{"type": "complex", "data": "First"},
 				{"type": "text", "text": "OK"},
 				{"type": "complex", "data": "Second"}
 			]
+		}`)
+		want := [][]string{{"Items", "[0]", "ID"}, {"Items", "[2]", "ID"}}
+
+		_, errs := validator.Unmarshal(data)
+		_, compiledErrs := validator.Compile().Unmarshal(data)
+		for name, errs := range map[string]godantic.ValidationErrors{"walker": errs, "compiled": compiledErrs} {
+			if len(errs) != len(want) {
+				t.Fatalf("%s: expected %d errors, got %d: %v", name, len(want), len(errs), errs)
+			}
+			for i, e := range errs {
+				if !reflect.DeepEqual(e.Loc, want[i]) || e.Type != godantic.ErrorTypeRequired {
+					t.Errorf("%s: error %d = %v %s, want required at %v", name, i, e.Loc, e.Type, want[i])
+				}
+			}
+		}
+	})
+
+	t.Run("element_discriminator_errors", func(t *testing.T) {
+		_, errs := validator.Unmarshal([]byte(`{
+			"title": "Test",
+			"items": [
+				{"text": "no type"},
+				{"type": "text", "text": "OK"},
+				{"type": "bogus"}
+			]
 		}`))
-		if len(errs) < 2 {
-			t.Errorf("expected >= 2 errors, got %d: %v", len(errs), errs)
+		want := []struct {
+			loc []string
+			typ godantic.ErrorType
+		}{
+			{[]string{"Items", "[0]", "type"}, godantic.ErrorTypeDiscriminatorMissing},
+			{[]string{"Items", "[2]", "type"}, godantic.ErrorTypeDiscriminatorInvalid},
+		}
+		if len(errs) != len(want) {
+			t.Fatalf("expected %d errors, got %d: %v", len(want), len(errs), errs)
+		}
+		for i, e := range errs {
+			if !reflect.DeepEqual(e.Loc, want[i].loc) || e.Type != want[i].typ {
+				t.Errorf("error %d = %v %s, want %s at %v", i, e.Loc, e.Type, want[i].typ, want[i].loc)
+			}
+		}
+		if msg := errs[1].Message; !strings.HasPrefix(msg, `"type" must be one of [`) || !strings.HasSuffix(msg, `], got "bogus"`) {
+			t.Errorf("expected the allowed values in the message, got %q", msg)
 		}
 	})
 }
@@ -1,9 +1,15 @@
 package godantic
 
 import (
+	"context"
+	stderrors "errors"
 	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
 
+	"github.com/deepankarm/godantic/pkg/internal/errors"
 	"github.com/deepankarm/godantic/pkg/internal/partialjson"
 	"github.com/deepankarm/godantic/pkg/internal/reflectutil"
 	"github.com/deepankarm/godantic/pkg/internal/walk"
@@ -13,6 +19,7 @@ import (
 // It caches results to avoid repeated reflection calls.
 type walkScanner struct {
 	cache sync.Map // map[reflect.Type]map[string]*walk.FieldOptions
+	tag   string   // Tag naming the fields of dependent rules and siblings ("" = json)
 }
 
 // ScanFieldOptions implements walk.FieldScanner with caching.
@@ -30,11 +37,38 @@ func (s *walkScanner) ScanFieldOptions(t reflect.Type) map[string]*walk.FieldOpt
 	// Convert to walk.FieldOptions
 	result := make(map[string]*walk.FieldOptions, len(internalOpts))
 	for fieldName, holder := range internalOpts {
+		validators := holder.validators
+		// Errors of write-only fields must not echo the value back
+		if writeOnly, _ := holder.constraints[ConstraintWriteOnly].(bool); writeOnly {
+			validators = hideInputs(validators)
+		}
 		result[fieldName] = &walk.FieldOptions{
-			Required:    holder.required,
-			Constraints: holder.constraints,
-			Validators:  holder.validators,
+			Required:          holder.required,
+			Constraints:       holder.constraints,
+			Validators:        validators,
+			ContextValidators: holder.ctxValidators,
+			SiblingValidators: holder.siblingValidators,
+		}
+	}
+
+	// Struct-level dependent rules become conditional required checks
+	for fieldName, checks := range requiredWhen(t, s.tag) {
+		opts := result[fieldName]
+		if opts == nil {
+			opts = &walk.FieldOptions{Constraints: map[string]any{}}
+			result[fieldName] = opts
 		}
+		opts.RequiredWhen = checks
+	}
+
+	// Raw JSON fields must hold valid JSON
+	for _, fieldName := range rawJSONFields(t) {
+		opts := result[fieldName]
+		if opts == nil {
+			opts = &walk.FieldOptions{Constraints: map[string]any{}}
+			result[fieldName] = opts
+		}
+		opts.Validators = append(slices.Clip(opts.Validators), validRawJSON)
 	}
 
 	// Cache the result
@@ -45,16 +79,54 @@ func (s *walkScanner) ScanFieldOptions(t reflect.Type) map[string]*walk.FieldOpt
 // cachedScanner is the shared scanner instance with caching.
 var cachedScanner = &walkScanner{}
 
+// taggedScanners holds a scanner per WithTagName tag, since dependent rules
+// resolve field names under the tag
+var taggedScanners sync.Map // map[string]*walkScanner
+
+// scannerFor returns the shared scanner for walks with fields named by tag
+func scannerFor(tag string) *walkScanner {
+	if tag == "" {
+		return cachedScanner
+	}
+	s, _ := taggedScanners.LoadOrStore(tag, &walkScanner{tag: tag})
+	return s.(*walkScanner)
+}
+
 // walkValidate runs validation processors on a struct.
-func walkValidate(objPtr reflect.Value) ValidationErrors {
-	w := walk.NewWalker(cachedScanner,
-		walk.NewValidateProcessor(),
+func walkValidate(objPtr reflect.Value, cfg *validatorConfig) ValidationErrors {
+	return walkValidateContext(context.Background(), objPtr, cfg)
+}
+
+// walkValidateContext runs validation processors with ctx available to context
+// validators. Cancellation stops the walk and appends an ErrorTypeContext error.
+// Structs nested deeper than cfg.maxDepth (0 = no limit) stop it with ErrorTypeMaxDepth.
+func walkValidateContext(ctx context.Context, objPtr reflect.Value, cfg *validatorConfig) ValidationErrors {
+	validateProcessor := walk.NewValidateProcessor()
+	validateProcessor.Ctx = ctx
+	w := walk.NewWalker(scannerFor(cfg.tagName),
+		validateProcessor,
 		walk.NewUnionValidateProcessor(),
 	)
-	if err := w.Walk(objPtr.Elem(), nil); err != nil {
-		return ValidationErrors{{Loc: []string{}, Message: err.Error(), Type: ErrorTypeInternal}}
+	w.MaxDepth = cfg.maxDepth
+	w.TagName = cfg.tagName
+	err := w.Walk(objPtr.Elem(), nil)
+	return withJSONLocs(walkResult(w.Errors(), err), objPtr.Elem(), cfg.tagName)
+}
+
+// walkResult combines the errors a walk collected with the error that stopped
+// it: cancellation and the depth limit are reported after the collected
+// errors, anything else replaces them as an internal error.
+func walkResult(errs ValidationErrors, err error) ValidationErrors {
+	var depthErr *walk.MaxDepthError
+	switch {
+	case err == nil:
+		return errs
+	case stderrors.Is(err, context.Canceled) || stderrors.Is(err, context.DeadlineExceeded):
+		return append(errs, ValidationError{Loc: []string{}, Message: err.Error(), Type: ErrorTypeContext})
+	case stderrors.As(err, &depthErr):
+		return append(errs, depthErr.ValidationError())
 	}
-	return w.Errors()
+	return ValidationErrors{{Loc: []string{}, Message: err.Error(), Type: ErrorTypeInternal}}
 }
 
 // walkDefaults applies default values to zero fields.
@@ -64,36 +136,157 @@ func walkDefaults(objPtr reflect.Value) error {
 }
 
 // walkParse unmarshals JSON, applies defaults, and validates.
-func walkParse(objPtr reflect.Value, data []byte) ValidationErrors {
-	w := walk.NewWalker(cachedScanner,
-		walk.NewUnmarshalProcessor(),
-		walk.NewDefaultsProcessor(),
-		walk.NewValidateProcessor(),
-		walk.NewUnionValidateProcessor(),
-	)
+// With cfg.useNumber, numbers decoded into interface values become json.Number;
+// with cfg.absentOnlyDefaults, explicit zero values are kept instead of defaulted;
+// with cfg.noDefaults, no defaults are applied at all;
+// with cfg.lenientArrays, a single value given for a slice field becomes one element;
+// with cfg.tagName, data names fields by that tag.
+// If report is non-nil, it receives the paths (named by cfg.tagName) of fields filled by defaults.
+func walkParse(objPtr reflect.Value, data []byte, cfg *validatorConfig, report *Report) ValidationErrors {
+	unmarshalProcessor := walk.NewUnmarshalProcessor()
+	unmarshalProcessor.UseNumber = cfg.useNumber
+	unmarshalProcessor.DurationSeconds = cfg.coerce.durationSeconds
+	unmarshalProcessor.LenientArrays = cfg.lenientArrays
+	unmarshalProcessor.RenameKeys = tagRenamer(cfg.tagName)
+	defaultsProcessor := walk.NewDefaultsProcessor()
+	defaultsProcessor.AbsentOnly = cfg.absentOnlyDefaults
+	validateProcessor := walk.NewValidateProcessor()
+	validateProcessor.AbsentOnlyDefaults = cfg.absentOnlyDefaults
+	validateProcessor.NoDefaults = cfg.noDefaults
+	processors := []walk.Processor{unmarshalProcessor}
+	if !cfg.noDefaults {
+		processors = append(processors, defaultsProcessor)
+	}
+	processors = append(processors, validateProcessor, walk.NewUnionValidateProcessor())
+	w := walk.NewWalker(scannerFor(cfg.tagName), processors...)
+	w.MaxDepth = cfg.maxDepth
+	w.TagName = cfg.tagName
 	if err := w.Walk(objPtr.Elem(), data); err != nil {
-		return ValidationErrors{{Loc: []string{}, Message: err.Error(), Type: ErrorTypeInternal}}
+		return withJSONLocs(walkResult(w.Errors(), err), objPtr.Elem(), cfg.tagName)
 	}
-	return w.Errors()
+	if report != nil {
+		typ := objPtr.Elem().Type()
+		for _, path := range defaultsProcessor.Defaulted {
+			report.DefaultedFields = append(report.DefaultedFields, structPathToTagPath(path, typ, cfg.tagName))
+		}
+	}
+	return withJSONLocs(w.Errors(), objPtr.Elem(), cfg.tagName)
+}
+
+// walkDecoded applies defaults to and validates a value encoding/json already
+// decoded, running the processors of walkParse that follow its unmarshal step.
+func walkDecoded(objPtr reflect.Value, cfg *validatorConfig) ValidationErrors {
+	validateProcessor := walk.NewValidateProcessor()
+	validateProcessor.NoDefaults = cfg.noDefaults
+	var processors []walk.Processor
+	if !cfg.noDefaults {
+		processors = append(processors, walk.NewDefaultsProcessor())
+	}
+	processors = append(processors, validateProcessor, walk.NewUnionValidateProcessor())
+	w := walk.NewWalker(scannerFor(cfg.tagName), processors...)
+	w.MaxDepth = cfg.maxDepth
+	w.TagName = cfg.tagName
+	err := w.Walk(objPtr.Elem(), nil)
+	return withJSONLocs(walkResult(w.Errors(), err), objPtr.Elem(), cfg.tagName)
 }
 
 // prefixErrors prepends a path segment to all error locations.
 func prefixErrors(errs ValidationErrors, prefix string) ValidationErrors {
 	result := make(ValidationErrors, len(errs))
 	for i, e := range errs {
-		result[i] = ValidationError{
-			Loc:     append([]string{prefix}, e.Loc...),
-			Message: e.Message,
-			Type:    e.Type,
+		jsonLoc := append([]string{prefix}, errors.JSONLoc(e)...)
+		e.Loc = append([]string{prefix}, e.Loc...)
+		errors.SetJSONLoc(&e, jsonLoc)
+		result[i] = e
+	}
+	return result
+}
+
+// withJSONLocs records the location of each error, a location in root, with
+// struct fields named by tag ("" = json; see jsonLoc). Under another tag (see
+// WithTagName) Loc names them that way as well.
+func withJSONLocs(errs ValidationErrors, root reflect.Value, tag string) ValidationErrors {
+	for i := range errs {
+		if tag == "" {
+			errors.SetJSONLoc(&errs[i], jsonLoc(errs[i].Loc, root, "json"))
+		} else {
+			errs[i].Loc = jsonLoc(errs[i].Loc, root, tag)
+		}
+	}
+	return errs
+}
+
+// tagRenamer returns the walk.UnmarshalProcessor.RenameKeys of walks whose
+// data names fields by tag, or nil for json.
+func tagRenamer(tag string) func([]byte, reflect.Type) []byte {
+	if tag == "" {
+		return nil
+	}
+	return func(data []byte, typ reflect.Type) []byte {
+		return fromTagNames(data, typ, tag)
+	}
+}
+
+// jsonLoc renames the struct fields in loc, a location in root, to their
+// names under tag (see reflectutil.TagFieldName). Unlike structPathToTagPath
+// it follows the values in root, so fields of the value an interface holds
+// are renamed too; where neither the value nor the type tells the field
+// apart, the rest of loc is kept as is.
+func jsonLoc(loc []string, root reflect.Value, tag string) []string {
+	result := slices.Clone(loc)
+	val, typ := root, root.Type()
+	for i, seg := range loc {
+		for typ.Kind() == reflect.Pointer || typ.Kind() == reflect.Interface {
+			if val.IsValid() && !val.IsNil() {
+				val = val.Elem()
+				typ = val.Type()
+				continue
+			}
+			if typ.Kind() == reflect.Interface {
+				return result
+			}
+			val, typ = reflect.Value{}, typ.Elem()
+		}
+
+		switch typ.Kind() {
+		case reflect.Struct:
+			field, ok := typ.FieldByName(seg)
+			if !ok {
+				return result
+			}
+			result[i] = reflectutil.TagFieldName(field, tag)
+			if val.IsValid() {
+				val, _ = val.FieldByIndexErr(field.Index)
+			}
+			typ = field.Type
+		case reflect.Slice, reflect.Array:
+			index, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(seg, "["), "]"))
+			if val.IsValid() && err == nil && index >= 0 && index < val.Len() {
+				val = val.Index(index)
+			} else {
+				val = reflect.Value{}
+			}
+			typ = typ.Elem()
+		case reflect.Map:
+			if val.IsValid() && typ.Key().Kind() == reflect.String {
+				val = val.MapIndex(reflect.ValueOf(seg).Convert(typ.Key()))
+			} else {
+				val = reflect.Value{}
+			}
+			typ = typ.Elem()
+		default:
+			return result
 		}
 	}
 	return result
 }
 
 // walkParsePartial unmarshals potentially incomplete JSON, applies defaults, and validates.
+// incompletePaths are the paths the caller's parser already found truncated; data
+// is usually repaired JSON by now, so re-parsing it would not find them again.
 // Returns the result with incomplete field paths tracked.
-func walkParsePartial(objPtr reflect.Value, data []byte) (*PartialUnmarshalResult, ValidationErrors) {
-	// First parse to get incomplete paths
+func walkParsePartial(objPtr reflect.Value, data []byte, incompletePaths [][]string, tag string) (*PartialUnmarshalResult, ValidationErrors) {
+	// Parse again in case a BeforeValidate hook returned truncated data
 	parser := partialjson.NewParser(false)
 	parseResult, err := parser.Parse(data)
 	if err != nil {
@@ -102,16 +295,18 @@ func walkParsePartial(objPtr reflect.Value, data []byte) (*PartialUnmarshalResul
 
 	// Use normal processors - we'll filter validation errors after
 	unmarshalProcessor := walk.NewUnmarshalProcessor()
+	unmarshalProcessor.RenameKeys = tagRenamer(tag)
 	defaultsProcessor := walk.NewDefaultsProcessor()
 	validateProcessor := walk.NewValidateProcessor()
 	unionValidateProcessor := walk.NewUnionValidateProcessor()
 
-	w := walk.NewWalker(cachedScanner,
+	w := walk.NewWalker(scannerFor(tag),
 		unmarshalProcessor,
 		defaultsProcessor,
 		validateProcessor,
 		unionValidateProcessor,
 	)
+	w.TagName = tag
 
 	// Walk with repaired JSON
 	if err := w.Walk(objPtr.Elem(), parseResult.Repaired); err != nil {
@@ -120,7 +315,9 @@ func walkParsePartial(objPtr reflect.Value, data []byte) (*PartialUnmarshalResul
 
 	// Filter out validation errors for incomplete fields using actual JSON tags
 	typ := objPtr.Elem().Type()
-	validationErrors := filterIncompleteFieldErrors(validateProcessor.GetErrors(), parseResult.Incomplete, typ)
+	allIncomplete := append(slices.Clip(incompletePaths), parseResult.Incomplete...)
+	validationErrors := filterIncompleteFieldErrors(validateProcessor.GetErrors(), allIncomplete, typ, tag)
+	validationErrors = withJSONLocs(validationErrors, objPtr.Elem(), tag)
 
 	return &PartialUnmarshalResult{
 		Value:           objPtr.Elem(),
@@ -131,15 +328,11 @@ func walkParsePartial(objPtr reflect.Value, data []byte) (*PartialUnmarshalResul
 }
 
 // filterIncompleteFieldErrors removes validation errors for fields that are incomplete.
-// Uses the struct type to properly map Go field names to JSON field names.
-func filterIncompleteFieldErrors(errs []walk.ValidationError, incompletePaths [][]string, typ reflect.Type) ValidationErrors {
+// Uses the struct type to properly map Go field names to their names under tag ("" = json).
+func filterIncompleteFieldErrors(errs []walk.ValidationError, incompletePaths [][]string, typ reflect.Type, tag string) ValidationErrors {
 	if len(incompletePaths) == 0 {
 		// Fast path: nothing incomplete, keep all errors
-		result := make(ValidationErrors, len(errs))
-		for i, e := range errs {
-			result[i] = ValidationError{Loc: e.Loc, Message: e.Message, Type: ErrorType(e.Type)}
-		}
-		return result
+		return slices.Clone(errs)
 	}
 
 	// Build set of incomplete JSON paths using partialjson utility
@@ -148,13 +341,9 @@ func filterIncompleteFieldErrors(errs []walk.ValidationError, incompletePaths []
 	var filtered ValidationErrors
 	for _, e := range errs {
 		// Convert struct path to JSON path using actual JSON tags
-		jsonPath := structPathToJSONPath(e.Loc, typ)
+		jsonPath := structPathToTagPath(e.Loc, typ, tag)
 		if !partialjson.IsPathOrParentIncomplete(jsonPath, incompleteSet) {
-			filtered = append(filtered, ValidationError{
-				Loc:     e.Loc,
-				Message: e.Message,
-				Type:    ErrorType(e.Type),
-			})
+			filtered = append(filtered, e)
 		}
 	}
 	return filtered
@@ -163,6 +352,14 @@ func filterIncompleteFieldErrors(errs []walk.ValidationError, incompletePaths []
 // structPathToJSONPath converts struct field path to JSON path using actual JSON tags.
 // Uses reflectutil.GoFieldToJSONName for proper tag lookup.
 func structPathToJSONPath(structPath []string, typ reflect.Type) string {
+	return structPathToTagPath(structPath, typ, "")
+}
+
+// structPathToTagPath is structPathToJSONPath naming fields by tag ("" = json).
+func structPathToTagPath(structPath []string, typ reflect.Type, tag string) string {
+	if tag == "" {
+		tag = "json"
+	}
 	if len(structPath) == 0 {
 		return ""
 	}
@@ -182,13 +379,23 @@ func structPathToJSONPath(structPath []string, typ reflect.Type) string {
 			}
 			// For array elements, try to get element type
 			if currentType.Kind() == reflect.Slice || currentType.Kind() == reflect.Array {
-				currentType = currentType.Elem()
+				currentType = reflectutil.UnwrapPointer(currentType.Elem())
 			}
 			continue
 		}
 
-		// Get JSON name from struct tag
-		jsonName := reflectutil.GoFieldToJSONName(currentType, fieldName)
+		// Map keys are used as-is
+		if currentType.Kind() == reflect.Map {
+			result += "." + fieldName
+			currentType = reflectutil.UnwrapPointer(currentType.Elem())
+			continue
+		}
+
+		// Get JSON name from struct tag (interfaces keep the Go name)
+		jsonName := fieldName
+		if currentType.Kind() == reflect.Struct {
+			jsonName = reflectutil.GoFieldToTagName(currentType, fieldName, tag)
+		}
 
 		if i == 0 {
 			result = jsonName
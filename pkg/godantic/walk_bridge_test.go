@@ -175,7 +175,7 @@ func TestFilterIncompleteFieldErrors(t *testing.T) {
 			{Loc: []string{"Age"}, Message: "min", Type: "constraint"},
 		}
 
-		result := filterIncompleteFieldErrors(errs, nil, typ)
+		result := filterIncompleteFieldErrors(errs, nil, typ, "")
 		if len(result) != 2 {
 			t.Errorf("expected 2 errors, got %d", len(result))
 		}
@@ -188,7 +188,7 @@ func TestFilterIncompleteFieldErrors(t *testing.T) {
 		}
 		incompletePaths := [][]string{{"name"}} // name is incomplete
 
-		result := filterIncompleteFieldErrors(errs, incompletePaths, typ)
+		result := filterIncompleteFieldErrors(errs, incompletePaths, typ, "")
 		if len(result) != 1 {
 			t.Errorf("expected 1 error, got %d: %v", len(result), result)
 		}
@@ -204,7 +204,7 @@ func TestFilterIncompleteFieldErrors(t *testing.T) {
 		}
 		incompletePaths := [][]string{{"address", "city"}} // address.city is incomplete
 
-		result := filterIncompleteFieldErrors(errs, incompletePaths, typ)
+		result := filterIncompleteFieldErrors(errs, incompletePaths, typ, "")
 		if len(result) != 1 {
 			t.Errorf("expected 1 error, got %d: %v", len(result), result)
 		}
@@ -220,7 +220,7 @@ func TestFilterIncompleteFieldErrors(t *testing.T) {
 		}
 		incompletePaths := [][]string{{"address"}} // whole address is incomplete
 
-		result := filterIncompleteFieldErrors(errs, incompletePaths, typ)
+		result := filterIncompleteFieldErrors(errs, incompletePaths, typ, "")
 		if len(result) != 0 {
 			t.Errorf("expected 0 errors (parent incomplete), got %d: %v", len(result), result)
 		}
@@ -232,7 +232,7 @@ func TestFilterIncompleteFieldErrors(t *testing.T) {
 		}
 		incompletePaths := [][]string{{"tags", "[0]"}}
 
-		result := filterIncompleteFieldErrors(errs, incompletePaths, typ)
+		result := filterIncompleteFieldErrors(errs, incompletePaths, typ, "")
 		if len(result) != 0 {
 			t.Errorf("expected 0 errors (array element incomplete), got %d", len(result))
 		}
@@ -245,7 +245,7 @@ func TestFilterIncompleteFieldErrors(t *testing.T) {
 		}
 		incompletePaths := [][]string{{"tags", "[0]"}} // only [0] is incomplete
 
-		result := filterIncompleteFieldErrors(errs, incompletePaths, typ)
+		result := filterIncompleteFieldErrors(errs, incompletePaths, typ, "")
 		if len(result) != 1 {
 			t.Errorf("expected 1 error, got %d: %v", len(result), result)
 		}
@@ -181,6 +181,69 @@ func (e *EventLog) FieldEvent() godantic.FieldOptions[Event] {
 	)
 }
 
+// ============================================================================
+// Root Union Dispatch: 10 variants, 100k messages
+// ============================================================================
+
+func newEventValidator() *godantic.Validator[Event] {
+	return godantic.NewValidator[Event](
+		godantic.WithDiscriminator("type", map[string]any{
+			"click":  ClickEvent{},
+			"scroll": ScrollEvent{},
+			"key":    KeyEvent{},
+			"mouse":  MouseEvent{},
+			"touch":  TouchEvent{},
+			"resize": ResizeEvent{},
+			"load":   LoadEvent{},
+			"error":  ErrorEvent{},
+			"focus":  FocusEvent{},
+			"blur":   BlurEvent{},
+		}),
+	)
+}
+
+// eventMessages cycles through every variant
+func eventMessages(n int) [][]byte {
+	variants := []string{
+		`{"type":"click","x":10,"y":20}`,
+		`{"type":"scroll","delta":-3}`,
+		`{"type":"key","key":"Enter"}`,
+		`{"type":"mouse","button":1}`,
+		`{"type":"touch","touches":2}`,
+		`{"type":"resize","width":1280,"height":720}`,
+		`{"type":"load","url":"https://example.com"}`,
+		`{"type":"error","message":"boom"}`,
+		`{"type":"focus","element_id":"email"}`,
+		`{"type":"blur","element_id":"email"}`,
+	}
+	messages := make([][]byte, n)
+	for i := range messages {
+		messages[i] = []byte(variants[i%len(variants)])
+	}
+	return messages
+}
+
+func benchmarkEventDispatch(b *testing.B, unmarshal func([]byte) (*Event, godantic.ValidationErrors)) {
+	messages := eventMessages(100_000)
+
+	b.ResetTimer()
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		if _, errs := unmarshal(messages[i%len(messages)]); len(errs) != 0 {
+			b.Fatalf("unexpected validation errors: %v", errs)
+		}
+	}
+}
+
+func BenchmarkDiscriminator_Dispatch10(b *testing.B) {
+	benchmarkEventDispatch(b, newEventValidator().Unmarshal)
+}
+
+func BenchmarkDiscriminator_Dispatch10_Compiled(b *testing.B) {
+	benchmarkEventDispatch(b, newEventValidator().Compile().Unmarshal)
+}
+
 // Manual: Custom UnmarshalJSON implementation
 type ManualPetOwner struct {
 	Name string    `json:"name"`
@@ -0,0 +1,95 @@
+package godantic_bench
+
+import (
+	"testing"
+
+	"github.com/deepankarm/godantic/pkg/gingodantic"
+	"github.com/deepankarm/godantic/pkg/godantic/schema"
+	"github.com/gin-gonic/gin"
+)
+
+// ============================================================================
+// Benchmark Fixtures: a 20-type API (10 resources, request + response each)
+// ============================================================================
+
+type SpecEntity struct {
+	ID    int           `json:"id"`
+	Name  string        `json:"name"`
+	Owner SimpleModel   `json:"owner"`
+	Ship  SchemaAddress `json:"ship"`
+	Tags  []string      `json:"tags"`
+}
+
+type (
+	SpecUserRequest      struct{ SpecEntity }
+	SpecUserResponse     struct{ MediumModel }
+	SpecOrderRequest     struct{ SpecEntity }
+	SpecOrderResponse    struct{ MediumModel }
+	SpecProductRequest   struct{ SpecEntity }
+	SpecProductResponse  struct{ MediumModel }
+	SpecInvoiceRequest   struct{ SpecEntity }
+	SpecInvoiceResponse  struct{ MediumModel }
+	SpecPaymentRequest   struct{ SpecEntity }
+	SpecPaymentResponse  struct{ MediumModel }
+	SpecShipmentRequest  struct{ SpecEntity }
+	SpecShipmentResponse struct{ MediumModel }
+	SpecReviewRequest    struct{ SpecEntity }
+	SpecReviewResponse   struct{ MediumModel }
+	SpecCouponRequest    struct{ SpecEntity }
+	SpecCouponResponse   struct{ MediumModel }
+	SpecCategoryRequest  struct{ SpecEntity }
+	SpecCategoryResponse struct{ MediumModel }
+	SpecSupplierRequest  struct{ SpecEntity }
+	SpecSupplierResponse struct{ MediumModel }
+)
+
+func addSpecRoute[Req, Resp any](api *gingodantic.API, path string) {
+	api.OpenAPISchema("POST", path,
+		gingodantic.WithRequest[Req](),
+		gingodantic.WithResponse[Resp](200, "OK"),
+	)
+}
+
+func newSpecAPI() *gingodantic.API {
+	gin.SetMode(gin.TestMode)
+	api := gingodantic.New("Bench API", "1.0.0")
+	addSpecRoute[SpecUserRequest, SpecUserResponse](api, "/users")
+	addSpecRoute[SpecOrderRequest, SpecOrderResponse](api, "/orders")
+	addSpecRoute[SpecProductRequest, SpecProductResponse](api, "/products")
+	addSpecRoute[SpecInvoiceRequest, SpecInvoiceResponse](api, "/invoices")
+	addSpecRoute[SpecPaymentRequest, SpecPaymentResponse](api, "/payments")
+	addSpecRoute[SpecShipmentRequest, SpecShipmentResponse](api, "/shipments")
+	addSpecRoute[SpecReviewRequest, SpecReviewResponse](api, "/reviews")
+	addSpecRoute[SpecCouponRequest, SpecCouponResponse](api, "/coupons")
+	addSpecRoute[SpecCategoryRequest, SpecCategoryResponse](api, "/categories")
+	addSpecRoute[SpecSupplierRequest, SpecSupplierResponse](api, "/suppliers")
+	return api
+}
+
+// ============================================================================
+// Benchmarks: OpenAPI Generation
+// ============================================================================
+
+func BenchmarkGenerateOpenAPI_20Types(b *testing.B) {
+	api := newSpecAPI()
+	api.GenerateOpenAPI() // Warm the schema cache
+
+	b.ResetTimer()
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		api.GenerateOpenAPI()
+	}
+}
+
+func BenchmarkGenerateOpenAPI_20Types_Uncached(b *testing.B) {
+	api := newSpecAPI()
+
+	b.ResetTimer()
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		schema.ClearCache()
+		api.GenerateOpenAPI()
+	}
+}
@@ -105,6 +105,53 @@ func BenchmarkUnmarshal_Medium(b *testing.B) {
 	}
 }
 
+func BenchmarkUnmarshal_Compiled_Simple(b *testing.B) {
+	validator := godantic.NewValidator[Product]().Compile()
+	data := []byte(`{"id":1,"name":"Widget","price":19.99,"in_stock":true,"description":"A useful widget"}`)
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, errs := validator.Unmarshal(data)
+		if len(errs) != 0 {
+			b.Fatalf("unexpected validation errors: %v", errs)
+		}
+	}
+}
+
+func BenchmarkUnmarshal_Compiled_Medium(b *testing.B) {
+	validator := godantic.NewValidator[MediumUser]().Compile()
+	data := []byte(`{
+		"id":1,
+		"username":"johndoe",
+		"email":"john@example.com",
+		"first_name":"John",
+		"last_name":"Doe",
+		"phone_number":"+12345678901",
+		"age":30,
+		"is_active":true,
+		"roles":["user","admin"],
+		"address":{
+			"street":"123 Main St",
+			"city":"New York",
+			"state":"NY",
+			"zip":"10001",
+			"country":"US"
+		},
+		"bio":"Software engineer"
+	}`)
+
+	b.ResetTimer()
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, errs := validator.Unmarshal(data)
+		if len(errs) != 0 {
+			b.Fatalf("unexpected validation errors: %v", errs)
+		}
+	}
+}
+
 // Comparison: godantic.Unmarshal vs json.Unmarshal
 func BenchmarkUnmarshal_Godantic(b *testing.B) {
 	validator := godantic.NewValidator[Product]()
@@ -2,7 +2,11 @@
 package errors
 
 import (
+	"cmp"
+	stderrors "errors"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 )
 
@@ -11,16 +15,21 @@ type ErrorType string
 
 // Error type constants.
 const (
-	ErrorTypeRequired             ErrorType = "required"              // Field is required but missing/zero
-	ErrorTypeConstraint           ErrorType = "constraint"            // Field constraint violation (min, max, etc.)
-	ErrorTypeInternal             ErrorType = "internal"              // Internal error (nil pointer, reflection issue)
-	ErrorTypeJSONDecode           ErrorType = "json_decode"           // JSON unmarshaling failed
-	ErrorTypeJSONEncode           ErrorType = "json_encode"           // JSON marshaling failed
-	ErrorTypeHookError            ErrorType = "hook_error"            // Lifecycle hook returned error
-	ErrorTypeDiscriminatorMissing ErrorType = "discriminator_missing" // Discriminator field not found
-	ErrorTypeDiscriminatorInvalid ErrorType = "discriminator_invalid" // Discriminator value not in mapping
-	ErrorTypeMismatch             ErrorType = "type_error"            // Type mismatch during validation
-	ErrorTypeMarshalError         ErrorType = "marshal_error"         // Marshal error (map validation)
+	ErrorTypeRequired             ErrorType = "required"               // Field is required but missing/zero
+	ErrorTypeConstraint           ErrorType = "constraint"             // Field constraint violation (min, max, etc.)
+	ErrorTypeInternal             ErrorType = "internal"               // Internal error (nil pointer, reflection issue)
+	ErrorTypeJSONDecode           ErrorType = "json_decode"            // JSON unmarshaling failed
+	ErrorTypeJSONEncode           ErrorType = "json_encode"            // JSON marshaling failed
+	ErrorTypeHookError            ErrorType = "hook_error"             // Lifecycle hook returned error
+	ErrorTypeDiscriminatorMissing ErrorType = "discriminator_missing"  // Discriminator field not found
+	ErrorTypeDiscriminatorInvalid ErrorType = "discriminator_invalid"  // Discriminator value not in mapping
+	ErrorTypeMismatch             ErrorType = "type_error"             // Type mismatch during validation
+	ErrorTypeMarshalError         ErrorType = "marshal_error"          // Marshal error (map validation)
+	ErrorTypeContext              ErrorType = "context"                // Context cancelled or deadline exceeded during validation
+	ErrorTypeCoercion             ErrorType = "coercion"               // String value (query/path/header) doesn't parse as the field type, or an enum index is unknown
+	ErrorTypeMaxDepth             ErrorType = "max_depth"              // Value nested deeper than the validator's depth limit
+	ErrorTypeFileTooLarge         ErrorType = "file_too_large"         // Uploaded file larger than MaxFileSize allows (HTTP 413)
+	ErrorTypeUnsupportedMediaType ErrorType = "unsupported_media_type" // Uploaded file of a type AllowedContentTypes rejects (HTTP 415)
 )
 
 // ValidationError represents a validation error with location information.
@@ -28,6 +37,24 @@ type ValidationError struct {
 	Loc     []string  // Path to the field, e.g., ["Address", "ZipCode"]
 	Message string    // Human-readable error message
 	Type    ErrorType // Error category
+	Input   any       `json:",omitempty"` // Offending value of a constraint error, truncated; nil when not reported
+
+	jsonLoc []string // Loc with fields named as on the wire; nil when Loc already is
+}
+
+// SetJSONLoc records the location of e with struct fields named as on the
+// wire (by their json tags), which Path, Pointer and Pretty render.
+func SetJSONLoc(e *ValidationError, loc []string) {
+	e.jsonLoc = loc
+}
+
+// JSONLoc returns the location of e with fields named as on the wire, which
+// is Loc when no other names were recorded.
+func JSONLoc(e ValidationError) []string {
+	if e.jsonLoc != nil {
+		return e.jsonLoc
+	}
+	return e.Loc
 }
 
 // Error implements the error interface.
@@ -38,6 +65,129 @@ func (e ValidationError) Error() string {
 	return fmt.Sprintf("%s: %s", strings.Join(e.Loc, "."), e.Message)
 }
 
+// Path renders the location as a dotted path with bracketed indices, naming
+// fields by their JSON names, e.g. "tasks[2].estimate".
+func (e ValidationError) Path() string {
+	var b strings.Builder
+	for i, seg := range JSONLoc(e) {
+		if i > 0 && !strings.HasPrefix(seg, "[") {
+			b.WriteByte('.')
+		}
+		b.WriteString(seg)
+	}
+	return b.String()
+}
+
+// Pointer renders the location as an RFC 6901 JSON Pointer, naming fields by
+// their JSON names, e.g. "/tasks/2/estimate". Indices become bare numbers, and
+// "~" and "/" in names are escaped as "~0" and "~1". An empty location yields
+// "", which points at the whole document.
+func (e ValidationError) Pointer() string {
+	var b strings.Builder
+	for _, seg := range JSONLoc(e) {
+		b.WriteByte('/')
+		if i, ok := locIndex(seg); ok {
+			b.WriteString(strconv.Itoa(i))
+			continue
+		}
+		b.WriteString(pointerEscaper.Replace(seg))
+	}
+	return b.String()
+}
+
+// pointerEscaper escapes a JSON Pointer reference token (RFC 6901, section 3)
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// MultiError holds several problems found by a single validator. Each member
+// is reported as its own ValidationError at the field's location.
+type MultiError []error
+
+// Error joins the messages of the members.
+func (m MultiError) Error() string {
+	msgs := make([]string, len(m))
+	for i, err := range m {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the members, for errors.Is and errors.As.
+func (m MultiError) Unwrap() []error {
+	return m
+}
+
+// InputError attaches the offending value to the error of a field validator.
+// ConstraintErrors reports it as the Input of the ValidationError.
+type InputError struct {
+	Err   error
+	Input any
+}
+
+// Error returns the message of the wrapped error.
+func (e InputError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the wrapped error.
+func (e InputError) Unwrap() error {
+	return e.Err
+}
+
+// TypedError gives the error of a field validator a type other than
+// ErrorTypeConstraint. ConstraintErrors reports it as the Type of the
+// ValidationError.
+type TypedError struct {
+	Err  error
+	Type ErrorType
+}
+
+// Error returns the message of the wrapped error.
+func (e TypedError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the wrapped error.
+func (e TypedError) Unwrap() error {
+	return e.Err
+}
+
+// ConstraintErrors converts the error of a field validator to constraint
+// errors at loc: one per member of a MultiError, or one for any other error.
+func ConstraintErrors(loc []string, err error) []ValidationError {
+	multi, ok := err.(MultiError)
+	if !ok {
+		return []ValidationError{constraintError(loc, err)}
+	}
+	errs := make([]ValidationError, len(multi))
+	for i, member := range multi {
+		errs[i] = constraintError(loc, member)
+	}
+	return errs
+}
+
+// constraintError builds a constraint error at loc, taking its Input from an
+// InputError and its Type from a TypedError
+func constraintError(loc []string, err error) ValidationError {
+	e := ValidationError{Loc: loc, Message: err.Error(), Type: ErrorTypeConstraint}
+	if in, ok := err.(InputError); ok {
+		e.Input = in.Input
+	}
+	var typed TypedError
+	if stderrors.As(err, &typed) {
+		e.Type = typed.Type
+	}
+	return e
+}
+
+// DiscriminatorInvalidMessage describes a discriminator value outside the
+// allowed set, listing the allowed values sorted, e.g.
+// "species" must be one of [bird cat dog], got "fish".
+func DiscriminatorInvalidMessage(field, value string, allowed []string) string {
+	sorted := slices.Clone(allowed)
+	slices.Sort(sorted)
+	return fmt.Sprintf("%q must be one of %v, got %q", field, sorted, value)
+}
+
 // ValidationErrors is a slice of ValidationError that implements error.
 type ValidationErrors []ValidationError
 
@@ -73,3 +223,55 @@ func (es ValidationErrors) HasJSONDecodeError() bool {
 	}
 	return false
 }
+
+// Pretty renders one "<path>: <message>" line per error, sorted by path (see
+// ValidationError.Path). Slice indices sort numerically, and errors at the
+// same path keep their order.
+//
+// Example output:
+//
+//	tasks[2].estimate.hours: value must be >= 0
+//	tasks[10].title: required field
+func (es ValidationErrors) Pretty() string {
+	sorted := slices.Clone(es)
+	slices.SortStableFunc(sorted, func(a, b ValidationError) int {
+		return compareLoc(JSONLoc(a), JSONLoc(b))
+	})
+
+	lines := make([]string, len(sorted))
+	for i, e := range sorted {
+		if path := e.Path(); path != "" {
+			lines[i] = path + ": " + e.Message
+		} else {
+			lines[i] = e.Message
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// compareLoc orders locations segment by segment, comparing "[i]" indices numerically.
+func compareLoc(a, b []string) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		ai, aIsIndex := locIndex(a[i])
+		bi, bIsIndex := locIndex(b[i])
+		if aIsIndex && bIsIndex {
+			if c := cmp.Compare(ai, bi); c != 0 {
+				return c
+			}
+			continue
+		}
+		if c := strings.Compare(a[i], b[i]); c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(a), len(b))
+}
+
+// locIndex parses a "[i]" location segment.
+func locIndex(seg string) (int, bool) {
+	if len(seg) < 3 || seg[0] != '[' || seg[len(seg)-1] != ']' {
+		return 0, false
+	}
+	n, err := strconv.Atoi(seg[1 : len(seg)-1])
+	return n, err == nil
+}
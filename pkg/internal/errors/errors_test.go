@@ -25,6 +25,17 @@ func TestValidationError_Error(t *testing.T) {
 	}
 }
 
+func TestDiscriminatorInvalidMessage(t *testing.T) {
+	allowed := []string{"cat", "dog", "bird"}
+	got := DiscriminatorInvalidMessage("species", "fish", allowed)
+	if want := `"species" must be one of [bird cat dog], got "fish"`; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if allowed[0] != "cat" {
+		t.Error("allowed values were sorted in place")
+	}
+}
+
 func TestValidationErrors_Error(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -90,3 +101,90 @@ func TestValidationErrors_ErrorsAs(t *testing.T) {
 		t.Error("errors.As should succeed")
 	}
 }
+
+func TestValidationError_Path(t *testing.T) {
+	tests := []struct {
+		loc      []string
+		expected string
+	}{
+		{nil, ""},
+		{[]string{"Name"}, "Name"},
+		{[]string{"Tasks", "[2]", "Estimate", "Hours"}, "Tasks[2].Estimate.Hours"},
+		{[]string{"[0]", "Name"}, "[0].Name"},
+		{[]string{"Matrix", "[1]", "[3]"}, "Matrix[1][3]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if got := (ValidationError{Loc: tt.loc}).Path(); got != tt.expected {
+				t.Errorf("Path() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidationError_JSONLoc(t *testing.T) {
+	e := ValidationError{Loc: []string{"Tasks", "[2]", "Estimate"}, Message: "invalid"}
+	SetJSONLoc(&e, []string{"tasks", "[2]", "estimate"})
+
+	if got := e.Path(); got != "tasks[2].estimate" {
+		t.Errorf("Path() = %q, want the JSON names", got)
+	}
+	if got := e.Pointer(); got != "/tasks/2/estimate" {
+		t.Errorf("Pointer() = %q, want the JSON names", got)
+	}
+	if got := e.Error(); got != "Tasks.[2].Estimate: invalid" {
+		t.Errorf("Error() = %q, want the Go names of Loc", got)
+	}
+
+	other := ValidationError{Loc: []string{"owner"}, Message: "required field"}
+	if got := (ValidationErrors{e, other}).Pretty(); got != "owner: required field\ntasks[2].estimate: invalid" {
+		t.Errorf("Pretty() should sort by the JSON names, got\n%s", got)
+	}
+}
+
+func TestValidationError_Pointer(t *testing.T) {
+	tests := []struct {
+		name     string
+		loc      []string
+		expected string
+	}{
+		{"root", nil, ""},
+		{"nested object", []string{"Address", "City"}, "/Address/City"},
+		{"array index", []string{"Tasks", "[2]", "Estimate"}, "/Tasks/2/Estimate"},
+		{"nested arrays", []string{"Matrix", "[1]", "[3]"}, "/Matrix/1/3"},
+		{"slash in key", []string{"Headers", "a/b"}, "/Headers/a~1b"},
+		{"tilde in key", []string{"Labels", "~home", "x~/y"}, "/Labels/~0home/x~0~1y"},
+		{"empty key", []string{"Tags", ""}, "/Tags/"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (ValidationError{Loc: tt.loc}).Pointer(); got != tt.expected {
+				t.Errorf("Pointer() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidationErrors_Pretty(t *testing.T) {
+	errs := ValidationErrors{
+		{Loc: []string{"Tasks", "[10]", "Title"}, Message: "required field"},
+		{Loc: []string{"Tasks", "[2]", "Estimate", "Hours"}, Message: "value must be >= 0"},
+		{Loc: []string{"Owner"}, Message: "required field"},
+		{Loc: []string{}, Message: "AfterValidate hook failed"},
+		{Loc: []string{"Tasks", "[2]", "Estimate", "Hours"}, Message: "value must be a multiple of 0.25"},
+	}
+
+	expected := "AfterValidate hook failed\n" +
+		"Owner: required field\n" +
+		"Tasks[2].Estimate.Hours: value must be >= 0\n" +
+		"Tasks[2].Estimate.Hours: value must be a multiple of 0.25\n" +
+		"Tasks[10].Title: required field"
+	if got := errs.Pretty(); got != expected {
+		t.Errorf("Pretty() =\n%s\nwant\n%s", got, expected)
+	}
+	if errs[0].Loc[1] != "[10]" {
+		t.Error("Pretty() should not reorder the receiver")
+	}
+}
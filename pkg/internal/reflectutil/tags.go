@@ -59,11 +59,16 @@ func FieldByJSONName(val reflect.Value, typ reflect.Type, jsonName string) refle
 // FieldByGoName finds a struct field by Go field name and returns its JSON name.
 // Returns the default name if field not found.
 func GoFieldToJSONName(typ reflect.Type, goFieldName string) string {
+	return GoFieldToTagName(typ, goFieldName, "json")
+}
+
+// GoFieldToTagName is GoFieldToJSONName for the field's name under tag (see TagFieldName).
+func GoFieldToTagName(typ reflect.Type, goFieldName, tag string) string {
 	typ = UnwrapPointer(typ)
 
 	// Try direct field
 	if field, ok := typ.FieldByName(goFieldName); ok {
-		return JSONFieldName(field)
+		return TagFieldName(field, tag)
 	}
 
 	// Try embedded structs
@@ -71,10 +76,78 @@ func GoFieldToJSONName(typ reflect.Type, goFieldName string) string {
 		field := typ.Field(i)
 		if field.Anonymous && field.Type.Kind() == reflect.Struct {
 			if embField, ok := field.Type.FieldByName(goFieldName); ok {
-				return JSONFieldName(embField)
+				return TagFieldName(embField, tag)
 			}
 		}
 	}
 
 	return goFieldName
 }
+
+// TagFieldName returns the name of a struct field under tag, falling back to
+// its JSON field name when the field has no such tag. Returns "-" for ignored fields.
+func TagFieldName(field reflect.StructField, tag string) string {
+	value := field.Tag.Get(tag)
+	if idx := strings.Index(value, ","); idx != -1 {
+		value = value[:idx]
+	}
+	if value == "" {
+		return JSONFieldName(field)
+	}
+	return value
+}
+
+// TaggedField is a struct field as encoding/json sees it, with its name under another tag.
+type TaggedField struct {
+	Field    reflect.StructField
+	JSONName string
+	TagName  string
+}
+
+// TaggedFields lists the fields encoding/json maps for typ in declaration
+// order, flattening embedded structs without a json name in place of the
+// struct, with their names under tag. Fields declared directly on typ win over
+// promoted ones with the same JSON name.
+func TaggedFields(typ reflect.Type, tag string) []TaggedField {
+	var fields []TaggedField
+	seen := make(map[string]bool)
+	collectTaggedFields(UnwrapPointer(typ), tag, &fields, seen, map[reflect.Type]bool{})
+	return fields
+}
+
+func collectTaggedFields(typ reflect.Type, tag string, fields *[]TaggedField, seen map[string]bool, visiting map[reflect.Type]bool) {
+	if visiting[typ] {
+		return
+	}
+	visiting[typ] = true
+
+	// Claim the names declared at this level first, so they shadow promoted
+	// fields of embedded structs declared before them
+	own := make(map[int]string)
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if isFlattened(field) || !field.IsExported() {
+			continue
+		}
+		jsonName := JSONFieldName(field)
+		if jsonName == "-" || seen[jsonName] {
+			continue
+		}
+		seen[jsonName] = true
+		own[i] = jsonName
+	}
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if isFlattened(field) {
+			collectTaggedFields(UnwrapPointer(field.Type), tag, fields, seen, visiting)
+		} else if jsonName, ok := own[i]; ok {
+			*fields = append(*fields, TaggedField{Field: field, JSONName: jsonName, TagName: TagFieldName(field, tag)})
+		}
+	}
+}
+
+// isFlattened reports whether encoding/json promotes the fields of the
+// embedded struct field into its parent
+func isFlattened(field reflect.StructField) bool {
+	return field.Anonymous && field.Tag.Get("json") == "" && UnwrapPointer(field.Type).Kind() == reflect.Struct
+}
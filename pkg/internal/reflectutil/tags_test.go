@@ -110,3 +110,48 @@ func TestGoFieldToJSONName(t *testing.T) {
 		})
 	}
 }
+
+func TestTagFieldName(t *testing.T) {
+	tests := []struct {
+		name     string
+		field    reflect.StructField
+		expected string
+	}{
+		{"custom tag", reflect.StructField{Name: "X", Tag: `api:"x_api" json:"x"`}, "x_api"},
+		{"custom tag options", reflect.StructField{Name: "X", Tag: `api:"x_api,omitempty"`}, "x_api"},
+		{"falls back to json", reflect.StructField{Name: "X", Tag: `json:"x"`}, "x"},
+		{"falls back to field name", reflect.StructField{Name: "X"}, "X"},
+		{"options only", reflect.StructField{Name: "X", Tag: `api:",omitempty" json:"x"`}, "x"},
+		{"ignored", reflect.StructField{Name: "X", Tag: `api:"-" json:"x"`}, "-"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TagFieldName(tt.field, "api"); got != tt.expected {
+				t.Errorf("TagFieldName() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTaggedFields(t *testing.T) {
+	type inner struct {
+		ID string `api:"id_api" json:"id"`
+	}
+	type outer struct {
+		Name     string `api:"name_api" json:"name"`
+		Skipped  string `json:"-"`
+		internal string
+		inner
+		Last string `api:"last_api" json:"last"`
+	}
+
+	var got []string
+	for _, f := range TaggedFields(reflect.TypeOf(&outer{}), "api") {
+		got = append(got, f.JSONName+"="+f.TagName)
+	}
+	want := []string{"name=name_api", "id=id_api", "last=last_api"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TaggedFields() = %v, want %v", got, want)
+	}
+}
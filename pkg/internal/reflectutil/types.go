@@ -1,6 +1,26 @@
 package reflectutil
 
-import "reflect"
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var (
+	jsonNumberType      = reflect.TypeOf(json.Number(""))
+	durationType        = reflect.TypeOf(time.Duration(0))
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	optionalType        = reflect.TypeOf((*Optional)(nil)).Elem()
+)
+
+// Optional is implemented by godantic.Optional[T], a value that records
+// whether it was present in the decoded JSON.
+type Optional interface {
+	OptionalValue() (any, bool)
+}
 
 // JSONSchemaType returns the JSON Schema type string for a Go type.
 func JSONSchemaType(t reflect.Type) string {
@@ -13,6 +33,20 @@ func JSONSchemaType(t reflect.Type) string {
 		return JSONSchemaType(t.Elem())
 	}
 
+	if t == jsonNumberType {
+		return "number"
+	}
+
+	// Durations are decoded from strings such as "1h30m"
+	if t == durationType {
+		return "string"
+	}
+
+	// encoding/json writes TextMarshaler values as strings
+	if IsTextMarshaler(t) {
+		return "string"
+	}
+
 	switch t.Kind() {
 	case reflect.String:
 		return "string"
@@ -53,6 +87,9 @@ func MatchesJSONSchemaType(val reflect.Value, schemaType string) bool {
 	}
 
 	got := JSONSchemaType(val.Type())
+	if val.Type() == jsonNumberType {
+		got = jsonNumberSchemaType(json.Number(val.String()))
+	}
 	if got == schemaType {
 		return true
 	}
@@ -65,6 +102,14 @@ func MatchesJSONSchemaType(val reflect.Value, schemaType string) bool {
 	return false
 }
 
+// jsonNumberSchemaType classifies a json.Number as "integer" or "number".
+func jsonNumberSchemaType(n json.Number) string {
+	if strings.ContainsAny(string(n), ".eE") {
+		return "number"
+	}
+	return "integer"
+}
+
 // IsBasicType checks if a type is a basic Go type (not a custom struct needing validation).
 func IsBasicType(t reflect.Type) bool {
 	switch t.Kind() {
@@ -80,7 +125,52 @@ func IsBasicType(t reflect.Type) bool {
 		return true
 	}
 
-	return false
+	// Optional wrappers are decoded and validated as their wrapped value
+	if IsOptional(t) {
+		return true
+	}
+
+	// Decoded from a JSON string by UnmarshalText, so there are no fields to walk
+	return IsTextUnmarshaler(t)
+}
+
+// IsOptional reports whether t is a godantic.Optional[T].
+func IsOptional(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t.Implements(optionalType)
+}
+
+// OptionalElem returns the wrapped type of a godantic.Optional[T], or t itself.
+func OptionalElem(t reflect.Type) reflect.Type {
+	if IsOptional(t) {
+		if field, ok := t.FieldByName("Value"); ok {
+			return field.Type
+		}
+	}
+	return t
+}
+
+// UnwrapOptional returns the wrapped value of a godantic.Optional[T] and
+// whether it was set. ok is false if v is not an Optional.
+func UnwrapOptional(v reflect.Value) (inner reflect.Value, set, ok bool) {
+	if !v.IsValid() || !IsOptional(v.Type()) {
+		return v, false, false
+	}
+	return v.FieldByName("Value"), v.FieldByName("Set").Bool(), true
+}
+
+// IsDuration reports whether t is time.Duration.
+func IsDuration(t reflect.Type) bool {
+	return t == durationType
+}
+
+// IsTextUnmarshaler reports whether t or *t implements encoding.TextUnmarshaler.
+func IsTextUnmarshaler(t reflect.Type) bool {
+	return t.Implements(textUnmarshalerType) || reflect.PointerTo(t).Implements(textUnmarshalerType)
+}
+
+// IsTextMarshaler reports whether t or *t implements encoding.TextMarshaler.
+func IsTextMarshaler(t reflect.Type) bool {
+	return t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType)
 }
 
 // UnwrapPointer returns the element type if pointer, otherwise returns the type itself.
@@ -110,7 +200,7 @@ func UnwrapValue(v reflect.Value) reflect.Value {
 	return v
 }
 
-// IsWalkableSliceElem checks if a slice's element type should be walked.
+// IsWalkableSliceElem checks if a slice's (or map's) element type should be walked.
 // Returns true for structs (non-basic) and interfaces (discriminated unions).
 func IsWalkableSliceElem(sliceType reflect.Type) bool {
 	elemType := UnwrapPointer(sliceType.Elem())
@@ -10,6 +10,12 @@ import (
 
 type customStruct struct{ Name string }
 
+// textStruct is decoded from a JSON string despite being a struct
+type textStruct struct{ parts []string }
+
+func (t textStruct) MarshalText() ([]byte, error) { return nil, nil }
+func (t *textStruct) UnmarshalText([]byte) error  { return nil }
+
 func TestJSONSchemaType(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -25,6 +31,8 @@ func TestJSONSchemaType(t *testing.T) {
 		{"map", reflect.TypeOf(map[string]int{}), "object"},
 		{"struct", reflect.TypeOf(struct{}{}), "object"},
 		{"pointer to string", reflect.TypeOf((*string)(nil)), "string"},
+		{"text marshaler", reflect.TypeOf(textStruct{}), "string"},
+		{"time.Time", reflect.TypeOf(time.Time{}), "string"},
 	}
 
 	for _, tt := range tests {
@@ -73,6 +81,7 @@ func TestIsBasicType(t *testing.T) {
 		{"map", reflect.TypeOf(map[string]int{}), true},
 		{"time.Time", reflect.TypeOf(time.Time{}), true},
 		{"custom struct", reflect.TypeOf(customStruct{}), false},
+		{"text unmarshaler", reflect.TypeOf(textStruct{}), true},
 	}
 
 	for _, tt := range tests {
@@ -138,6 +147,7 @@ func TestIsWalkableSliceElem(t *testing.T) {
 		{"pointer to struct", []*customStruct{}, true},
 		{"interface", []myInterface{}, true},
 		{"time.Time", []time.Time{}, false},
+		{"text unmarshaler", []textStruct{}, false},
 	}
 
 	for _, tt := range tests {
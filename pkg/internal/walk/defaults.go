@@ -5,13 +5,28 @@ import (
 )
 
 // DefaultsProcessor applies default values to zero-valued fields.
-type DefaultsProcessor struct{}
+type DefaultsProcessor struct {
+	// Defaulted records the paths of fields that were absent from the raw JSON
+	// and received their default value
+	Defaulted [][]string
+
+	// AbsentOnly applies defaults only to fields missing from the raw JSON,
+	// keeping explicit zero values such as an empty query parameter
+	AbsentOnly bool
+}
 
 // GetErrors returns collected errors (defaults processor doesn't generate errors).
 func (p *DefaultsProcessor) GetErrors() []ValidationError {
 	return nil
 }
 
+// HasDefault reports whether constraints set a default, by Default or DefaultFunc.
+func HasDefault(constraints map[string]any) bool {
+	_, hasDefault := constraints["default"]
+	_, hasDefaultFunc := constraints["defaultFunc"]
+	return hasDefault || hasDefaultFunc
+}
+
 // NewDefaultsProcessor creates a new defaults processor.
 func NewDefaultsProcessor() *DefaultsProcessor {
 	return &DefaultsProcessor{}
@@ -30,8 +45,12 @@ func (p *DefaultsProcessor) ProcessField(ctx *FieldContext) error {
 	}
 
 	// Check if field has a default
-	defaultVal, hasDefault := ctx.FieldOptions.Constraints["default"]
-	if !hasDefault {
+	if !HasDefault(ctx.FieldOptions.Constraints) {
+		return nil
+	}
+
+	// Strict-required fields must be provided explicitly
+	if strict, _ := ctx.FieldOptions.Constraints["strictRequired"].(bool); strict {
 		return nil
 	}
 
@@ -44,11 +63,21 @@ func (p *DefaultsProcessor) ProcessField(ctx *FieldContext) error {
 	if !ctx.Value.IsZero() {
 		return nil
 	}
+	if p.AbsentOnly && len(ctx.RawJSON) > 0 {
+		return nil
+	}
 
-	// Set the default
+	// Set the default, computing it for DefaultFunc
+	defaultVal := ctx.FieldOptions.Constraints["default"]
+	if fn, ok := ctx.FieldOptions.Constraints["defaultFunc"].(func() any); ok {
+		defaultVal = fn()
+	}
 	defaultReflect := reflect.ValueOf(defaultVal)
-	if defaultReflect.Type().AssignableTo(ctx.Value.Type()) {
+	if defaultReflect.IsValid() && defaultReflect.Type().AssignableTo(ctx.Value.Type()) {
 		ctx.Value.Set(defaultReflect)
+		if len(ctx.RawJSON) == 0 {
+			p.Defaulted = append(p.Defaulted, ctx.Path)
+		}
 	}
 
 	return nil
@@ -0,0 +1,56 @@
+package walk
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// ParseDuration parses a duration string such as "30s" or "1h30m" with
+// time.ParseDuration. With bareSeconds, a plain number such as "30" or "1.5"
+// is read as seconds instead of being rejected for its missing unit.
+func ParseDuration(s string, bareSeconds bool) (time.Duration, error) {
+	if bareSeconds {
+		if seconds, err := strconv.ParseFloat(s, 64); err == nil {
+			return secondsToDuration(seconds, s)
+		}
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q: expected a number with a unit, such as \"30s\" or \"1h30m\"", s)
+	}
+	return d, nil
+}
+
+// DecodeDuration decodes a JSON duration: a string for ParseDuration, or a
+// number, which is nanoseconds as with encoding/json unless bareSeconds
+// makes it seconds.
+func DecodeDuration(data []byte, bareSeconds bool) (time.Duration, error) {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		return ParseDuration(s, bareSeconds)
+	}
+	if bareSeconds {
+		var seconds float64
+		if err := json.Unmarshal(data, &seconds); err != nil {
+			return 0, fmt.Errorf("invalid duration %s", data)
+		}
+		return secondsToDuration(seconds, string(data))
+	}
+	var ns int64
+	if err := json.Unmarshal(data, &ns); err != nil {
+		return 0, fmt.Errorf("invalid duration %s", data)
+	}
+	return time.Duration(ns), nil
+}
+
+// secondsToDuration converts seconds to a Duration, rejecting overflow
+func secondsToDuration(seconds float64, text string) (time.Duration, error) {
+	ns := seconds * float64(time.Second)
+	if math.IsNaN(ns) || ns > math.MaxInt64 || ns < math.MinInt64 {
+		return 0, fmt.Errorf("invalid duration %q: out of range", text)
+	}
+	return time.Duration(ns), nil
+}
@@ -2,7 +2,9 @@ package walk
 
 import (
 	"fmt"
+	"maps"
 	"reflect"
+	"slices"
 
 	"github.com/deepankarm/godantic/pkg/internal/errors"
 	"github.com/deepankarm/godantic/pkg/internal/reflectutil"
@@ -120,13 +122,9 @@ func (p *UnionValidateProcessor) validateSingleDiscriminator(val reflect.Value,
 	discValue := fmt.Sprintf("%v", discField.Interface())
 
 	if _, ok := mapping[discValue]; !ok {
-		validValues := make([]string, 0, len(mapping))
-		for k := range mapping {
-			validValues = append(validValues, k)
-		}
 		return &ValidationError{
 			Loc:     path,
-			Message: fmt.Sprintf("invalid discriminator value '%s', expected one of: %v", discValue, validValues),
+			Message: errors.DiscriminatorInvalidMessage(discriminatorField, discValue, slices.Collect(maps.Keys(mapping))),
 			Type:    errors.ErrorTypeConstraint,
 		}
 	}
@@ -1,9 +1,12 @@
 package walk
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"reflect"
+	"slices"
 
 	"github.com/deepankarm/godantic/pkg/internal/errors"
 	"github.com/deepankarm/godantic/pkg/internal/reflectutil"
@@ -13,6 +16,22 @@ import (
 // It handles regular fields and discriminated unions.
 type UnmarshalProcessor struct {
 	Errors []ValidationError
+
+	// UseNumber decodes JSON numbers held in interface values as json.Number
+	// instead of float64, preserving integers beyond float64's exact range.
+	UseNumber bool
+
+	// DurationSeconds reads unitless time.Duration values ("30" or 30) as
+	// seconds; otherwise strings need a unit and numbers are nanoseconds.
+	DurationSeconds bool
+
+	// LenientArrays decodes a single non-array JSON value given for a slice
+	// field as a one-element slice.
+	LenientArrays bool
+
+	// RenameKeys, if set, rewrites the object keys of JSON for a value of typ
+	// to the names encoding/json decodes, for walkers with a Walker.TagName.
+	RenameKeys func(data []byte, typ reflect.Type) []byte
 }
 
 // GetErrors returns collected validation errors.
@@ -27,6 +46,31 @@ func NewUnmarshalProcessor() *UnmarshalProcessor {
 	}
 }
 
+// decode unmarshals data into target, a pointer, honoring UseNumber and RenameKeys.
+func (p *UnmarshalProcessor) decode(data []byte, target any) error {
+	if p.RenameKeys != nil {
+		data = p.RenameKeys(data, reflect.TypeOf(target).Elem())
+	}
+	return DecodeJSON(data, target, p.UseNumber)
+}
+
+// DecodeJSON is json.Unmarshal with optional json.Number decoding.
+func DecodeJSON(data []byte, target any, useNumber bool) error {
+	if !useNumber {
+		return json.Unmarshal(data, target)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(target); err != nil {
+		return err
+	}
+	// Match json.Unmarshal: reject trailing data after the first value
+	if dec.More() {
+		return fmt.Errorf("invalid character after top-level value")
+	}
+	return nil
+}
+
 // ProcessField unmarshals JSON into a field.
 func (p *UnmarshalProcessor) ProcessField(ctx *FieldContext) error {
 	// Skip root - walker handles root separately
@@ -44,6 +88,10 @@ func (p *UnmarshalProcessor) ProcessField(ctx *FieldContext) error {
 		return nil
 	}
 
+	if p.LenientArrays {
+		wrapSingleValue(ctx)
+	}
+
 	// Check for discriminated union constraint
 	if ctx.FieldOptions != nil {
 		if discConstraint, ok := ctx.FieldOptions.Constraints["discriminator"].(map[string]any); ok {
@@ -51,23 +99,176 @@ func (p *UnmarshalProcessor) ProcessField(ctx *FieldContext) error {
 		}
 	}
 
+	// Enum fields given as an integer index decode the value it names
+	if ctx.FieldOptions != nil {
+		if values, ok := ctx.FieldOptions.Constraints["enumFromInt"].(map[int]any); ok && !p.resolveEnumIndex(ctx, values) {
+			return nil
+		}
+	}
+
 	// Regular field - unmarshal directly
 	return p.unmarshalRegular(ctx)
 }
 
 // unmarshalRegular unmarshals a regular (non-discriminated) field.
 func (p *UnmarshalProcessor) unmarshalRegular(ctx *FieldContext) error {
+	if holdsDuration(ctx.Value.Type()) && string(ctx.RawJSON) != "null" {
+		p.unmarshalDurations(ctx.RawJSON, ctx.Value, ctx.Path)
+		p.checkArrayLength(ctx)
+		return nil
+	}
 	fieldPtr := ctx.Value.Addr()
-	if err := json.Unmarshal(ctx.RawJSON, fieldPtr.Interface()); err != nil {
+	if err := p.decode(ctx.RawJSON, fieldPtr.Interface()); err != nil {
 		p.Errors = append(p.Errors, ValidationError{
 			Loc:     ctx.Path,
 			Message: fmt.Sprintf("JSON unmarshal failed: %v", err),
 			Type:    errors.ErrorTypeJSONDecode,
 		})
+		return nil
 	}
+	p.checkArrayLength(ctx)
 	return nil
 }
 
+// holdsDuration reports whether typ is time.Duration or pointers, slices,
+// arrays and string-keyed maps of it.
+func holdsDuration(typ reflect.Type) bool {
+	switch typ.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Array:
+		return holdsDuration(typ.Elem())
+	case reflect.Map:
+		return typ.Key().Kind() == reflect.String && holdsDuration(typ.Elem())
+	}
+	return reflectutil.IsDuration(typ)
+}
+
+// unmarshalDurations decodes data into target, a value of a type holdsDuration
+// accepts, reading each duration from a string such as "1h30m" (see
+// DecodeDuration). Invalid values are reported at their element's path.
+func (p *UnmarshalProcessor) unmarshalDurations(data []byte, target reflect.Value, path []string) {
+	if string(data) == "null" {
+		return
+	}
+	fail := func(err error) {
+		p.Errors = append(p.Errors, ValidationError{
+			Loc:     path,
+			Message: fmt.Sprintf("JSON unmarshal failed: %v", err),
+			Type:    errors.ErrorTypeJSONDecode,
+		})
+	}
+
+	switch target.Kind() {
+	case reflect.Pointer:
+		target.Set(reflect.New(target.Type().Elem()))
+		p.unmarshalDurations(data, target.Elem(), path)
+
+	case reflect.Slice, reflect.Array:
+		var elements []json.RawMessage
+		if err := json.Unmarshal(data, &elements); err != nil {
+			fail(err)
+			return
+		}
+		if target.Kind() == reflect.Slice {
+			target.Set(reflect.MakeSlice(target.Type(), len(elements), len(elements)))
+		}
+		for i, element := range elements[:min(len(elements), target.Len())] {
+			elemPath := append(append([]string{}, path...), fmt.Sprintf("[%d]", i))
+			p.unmarshalDurations(element, target.Index(i), elemPath)
+		}
+
+	case reflect.Map:
+		var members map[string]json.RawMessage
+		if err := json.Unmarshal(data, &members); err != nil {
+			fail(err)
+			return
+		}
+		m := reflect.MakeMapWithSize(target.Type(), len(members))
+		for _, key := range slices.Sorted(maps.Keys(members)) {
+			elem := reflect.New(target.Type().Elem()).Elem()
+			p.unmarshalDurations(members[key], elem, append(append([]string{}, path...), key))
+			m.SetMapIndex(reflect.ValueOf(key).Convert(target.Type().Key()), elem)
+		}
+		target.Set(m)
+
+	default:
+		d, err := DecodeDuration(data, p.DurationSeconds)
+		if err != nil {
+			fail(err)
+			return
+		}
+		target.SetInt(int64(d))
+	}
+}
+
+// resolveEnumIndex replaces an integer in the raw JSON of an EnumFromInt field
+// with the JSON of the enum value it indexes. It reports false, with an error,
+// for an integer that indexes no value; other JSON is left to decode as usual.
+func (p *UnmarshalProcessor) resolveEnumIndex(ctx *FieldContext, values map[int]any) bool {
+	var index int
+	if json.Unmarshal(ctx.RawJSON, &index) != nil {
+		return true
+	}
+	value, ok := values[index]
+	if !ok {
+		p.Errors = append(p.Errors, ValidationError{
+			Loc:     ctx.Path,
+			Message: fmt.Sprintf("enum index %d is out of range, expected one of %v", index, slices.Sorted(maps.Keys(values))),
+			Type:    errors.ErrorTypeCoercion,
+		})
+		return false
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		p.Errors = append(p.Errors, ValidationError{
+			Loc:     ctx.Path,
+			Message: fmt.Sprintf("JSON marshal failed: %v", err),
+			Type:    errors.ErrorTypeJSONEncode,
+		})
+		return false
+	}
+	ctx.RawJSON = data
+	return true
+}
+
+// wrapSingleValue replaces the raw JSON of a slice field that holds a single
+// non-array value with a one-element array of it. Byte slices (base64
+// strings) and types with their own UnmarshalJSON are left as they are.
+func wrapSingleValue(ctx *FieldContext) {
+	typ := reflectutil.UnwrapPointer(ctx.Value.Type())
+	if typ.Kind() != reflect.Slice || typ.Elem().Kind() == reflect.Uint8 ||
+		reflect.PointerTo(typ).Implements(jsonUnmarshalerType) {
+		return
+	}
+	raw := bytes.TrimSpace(ctx.RawJSON)
+	if len(raw) == 0 || raw[0] == '[' || string(raw) == "null" {
+		return
+	}
+	wrapped := make([]byte, 0, len(raw)+2)
+	wrapped = append(wrapped, '[')
+	wrapped = append(wrapped, raw...)
+	ctx.RawJSON = append(wrapped, ']')
+}
+
+var jsonUnmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+
+// checkArrayLength reports a fixed-size array field whose JSON array has a
+// different length. encoding/json zero-fills missing items and drops extras.
+func (p *UnmarshalProcessor) checkArrayLength(ctx *FieldContext) {
+	typ := reflectutil.UnwrapPointer(ctx.Value.Type())
+	if typ.Kind() != reflect.Array {
+		return
+	}
+	var items []json.RawMessage
+	if json.Unmarshal(ctx.RawJSON, &items) != nil || items == nil || len(items) == typ.Len() {
+		return // not an array (null or a custom encoding)
+	}
+	p.Errors = append(p.Errors, ValidationError{
+		Loc:     ctx.Path,
+		Message: fmt.Sprintf("must have exactly %d items, got %d", typ.Len(), len(items)),
+		Type:    errors.ErrorTypeConstraint,
+	})
+}
+
 // unmarshalDiscriminated handles discriminated union unmarshaling.
 func (p *UnmarshalProcessor) unmarshalDiscriminated(ctx *FieldContext, discConstraint map[string]any) error {
 	discriminatorField, _ := discConstraint["propertyName"].(string)
@@ -114,13 +315,9 @@ func (p *UnmarshalProcessor) unmarshalDiscriminatedSingle(ctx *FieldContext, dis
 	// Look up concrete type
 	concreteTypeExample, ok := mapping[fmt.Sprintf("%v", discriminatorValue)]
 	if !ok {
-		validValues := make([]string, 0, len(mapping))
-		for k := range mapping {
-			validValues = append(validValues, k)
-		}
 		p.Errors = append(p.Errors, ValidationError{
 			Loc:     append(ctx.Path, discriminatorField),
-			Message: fmt.Sprintf("invalid discriminator value '%v', expected one of: %v", discriminatorValue, validValues),
+			Message: errors.DiscriminatorInvalidMessage(discriminatorField, fmt.Sprintf("%v", discriminatorValue), slices.Collect(maps.Keys(mapping))),
 			Type:    errors.ErrorTypeDiscriminatorInvalid,
 		})
 		return nil
@@ -134,7 +331,7 @@ func (p *UnmarshalProcessor) unmarshalDiscriminatedSingle(ctx *FieldContext, dis
 	}
 
 	concretePtr := reflect.New(elemType)
-	if err := json.Unmarshal(ctx.RawJSON, concretePtr.Interface()); err != nil {
+	if err := p.decode(ctx.RawJSON, concretePtr.Interface()); err != nil {
 		p.Errors = append(p.Errors, ValidationError{
 			Loc:     ctx.Path,
 			Message: fmt.Sprintf("failed to unmarshal discriminated union: %v", err),
@@ -195,13 +392,9 @@ func (p *UnmarshalProcessor) unmarshalDiscriminatedSlice(ctx *FieldContext, disc
 		// Look up concrete type
 		concreteTypeExample, ok := mapping[fmt.Sprintf("%v", discriminatorValue)]
 		if !ok {
-			validValues := make([]string, 0, len(mapping))
-			for k := range mapping {
-				validValues = append(validValues, k)
-			}
 			p.Errors = append(p.Errors, ValidationError{
 				Loc:     append(elemPath, discriminatorField),
-				Message: fmt.Sprintf("invalid discriminator value '%v', expected one of: %v", discriminatorValue, validValues),
+				Message: errors.DiscriminatorInvalidMessage(discriminatorField, fmt.Sprintf("%v", discriminatorValue), slices.Collect(maps.Keys(mapping))),
 				Type:    errors.ErrorTypeDiscriminatorInvalid,
 			})
 			continue
@@ -215,7 +408,7 @@ func (p *UnmarshalProcessor) unmarshalDiscriminatedSlice(ctx *FieldContext, disc
 		}
 
 		concretePtr := reflect.New(elemType)
-		if err := json.Unmarshal(elemData, concretePtr.Interface()); err != nil {
+		if err := p.decode(elemData, concretePtr.Interface()); err != nil {
 			p.Errors = append(p.Errors, ValidationError{
 				Loc:     elemPath,
 				Message: fmt.Sprintf("failed to unmarshal element: %v", err),
@@ -243,8 +436,8 @@ func (p *UnmarshalProcessor) ShouldDescend(ctx *FieldContext) bool {
 	// and now the walker needs to descend to validate individual fields of each element
 	val := reflectutil.UnwrapValue(ctx.Value)
 
-	// Descend into slices (let walker handle elements)
-	if val.Kind() == reflect.Slice {
+	// Descend into slices and maps (let walker handle elements)
+	if val.Kind() == reflect.Slice || val.Kind() == reflect.Map {
 		return reflectutil.IsWalkableSliceElem(val.Type())
 	}
 
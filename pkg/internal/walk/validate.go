@@ -1,6 +1,7 @@
 package walk
 
 import (
+	"context"
 	"reflect"
 
 	"github.com/deepankarm/godantic/pkg/internal/errors"
@@ -14,6 +15,18 @@ type ValidationError = errors.ValidationError
 // It collects all errors rather than stopping at the first one.
 type ValidateProcessor struct {
 	Errors []ValidationError
+
+	// Ctx is passed to context validators. When set, cancellation stops the walk
+	// with the context's error. Nil means context.Background().
+	Ctx context.Context
+
+	// AbsentOnlyDefaults matches DefaultsProcessor.AbsentOnly: a field with a
+	// default that is present in the raw JSON counts as provided, even if zero
+	AbsentOnlyDefaults bool
+
+	// NoDefaults is set when no DefaultsProcessor runs: a field with a default
+	// is checked like any other, so a missing required one is reported
+	NoDefaults bool
 }
 
 // GetErrors returns collected validation errors.
@@ -35,17 +48,46 @@ func (p *ValidateProcessor) ProcessField(ctx *FieldContext) error {
 		return nil
 	}
 
+	// Stop as soon as the caller's context is done
+	if p.Ctx != nil {
+		if err := p.Ctx.Err(); err != nil {
+			return err
+		}
+	}
+
 	// No field options means no validation rules
 	if ctx.FieldOptions == nil {
 		return nil
 	}
 
 	val := reflectutil.UnwrapValue(ctx.Value)
-	_, hasDefault := ctx.FieldOptions.Constraints["default"]
+	hasDefault := HasDefault(ctx.FieldOptions.Constraints)
+	if strict, _ := ctx.FieldOptions.Constraints["strictRequired"].(bool); strict || p.NoDefaults {
+		hasDefault = false // Strict-required fields must be provided; defaults aren't applied
+	}
 	isStruct := val.Kind() == reflect.Struct && !reflectutil.IsBasicType(val.Type())
+	// A non-nil pointer marks an optional value as provided, even if it points to zero
+	missing := isZero(val) && !isSetPointer(ctx.Value)
+	if inner, set, ok := reflectutil.UnwrapOptional(val); ok {
+		// A set Optional counts as provided, even when it holds zero
+		val, missing = inner, !set
+	}
+	if p.AbsentOnlyDefaults && hasDefault && len(ctx.RawJSON) > 0 {
+		missing, hasDefault = false, false
+	}
+
+	// Required non-empty fields also reject empty strings and collections
+	if nonEmpty, _ := ctx.FieldOptions.Constraints["nonEmpty"].(bool); nonEmpty && IsEmpty(ctx.Value) && !(missing && hasDefault) {
+		p.Errors = append(p.Errors, ValidationError{
+			Loc:     ctx.Path,
+			Message: "required field must not be empty",
+			Type:    errors.ErrorTypeRequired,
+		})
+		return nil
+	}
 
 	// Check required fields (but don't skip nested struct validation)
-	if ctx.FieldOptions.Required && isZero(val) {
+	if ctx.FieldOptions.Required && missing {
 		if !hasDefault {
 			// For structs, still validate nested fields to give more specific errors
 			// (walker will descend into them, so don't add error here for structs)
@@ -60,11 +102,27 @@ func (p *ValidateProcessor) ProcessField(ctx *FieldContext) error {
 		}
 	}
 
+	// Conditionally required fields depend on the values of their siblings
+	if missing && !hasDefault && len(ctx.FieldOptions.RequiredWhen) > 0 {
+		var siblings map[string]any
+		if ctx.Siblings != nil {
+			siblings = ctx.Siblings()
+		}
+		if err := RequiredWhenError(ctx.FieldOptions.RequiredWhen, siblings); err != nil {
+			p.Errors = append(p.Errors, ValidationError{
+				Loc:     ctx.Path,
+				Message: err.Error(),
+				Type:    errors.ErrorTypeRequired,
+			})
+			return nil
+		}
+	}
+
 	// Skip validation for zero values if:
 	// 1. Field has a default (will be applied later), OR
 	// 2. Field is not required (zero value means "not provided" for optional fields)
 	// Otherwise: validate zero values (they may have been explicitly provided)
-	if isZero(val) && !isStruct {
+	if missing && !isStruct {
 		if hasDefault || !ctx.FieldOptions.Required {
 			return nil
 		}
@@ -72,16 +130,60 @@ func (p *ValidateProcessor) ProcessField(ctx *FieldContext) error {
 	}
 
 	// Run validators
+	failed := false
 	for _, validator := range ctx.FieldOptions.Validators {
 		if err := validator(val.Interface()); err != nil {
-			p.Errors = append(p.Errors, ValidationError{
-				Loc:     ctx.Path,
-				Message: err.Error(),
-				Type:    errors.ErrorTypeConstraint,
-			})
+			p.Errors = append(p.Errors, errors.ConstraintErrors(ctx.Path, err)...)
+			failed = true
 		}
 	}
 
+	if len(ctx.FieldOptions.SiblingValidators) > 0 {
+		var siblings map[string]any
+		if ctx.Siblings != nil {
+			siblings = ctx.Siblings()
+		}
+		for _, validator := range ctx.FieldOptions.SiblingValidators {
+			if err := validator(val.Interface(), siblings); err != nil {
+				p.Errors = append(p.Errors, errors.ConstraintErrors(ctx.Path, err)...)
+				failed = true
+			}
+		}
+	}
+
+	// Context validators usually do I/O - only run them on otherwise valid values
+	if failed {
+		return nil
+	}
+	return p.runContextValidators(ctx, val)
+}
+
+// RequiredWhenError returns the first error of the conditional required checks
+// for siblings, or nil if none applies.
+func RequiredWhenError(checks []func(map[string]any) error, siblings map[string]any) error {
+	for _, check := range checks {
+		if err := check(siblings); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runContextValidators runs context-aware validators for a field.
+// Returns the context's error (stopping the walk) if it is cancelled.
+func (p *ValidateProcessor) runContextValidators(ctx *FieldContext, val reflect.Value) error {
+	goCtx := p.Ctx
+	if goCtx == nil {
+		goCtx = context.Background()
+	}
+	for _, validator := range ctx.FieldOptions.ContextValidators {
+		if err := validator(goCtx, val.Interface()); err != nil {
+			if ctxErr := goCtx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+			p.Errors = append(p.Errors, errors.ConstraintErrors(ctx.Path, err)...)
+		}
+	}
 	return nil
 }
 
@@ -89,8 +191,8 @@ func (p *ValidateProcessor) ProcessField(ctx *FieldContext) error {
 func (p *ValidateProcessor) ShouldDescend(ctx *FieldContext) bool {
 	val := reflectutil.UnwrapValue(ctx.Value)
 
-	// Always descend into slices (let walker handle elements)
-	if val.Kind() == reflect.Slice {
+	// Always descend into slices and maps (let walker handle elements)
+	if val.Kind() == reflect.Slice || val.Kind() == reflect.Map {
 		return true
 	}
 
@@ -103,6 +205,37 @@ func (p *ValidateProcessor) ShouldDescend(ctx *FieldContext) bool {
 	return !reflectutil.IsBasicType(val.Type())
 }
 
+// IsEmpty reports whether v is nil or an empty string, slice, array or map,
+// looking through pointers, interfaces and Optional wrappers. An unset
+// Optional is empty.
+func IsEmpty(v reflect.Value) bool {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return true
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return true
+	}
+	if inner, set, ok := reflectutil.UnwrapOptional(v); ok {
+		if !set {
+			return true
+		}
+		return IsEmpty(inner)
+	}
+	switch v.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+		return v.Len() == 0
+	}
+	return false
+}
+
+// isSetPointer reports whether v is a non-nil pointer.
+func isSetPointer(v reflect.Value) bool {
+	return v.Kind() == reflect.Pointer && !v.IsNil()
+}
+
 // isZero checks if a value is the zero value for its type.
 func isZero(v reflect.Value) bool {
 	if !v.IsValid() {
@@ -2,10 +2,14 @@
 package walk
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/deepankarm/godantic/pkg/internal/errors"
 	"github.com/deepankarm/godantic/pkg/internal/reflectutil"
@@ -28,6 +32,10 @@ type FieldContext struct {
 	// FieldOptions contains validation options from Field{Name}() method (nil if none)
 	FieldOptions *FieldOptions
 
+	// Siblings returns the raw values of the object holding this field, for
+	// sibling validators (nil when none of the object's fields has them)
+	Siblings func() map[string]any
+
 	// IsRoot is true for the root struct being walked
 	IsRoot bool
 }
@@ -35,9 +43,15 @@ type FieldContext struct {
 // FieldOptions holds validation info extracted from Field{Name}() methods.
 // This is a simplified view for the walker - validators are stored separately.
 type FieldOptions struct {
-	Required    bool
-	Constraints map[string]any
-	Validators  []func(any) error
+	Required          bool
+	Constraints       map[string]any
+	Validators        []func(any) error
+	ContextValidators []func(context.Context, any) error
+	SiblingValidators []func(any, map[string]any) error
+
+	// RequiredWhen makes a missing field required when a check over the raw
+	// values of its object returns an error, used as the required message
+	RequiredWhen []func(siblings map[string]any) error
 }
 
 // Processor handles fields during tree walk.
@@ -62,7 +76,34 @@ type DescentController interface {
 type Walker struct {
 	processors []Processor
 	scanner    FieldScanner
-	visited    map[uintptr]bool // Track visited pointers to prevent cycles
+	visited    map[uintptr]bool      // Track visited pointers to prevent cycles
+	decoding   bool                  // Walking JSON data, so siblings come from the raw objects
+	siblings   func() map[string]any // Siblings of the struct embedding the one being walked
+
+	// MaxDepth limits the length of the path to a walked struct (0 = no
+	// limit). A deeper struct stops the walk with a *MaxDepthError.
+	MaxDepth int
+
+	// TagName is the struct tag naming fields in the JSON data, falling back
+	// to json (see reflectutil.TagFieldName). Empty means json. Under another
+	// tag, keys match only the field's name under it, case-insensitively, and
+	// siblings are keyed by that name when validating a struct as well.
+	TagName string
+}
+
+// MaxDepthError stops a walk at a struct nested deeper than Walker.MaxDepth.
+type MaxDepthError struct {
+	Path []string
+	Max  int
+}
+
+func (e *MaxDepthError) Error() string {
+	return fmt.Sprintf("nesting exceeds the maximum depth of %d", e.Max)
+}
+
+// ValidationError reports e at the path of the struct that exceeded the limit.
+func (e *MaxDepthError) ValidationError() ValidationError {
+	return ValidationError{Loc: e.Path, Message: e.Error(), Type: errors.ErrorTypeMaxDepth}
 }
 
 // FieldScanner scans types for field options. Allows dependency injection for testing.
@@ -82,6 +123,7 @@ func NewWalker(scanner FieldScanner, processors ...Processor) *Walker {
 // val should be the value (not pointer). data is optional raw JSON.
 func (w *Walker) Walk(val reflect.Value, data []byte) error {
 	w.visited = make(map[uintptr]bool)
+	w.decoding = len(data) > 0
 
 	// Unwrap pointer at root
 	if val.Kind() == reflect.Pointer {
@@ -138,7 +180,14 @@ func (w *Walker) walkRootSlice(slice reflect.Value, data []byte) error {
 	if actualElemType.Kind() != reflect.Struct {
 		// For primitive slices, just unmarshal directly
 		if len(data) > 0 {
-			json.Unmarshal(data, slice.Addr().Interface())
+			useNumber := false
+			for _, p := range w.processors {
+				if up, ok := p.(*UnmarshalProcessor); ok {
+					useNumber = up.UseNumber
+					break
+				}
+			}
+			DecodeJSON(data, slice.Addr().Interface(), useNumber)
 		}
 		return nil
 	}
@@ -220,6 +269,9 @@ func (w *Walker) walkStruct(val reflect.Value, rawFields map[string]json.RawMess
 	if val.Kind() != reflect.Struct {
 		return nil
 	}
+	if w.MaxDepth > 0 && len(path) > w.MaxDepth {
+		return &MaxDepthError{Path: path, Max: w.MaxDepth}
+	}
 
 	t := val.Type()
 
@@ -245,6 +297,12 @@ func (w *Walker) walkStruct(val reflect.Value, rawFields map[string]json.RawMess
 		fieldOpts = w.scanner.ScanFieldOptions(t)
 	}
 
+	// Embedded structs share the object of the struct embedding them
+	siblings := w.siblings
+	if fieldOptsOverride == nil {
+		siblings = w.siblingsFor(val, rawFields, fieldOpts)
+	}
+
 	// Process each field
 	for i := 0; i < t.NumField(); i++ {
 		structField := t.Field(i)
@@ -258,7 +316,11 @@ func (w *Walker) walkStruct(val reflect.Value, rawFields map[string]json.RawMess
 		}
 
 		// Get JSON field name
-		jsonName := reflectutil.JSONFieldName(structField)
+		jsonName, goName := reflectutil.JSONFieldName(structField), structField.Name
+		if w.TagName != "" {
+			jsonName = reflectutil.TagFieldName(structField, w.TagName)
+			goName = jsonName
+		}
 		if jsonName == "-" {
 			continue // Skip ignored fields
 		}
@@ -269,9 +331,10 @@ func (w *Walker) walkStruct(val reflect.Value, rawFields map[string]json.RawMess
 			Path:         fieldPath,
 			StructField:  &structField,
 			Value:        fieldVal,
-			RawJSON:      lookupRawField(rawFields, jsonName, structField.Name),
+			RawJSON:      LookupRawField(rawFields, jsonName, goName),
 			FieldOptions: fieldOpts[structField.Name],
 			IsRoot:       false,
+			Siblings:     siblings,
 		}
 
 		// Run all processors
@@ -292,10 +355,17 @@ func (w *Walker) walkStruct(val reflect.Value, rawFields map[string]json.RawMess
 				if err := w.walkSlice(fieldVal, ctx.RawJSON, fieldPath); err != nil {
 					return err
 				}
+			} else if fieldVal.Kind() == reflect.Map {
+				if err := w.walkMap(fieldVal, nestedRaw, fieldPath); err != nil {
+					return err
+				}
 			} else if structField.Anonymous {
 				// For embedded/anonymous structs, use PARENT's field options
 				// so overridden Field{Name}() methods on the outer struct apply
-				if err := w.walkStruct(fieldVal, nestedRaw, path, false, fieldOpts); err != nil {
+				w.siblings = siblings
+				err := w.walkStruct(fieldVal, nestedRaw, path, false, fieldOpts)
+				w.siblings = nil
+				if err != nil {
 					return err
 				}
 			} else {
@@ -310,6 +380,68 @@ func (w *Walker) walkStruct(val reflect.Value, rawFields map[string]json.RawMess
 	return nil
 }
 
+// siblingsFor returns the lazily built siblings of the fields of val, or nil
+// if none of them has sibling validators or conditional required checks.
+func (w *Walker) siblingsFor(val reflect.Value, rawFields map[string]json.RawMessage, fieldOpts map[string]*FieldOptions) func() map[string]any {
+	needed := false
+	for _, opts := range fieldOpts {
+		if opts != nil && (len(opts.SiblingValidators) > 0 || len(opts.RequiredWhen) > 0) {
+			needed = true
+			break
+		}
+	}
+	if !needed {
+		return nil
+	}
+	if w.decoding {
+		return sync.OnceValue(func() map[string]any { return RawSiblings(rawFields) })
+	}
+	if w.TagName != "" {
+		return sync.OnceValue(func() map[string]any { return tagSiblings(StructSiblings(val), val.Type(), w.TagName) })
+	}
+	return sync.OnceValue(func() map[string]any { return StructSiblings(val) })
+}
+
+// RawSiblings decodes the members of a raw JSON object for sibling validators.
+// A missing object gives an empty map.
+func RawSiblings(rawFields map[string]json.RawMessage) map[string]any {
+	siblings := make(map[string]any, len(rawFields))
+	for key, raw := range rawFields {
+		var value any
+		if json.Unmarshal(raw, &value) == nil {
+			siblings[key] = value
+		}
+	}
+	return siblings
+}
+
+// StructSiblings encodes a struct as a JSON object for sibling validators.
+// Values that can't be encoded give an empty map.
+func StructSiblings(val reflect.Value) map[string]any {
+	siblings := make(map[string]any)
+	if !val.CanInterface() {
+		return siblings
+	}
+	data, err := json.Marshal(val.Interface())
+	if err != nil {
+		return siblings
+	}
+	_ = json.Unmarshal(data, &siblings)
+	return siblings
+}
+
+// tagSiblings renames the keys of siblings encoded from a struct of type typ
+// from their JSON names to their names under tag, as in the JSON data
+func tagSiblings(siblings map[string]any, typ reflect.Type, tag string) map[string]any {
+	renamed := make(map[string]any, len(siblings))
+	for _, field := range reflectutil.TaggedFields(typ, tag) {
+		if value, ok := siblings[field.JSONName]; ok && field.TagName != "-" {
+			renamed[field.TagName] = value
+		}
+	}
+	return renamed
+}
+
 // walkSlice walks each element of a slice.
 func (w *Walker) walkSlice(slice reflect.Value, rawJSON json.RawMessage, path []string) error {
 	slice = reflectutil.UnwrapValue(slice)
@@ -351,6 +483,64 @@ func (w *Walker) walkSlice(slice reflect.Value, rawJSON json.RawMessage, path []
 	return nil
 }
 
+// walkMap walks each value of a map, in sorted key order.
+// Map values are not addressable, so struct values are walked as copies and
+// stored back, which lets processors such as defaults modify them.
+func (w *Walker) walkMap(m reflect.Value, rawFields map[string]json.RawMessage, path []string) error {
+	if m.Kind() != reflect.Map || m.IsNil() {
+		return nil
+	}
+
+	// Check if values are worth walking (structs or interfaces)
+	if !reflectutil.IsWalkableSliceElem(m.Type()) {
+		return nil
+	}
+
+	keys := m.MapKeys()
+	names := make([]string, len(keys))
+	for i, key := range keys {
+		names[i] = mapKeyName(key)
+	}
+	sort.Sort(mapKeySorter{keys, names})
+
+	for i, key := range keys {
+		var elemFields map[string]json.RawMessage
+		if raw, ok := rawFields[names[i]]; ok {
+			json.Unmarshal(raw, &elemFields)
+		}
+
+		elem := reflect.New(m.Type().Elem()).Elem()
+		elem.Set(m.MapIndex(key))
+		if err := w.walkStruct(elem, elemFields, appendPath(path, names[i]), false, nil); err != nil {
+			return err
+		}
+		m.SetMapIndex(key, elem)
+	}
+
+	return nil
+}
+
+// mapKeyName returns the JSON object key for a map key.
+func mapKeyName(key reflect.Value) string {
+	if key.Kind() == reflect.String {
+		return key.String()
+	}
+	return fmt.Sprint(key.Interface())
+}
+
+// mapKeySorter sorts map keys by their JSON names.
+type mapKeySorter struct {
+	keys  []reflect.Value
+	names []string
+}
+
+func (s mapKeySorter) Len() int           { return len(s.keys) }
+func (s mapKeySorter) Less(i, j int) bool { return s.names[i] < s.names[j] }
+func (s mapKeySorter) Swap(i, j int) {
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+	s.names[i], s.names[j] = s.names[j], s.names[i]
+}
+
 // shouldDescend checks if we should recurse into this field.
 func (w *Walker) shouldDescend(ctx *FieldContext) bool {
 	// Check if any processor wants to control descent
@@ -362,8 +552,8 @@ func (w *Walker) shouldDescend(ctx *FieldContext) bool {
 
 	// Default: descend into non-basic struct types
 	val := reflectutil.UnwrapValue(ctx.Value)
-	if val.Kind() == reflect.Slice {
-		return true // Let walkSlice decide
+	if val.Kind() == reflect.Slice || val.Kind() == reflect.Map {
+		return true // Let walkSlice/walkMap decide
 	}
 	if val.Kind() != reflect.Struct {
 		return false
@@ -396,32 +586,36 @@ func appendPathIndex(path []string, index int) []string {
 	return result
 }
 
-// lookupRawField looks up a field in rawFields with case-insensitive fallback.
+// LookupRawField looks up a field in rawFields with case-insensitive fallback.
 // This mimics json.Unmarshal's behavior: exact match first, then case-insensitive.
-func lookupRawField(rawFields map[string]json.RawMessage, jsonName, fieldName string) json.RawMessage {
-	if rawFields == nil {
-		return nil
-	}
+func LookupRawField(rawFields map[string]json.RawMessage, jsonName, fieldName string) json.RawMessage {
+	raw, _ := LookupField(rawFields, jsonName, fieldName)
+	return raw
+}
 
+// LookupField is LookupRawField for the members of an object decoded as any
+// value type, also reporting whether the field is present.
+func LookupField[V any](fields map[string]V, jsonName, fieldName string) (V, bool) {
 	// Try exact JSON tag name first
-	if raw, ok := rawFields[jsonName]; ok {
-		return raw
+	if value, ok := fields[jsonName]; ok {
+		return value, true
 	}
 
 	// Try exact field name (for fields without json tag)
-	if raw, ok := rawFields[fieldName]; ok {
-		return raw
+	if value, ok := fields[fieldName]; ok {
+		return value, true
 	}
 
 	// Case-insensitive fallback (like json.Unmarshal)
 	lowerJSON := strings.ToLower(jsonName)
 	lowerField := strings.ToLower(fieldName)
-	for key, raw := range rawFields {
+	for key, value := range fields {
 		lowerKey := strings.ToLower(key)
 		if lowerKey == lowerJSON || lowerKey == lowerField {
-			return raw
+			return value, true
 		}
 	}
 
-	return nil
+	var zero V
+	return zero, false
 }
@@ -0,0 +1,81 @@
+// Command schema2godantic generates Go structs with godantic Field methods
+// from a JSON Schema (or the component schemas of an OpenAPI spec), the
+// inverse of schema generation.
+//
+// Usage:
+//
+//	schema2godantic [-package name] [-o out.go] schema.json
+//
+// The source is written to stdout unless -o is given. See schema.GoSource for
+// the keywords that are supported.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/deepankarm/godantic/pkg/godantic/schema"
+	"github.com/invopop/jsonschema"
+)
+
+func main() {
+	pkg := flag.String("package", "models", "package name of the generated source")
+	out := flag.String("o", "", "output file (default stdout)")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: schema2godantic [-package name] [-o out.go] schema.json")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	s, err := loadSchema(flag.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	src, err := schema.GoSource(s, *pkg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if *out == "" {
+		fmt.Print(src)
+		return
+	}
+	if err := os.WriteFile(*out, []byte(src), 0o644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// loadSchema reads a JSON schema file. OpenAPI documents are reduced to their
+// components.schemas, which become $defs.
+func loadSchema(path string) (*jsonschema.Schema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if _, isOpenAPI := doc["openapi"]; isOpenAPI {
+		components, _ := doc["components"].(map[string]any)
+		if data, err = json.Marshal(map[string]any{"$defs": components["schemas"]}); err != nil {
+			return nil, err
+		}
+	}
+
+	s, err := schema.Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return s, nil
+}
@@ -0,0 +1,74 @@
+// Command schemadiff compares two generated JSON schemas (or OpenAPI specs)
+// and exits non-zero when the new one contains breaking changes.
+//
+// Usage:
+//
+//	schemadiff [-breaking] old.json new.json
+//
+// For OpenAPI documents, components.schemas are compared as named definitions.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/deepankarm/godantic/pkg/godantic/schema"
+)
+
+func main() {
+	breakingOnly := flag.Bool("breaking", false, "only print breaking changes")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: schemadiff [-breaking] old.json new.json")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	oldSchema, err := loadSchema(flag.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	newSchema, err := loadSchema(flag.Arg(1))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	changes := schema.Diff(oldSchema, newSchema)
+	for _, c := range changes {
+		if *breakingOnly && !c.Breaking {
+			continue
+		}
+		fmt.Println(c)
+	}
+
+	if schema.HasBreakingChanges(changes) {
+		os.Exit(1)
+	}
+}
+
+// loadSchema reads a JSON schema file. OpenAPI documents are reduced to their
+// components.schemas so they can be compared as $defs.
+func loadSchema(path string) (map[string]any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	if _, isOpenAPI := doc["openapi"]; isOpenAPI {
+		components, _ := doc["components"].(map[string]any)
+		return map[string]any{"$defs": components["schemas"]}, nil
+	}
+	return doc, nil
+}